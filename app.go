@@ -46,6 +46,14 @@ func init() {
 				slog.Error(err.Error())
 			}
 			theDebugMode.deviceScale = f
+		case strings.HasPrefix(token, "record="):
+			if err := theInputSession.startRecording(token[len("record="):]); err != nil {
+				slog.Error(err.Error())
+			}
+		case strings.HasPrefix(token, "replay="):
+			if err := theInputSession.startReplaying(token[len("replay="):]); err != nil {
+				slog.Error(err.Error())
+			}
 		case token == "":
 		default:
 			slog.Warn("unknown debug option", "option", token)
@@ -126,15 +134,31 @@ type app struct {
 	screenHeight float64
 	deviceScale  float64
 
+	// outsideWidth and outsideHeight are the outside size given to Layout.
+	outsideWidth  float64
+	outsideHeight float64
+
 	lastScreenWidth    float64
 	lastScreenHeight   float64
 	lastCursorPosition image.Point
 	lastColorMode      ebiten.ColorMode
 
-	inputState inputState
+	inputState      inputState
+	clickRecognizer clickRecognizer
+
+	textInputClientState textInputClientState
+
+	// wheelX and wheelY are the wheel values for widgets. See [Context.Wheel].
+	wheelX    float64
+	wheelY    float64
+	zoomWheel float64
 
 	focusedWidget Widget
 
+	// fileDropHoveredWidget is the widget that would receive files dropped at the cursor position.
+	// See [Context.IsFileDropHovered].
+	fileDropHoveredWidget Widget
+
 	// widgetList is a flat DFS-ordered list of all widgets, populated after each buildWidgets call.
 	// It is used to avoid re-traversing the tree for passes that don't modify the tree structure.
 	widgetList []Widget
@@ -384,10 +408,18 @@ func (a *app) Update() error {
 		a.focusWidget(a.root)
 	}
 
-	if s := deviceScaleFactor(); a.deviceScale != s {
+	// Handle user inputs.
+	// In the replay mode, the input state also includes the window size and the device scale.
+	// TODO: Handle this in Ebitengine's HandleInput in the future (hajimehoshi/ebiten#1704)
+	a.inputState.update(a.outsideWidth, a.outsideHeight)
+
+	if s := a.inputState.deviceScale; a.deviceScale != s {
 		a.deviceScale = s
 		a.requestRebuild(a.root.widgetState(), requestRedrawReasonScreenDeviceScale)
 	}
+	if theInputSession.isReplaying() {
+		a.updateScreenSize(a.inputState.outsideWidth, a.inputState.outsideHeight)
+	}
 
 	if a.context.ColorMode() != a.lastColorMode {
 		a.lastColorMode = a.context.ColorMode()
@@ -404,9 +436,8 @@ func (a *app) Update() error {
 		layoutChangedInUpdate = true
 	}
 
-	// Handle user inputs.
-	// TODO: Handle this in Ebitengine's HandleInput in the future (hajimehoshi/ebiten#1704)
-	a.inputState.update()
+	a.clickRecognizer.update(a.context.Scale())
+	zoomKeyHandled := a.updateWheel()
 	var inputHandledWidget Widget
 	if a.inputState.isPointingActive(layoutChangedInUpdate) {
 		if r := a.handleInputWidget(handleInputTypePointing); r.widget != nil {
@@ -418,7 +449,16 @@ func (a *app) Update() error {
 			}
 		}
 	}
-	if a.inputState.isButtonActive() {
+	// The input method takes precedence over button input while composing text.
+	imeHandled := a.textInputClientState.update(a.focusedWidget)
+	if imeHandled {
+		inputHandledWidget = a.textInputClientState.client
+		if theDebugMode.showInputLogs {
+			slog.Info("input method handled", "widget", fmt.Sprintf("%T", inputHandledWidget))
+		}
+	}
+	// A zoom shortcut key is not passed to the focused widget.
+	if !imeHandled && !zoomKeyHandled && a.inputState.isButtonActive() {
 		a.setButtonInputReceptiveAncestorFlags()
 		if r := a.handleInputWidget(handleInputTypeButton); r.widget != nil {
 			if !r.aborted {
@@ -430,6 +470,14 @@ func (a *app) Update() error {
 		}
 	}
 
+	if w := a.handleDroppedFiles(); w != nil {
+		inputHandledWidget = w
+		if theDebugMode.showInputLogs {
+			slog.Info("dropped files handled", "widget", fmt.Sprintf("%T", w))
+		}
+	}
+	a.updateFileDropHoveredWidget()
+
 	a.settleRedrawAndRebuildState(inputHandledWidget)
 
 	// Call the second buildWidgets to construct the widget tree again to reflect the latest state.
@@ -527,10 +575,20 @@ func (a *app) Layout(outsideWidth, outsideHeight int) (int, int) {
 }
 
 func (a *app) LayoutF(outsideWidth, outsideHeight float64) (float64, float64) {
+	a.outsideWidth = outsideWidth
+	a.outsideHeight = outsideHeight
+	if theInputSession.isReplaying() && a.inputState.outsideWidth > 0 && a.inputState.outsideHeight > 0 {
+		outsideWidth = a.inputState.outsideWidth
+		outsideHeight = a.inputState.outsideHeight
+	}
+	a.updateScreenSize(outsideWidth, outsideHeight)
+	return a.screenWidth, a.screenHeight
+}
+
+func (a *app) updateScreenSize(outsideWidth, outsideHeight float64) {
 	s := a.deviceScale
 	a.screenWidth = outsideWidth * s
 	a.screenHeight = outsideHeight * s
-	return a.screenWidth, a.screenHeight
 }
 
 func (a *app) requestRedraw(region image.Rectangle, reason requestRedrawReason, widget Widget) {
@@ -767,7 +825,7 @@ func (a *app) layoutWidgets() {
 }
 
 func (a *app) updateHitWidgets(layoutChanged bool) {
-	pt := image.Pt(ebiten.CursorPosition())
+	pt := image.Pt(a.inputState.cursorX, a.inputState.cursorY)
 	if !layoutChanged && pt == a.lastCursorPosition {
 		return
 	}
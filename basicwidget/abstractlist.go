@@ -29,8 +29,18 @@ type valuer[Value comparable] interface {
 	visible() bool
 }
 
+// abstractListItemSource provides items lazily instead of a slice of items.
+type abstractListItemSource[Value comparable, Item valuer[Value]] interface {
+	itemCount() int
+	itemAt(index int) Item
+
+	// indexByValue returns the index of the item with the given value, or -1 if there is no such item.
+	indexByValue(value Value) int
+}
+
 type abstractList[Value comparable, Item valuer[Value]] struct {
 	items           []Item
+	itemSource      abstractListItemSource[Value, Item]
 	selectedIndices map[int]struct{}
 	multiSelection  bool
 
@@ -75,10 +85,11 @@ func (a *abstractList[Value, Item]) refreshSelectionString() {
 }
 
 func (a *abstractList[Value, Item]) isItemIndexSelectable(index int) bool {
-	if index < 0 || index >= len(a.items) {
+	item, ok := a.ItemByIndex(index)
+	if !ok {
 		return false
 	}
-	return a.items[index].selectable()
+	return item.selectable()
 }
 
 func (a *abstractList[Value, Item]) OnItemSelected(f func(index int)) {
@@ -111,26 +122,56 @@ func (a *abstractList[Value, Item]) SetMultiSelection(multi bool) {
 func (a *abstractList[Value, Item]) SetItems(items []Item) {
 	a.items = adjustSliceSize(items, len(items))
 	copy(a.items, items)
+	a.itemSource = nil
 
 	maps.DeleteFunc(a.selectedIndices, func(idx int, _ struct{}) bool {
 		return !a.isItemIndexSelectable(idx)
 	})
 }
 
+// SetItemSource sets the source to provide items lazily.
+// The items set by SetItems are discarded.
+func (a *abstractList[Value, Item]) SetItemSource(source abstractListItemSource[Value, Item]) {
+	a.items = slices.Delete(a.items, 0, len(a.items))
+	a.itemSource = source
+
+	// Don't check the selectability of each item, as this might load the items.
+	n := a.ItemCount()
+	maps.DeleteFunc(a.selectedIndices, func(idx int, _ struct{}) bool {
+		return idx >= n
+	})
+}
+
 func (a *abstractList[Value, Item]) ItemCount() int {
+	if a.itemSource != nil {
+		return a.itemSource.itemCount()
+	}
 	return len(a.items)
 }
 
 func (a *abstractList[Value, Item]) ItemByIndex(index int) (Item, bool) {
-	if index < 0 || index >= len(a.items) {
+	if index < 0 || index >= a.ItemCount() {
 		var item Item
 		return item, false
 	}
+	if a.itemSource != nil {
+		return a.itemSource.itemAt(index), true
+	}
 	return a.items[index], true
 }
 
+// indexByValue returns the index of the item with the given value, or -1 if there is no such item.
+func (a *abstractList[Value, Item]) indexByValue(value Value) int {
+	if a.itemSource != nil {
+		return a.itemSource.indexByValue(value)
+	}
+	return slices.IndexFunc(a.items, func(item Item) bool {
+		return item.value() == value
+	})
+}
+
 func (a *abstractList[Value, Item]) SelectItemByIndex(index int, forceFireEvents bool) {
-	if index < 0 || index >= len(a.items) {
+	if index < 0 || index >= a.ItemCount() {
 		a.SelectItemsByIndices(nil, forceFireEvents)
 		return
 	}
@@ -139,7 +180,7 @@ func (a *abstractList[Value, Item]) SelectItemByIndex(index int, forceFireEvents
 }
 
 func (a *abstractList[Value, Item]) ExtendItemSelectionByIndex(index int, forceFireEvents bool) {
-	if index < 0 || index >= len(a.items) {
+	if index < 0 || index >= a.ItemCount() {
 		return
 	}
 
@@ -182,7 +223,7 @@ func (a *abstractList[Value, Item]) ExtendItemSelectionByIndex(index int, forceF
 }
 
 func (a *abstractList[Value, Item]) ToggleItemSelectionByIndex(index int, forceFireEvents bool) {
-	if index < 0 || index >= len(a.items) {
+	if index < 0 || index >= a.ItemCount() {
 		return
 	}
 
@@ -235,10 +276,10 @@ func (a *abstractList[Value, Item]) SelectItemsByIndices(indices []int, forceFir
 func (a *abstractList[Value, Item]) SelectAllItems(forceFireEvents bool) {
 	clear(a.tmpIndexMap)
 	if a.tmpIndexMap == nil {
-		a.tmpIndexMap = make(map[int]struct{}, len(a.items))
+		a.tmpIndexMap = make(map[int]struct{}, a.ItemCount())
 	}
 	newAnchor := math.MaxInt
-	for i := range a.items {
+	for i := range a.ItemCount() {
 		a.tmpIndexMap[i] = struct{}{}
 		if !a.isItemIndexSelectable(i) {
 			continue
@@ -314,17 +355,22 @@ func (a *abstractList[Value, Item]) selectItemsByIndices(indices map[int]struct{
 }
 
 func (a *abstractList[Value, Item]) SelectItemByValue(value Value, forceFireEvents bool) {
-	idx := slices.IndexFunc(a.items, func(item Item) bool {
-		return item.value() == value
-	})
-	a.SelectItemByIndex(idx, forceFireEvents)
+	a.SelectItemByIndex(a.indexByValue(value), forceFireEvents)
 }
 
 func (a *abstractList[Value, Item]) SelectItemsByValues(values []Value, forceFireEvents bool) {
 	a.tmpIndexSlice = a.tmpIndexSlice[:0]
-	for i, item := range a.items {
-		if slices.Contains(values, item.value()) {
-			a.tmpIndexSlice = append(a.tmpIndexSlice, i)
+	if a.itemSource != nil {
+		for _, value := range values {
+			if idx := a.itemSource.indexByValue(value); idx >= 0 {
+				a.tmpIndexSlice = append(a.tmpIndexSlice, idx)
+			}
+		}
+	} else {
+		for i, item := range a.items {
+			if slices.Contains(values, item.value()) {
+				a.tmpIndexSlice = append(a.tmpIndexSlice, i)
+			}
 		}
 	}
 	a.SelectItemsByIndices(a.tmpIndexSlice, forceFireEvents)
@@ -340,13 +386,14 @@ func (a *abstractList[Value, Item]) SelectedItem() (Item, bool) {
 		var item Item
 		return item, false
 	}
-	return a.items[idx], true
+	return a.ItemByIndex(idx)
 }
 
 func (a *abstractList[Value, Item]) AppendSelectedItems(items []Item) []Item {
 	a.tmpIndexSlice = a.AppendSelectedItemIndices(a.tmpIndexSlice[:0])
 	for _, idx := range a.tmpIndexSlice {
-		items = append(items, a.items[idx])
+		item, _ := a.ItemByIndex(idx)
+		items = append(items, item)
 	}
 	return items
 }
@@ -394,7 +441,7 @@ func (a *abstractList[Value, Item]) SelectGroupAt(index int, forceFireEvents boo
 		return
 	}
 
-	if index < 0 || index >= len(a.items) {
+	if index < 0 || index >= a.ItemCount() {
 		a.SelectItemsByIndices(nil, forceFireEvents)
 		return
 	}
@@ -413,7 +460,7 @@ func (a *abstractList[Value, Item]) SelectGroupAt(index int, forceFireEvents boo
 
 	// Search backwards
 	for i := index - 1; i >= 0; i-- {
-		if !a.items[i].visible() {
+		if item, _ := a.ItemByIndex(i); !item.visible() {
 			continue
 		}
 		if _, ok := a.selectedIndices[i]; !ok {
@@ -423,8 +470,8 @@ func (a *abstractList[Value, Item]) SelectGroupAt(index int, forceFireEvents boo
 	}
 
 	// Search forwards
-	for i := index + 1; i < len(a.items); i++ {
-		if !a.items[i].visible() {
+	for i := index + 1; i < a.ItemCount(); i++ {
+		if item, _ := a.ItemByIndex(i); !item.visible() {
 			continue
 		}
 		if _, ok := a.selectedIndices[i]; !ok {
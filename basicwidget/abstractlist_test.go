@@ -504,3 +504,54 @@ func TestAbstractListSelectGroupAt(t *testing.T) {
 		t.Errorf("SelectedItemCount = %d, want 0", got)
 	}
 }
+
+func TestAbstractListItemSource(t *testing.T) {
+	type Item = basicwidget.AbstractListTestItem[int]
+	var l basicwidget.AbstractList[int, Item]
+	l.SetMultiSelection(true)
+
+	source := &basicwidget.AbstractListTestItemSource{
+		Count: 1000000,
+	}
+	l.SetItemSource(source)
+
+	if got, want := l.ItemCount(), 1000000; got != want {
+		t.Errorf("ItemCount() = %d, want %d", got, want)
+	}
+	if item, ok := l.ItemByIndex(999998); !ok || item.Value != 999998 {
+		t.Errorf("ItemByIndex(999998) = %v, %v; want {999998}, true", item, ok)
+	}
+	if _, ok := l.ItemByIndex(1000000); ok {
+		t.Error("ItemByIndex(1000000) returned true")
+	}
+
+	l.SelectItemByValue(999998, false)
+	if got, want := l.SelectedItemIndex(), 999998; got != want {
+		t.Errorf("SelectedItemIndex() = %d, want %d", got, want)
+	}
+	// Index 999999 is not selectable.
+	l.SelectItemsByValues([]int{10, 999999, 20}, false)
+	if got, want := l.AppendSelectedItemIndices(nil), []int{10, 20}; !slices.Equal(got, want) {
+		t.Errorf("AppendSelectedItemIndices() = %v, want %v", got, want)
+	}
+
+	// Only the queried items should be loaded.
+	if got := source.ItemAtCallCount; got > 100 {
+		t.Errorf("ItemAtCallCount = %d, want <= 100", got)
+	}
+
+	// Shrinking the source drops the out-of-range selection.
+	source.Count = 15
+	l.SetItemSource(source)
+	if got, want := l.AppendSelectedItemIndices(nil), []int{10}; !slices.Equal(got, want) {
+		t.Errorf("AppendSelectedItemIndices() after shrinking = %v, want %v", got, want)
+	}
+
+	// SetItems discards the source.
+	l.SetItems([]Item{
+		{Value: 1, Selectable: true, Visible: true},
+	})
+	if got, want := l.ItemCount(), 1; got != want {
+		t.Errorf("ItemCount() after SetItems = %d, want %d", got, want)
+	}
+}
@@ -8,9 +8,16 @@ import (
 	"math/big"
 	"strings"
 
+	"golang.org/x/text/language"
+
 	"github.com/guigui-gui/guigui"
 )
 
+// abstractNumberInput is the value model shared by [NumberInput] and [Slider].
+//
+// Values are fixed-point numbers: value, min, max and step are scaled by 10^fractionDigits.
+// The integer APIs take and return values in the actual unit, so they are not affected by fractionDigits
+// except that the fraction part is truncated.
 type abstractNumberInput struct {
 	value   big.Int
 	min     big.Int
@@ -33,11 +40,20 @@ type abstractNumberInput struct {
 	maxString   string
 	stepString  string
 
-	onValueChanged       func(value int, committed bool)
-	onValueChangedString func(value string, force bool)
-	onValueChangedBigInt func(value *big.Int, committed bool)
-	onValueChangedInt64  func(value int64, committed bool)
-	onValueChangedUint64 func(value uint64, committed bool)
+	fractionDigits       int
+	digitGroupingEnabled bool
+	numberSymbols        numberSymbols
+
+	// minIntegerDigits is the minimum number of the integer digits of a non-negative value string.
+	// The integer part is padded with zeros.
+	minIntegerDigits int
+
+	onValueChanged        func(value int, committed bool)
+	onValueChangedString  func(value string, force bool)
+	onValueChangedBigInt  func(value *big.Int, committed bool)
+	onValueChangedInt64   func(value int64, committed bool)
+	onValueChangedUint64  func(value uint64, committed bool)
+	onValueChangedFloat64 func(value float64, committed bool)
 }
 
 func (a *abstractNumberInput) writeStateKey(w *guigui.StateKeyWriter) {
@@ -45,6 +61,11 @@ func (a *abstractNumberInput) writeStateKey(w *guigui.StateKeyWriter) {
 	w.WriteString(a.minString)
 	w.WriteString(a.maxString)
 	w.WriteString(a.stepString)
+	w.WriteInt64(int64(a.fractionDigits))
+	w.WriteBool(a.digitGroupingEnabled)
+	w.WriteString(a.numberSymbols.decimal)
+	w.WriteString(a.numberSymbols.group)
+	w.WriteInt64(int64(a.minIntegerDigits))
 }
 
 func (a *abstractNumberInput) OnValueChanged(f func(value int, committed bool)) {
@@ -67,12 +88,16 @@ func (a *abstractNumberInput) OnValueChangedUint64(f func(value uint64, committe
 	a.onValueChangedUint64 = f
 }
 
+func (a *abstractNumberInput) OnValueChangedFloat64(f func(value float64, committed bool)) {
+	a.onValueChangedFloat64 = f
+}
+
 func (a *abstractNumberInput) dispatchValueChangeEvents(force bool, committed bool) {
 	if a.onValueChanged != nil {
 		a.onValueChanged(a.Value(), committed)
 	}
 	if a.onValueChangedString != nil {
-		a.onValueChangedString(a.value.String(), force)
+		a.onValueChangedString(a.ValueString(), force)
 	}
 	if a.onValueChangedBigInt != nil {
 		a.onValueChangedBigInt(a.ValueBigInt(), committed)
@@ -83,88 +108,208 @@ func (a *abstractNumberInput) dispatchValueChangeEvents(force bool, committed bo
 	if a.onValueChangedUint64 != nil {
 		a.onValueChangedUint64(a.ValueUint64(), committed)
 	}
+	if a.onValueChangedFloat64 != nil {
+		a.onValueChangedFloat64(a.ValueFloat64(), committed)
+	}
+}
+
+// FractionDigits returns the number of digits after the decimal point.
+func (a *abstractNumberInput) FractionDigits() int {
+	return a.fractionDigits
+}
+
+// SetFractionDigits sets the number of digits after the decimal point.
+// The current value, minimum, maximum and step are rounded to the new precision.
+func (a *abstractNumberInput) SetFractionDigits(digits int) {
+	if digits < 0 {
+		panic("basicwidget: digits must be non-negative")
+	}
+	if a.fractionDigits == digits {
+		return
+	}
+	rescale := func(v *big.Int) {
+		if digits > a.fractionDigits {
+			v.Mul(v, pow10BigInt(digits-a.fractionDigits))
+		} else {
+			roundDivBigInt(v, (&big.Int{}).Set(v), pow10BigInt(a.fractionDigits-digits))
+		}
+	}
+	rescale(&a.value)
+	a.valueString = a.value.Text(10)
+	if a.minSet {
+		rescale(&a.min)
+		a.minString = a.min.Text(10)
+	}
+	if a.maxSet {
+		rescale(&a.max)
+		a.maxString = a.max.Text(10)
+	}
+	if a.stepSet {
+		rescale(&a.step)
+		a.stepString = a.step.Text(10)
+	}
+	a.fractionDigits = digits
+}
+
+func (a *abstractNumberInput) setDigitGroupingEnabled(enabled bool) {
+	a.digitGroupingEnabled = enabled
+}
+
+func (a *abstractNumberInput) setMinimumIntegerDigits(digits int) {
+	a.minIntegerDigits = digits
+}
+
+func (a *abstractNumberInput) setLocale(locale language.Tag) {
+	a.numberSymbols = numberSymbolsForLocale(locale)
+}
+
+func (a *abstractNumberInput) symbols() numberSymbols {
+	if a.numberSymbols == (numberSymbols{}) {
+		return defaultNumberSymbols
+	}
+	return a.numberSymbols
+}
+
+// fromInt returns value in the actual unit as a fixed-point number.
+func (a *abstractNumberInput) fromInt(value *big.Int) *big.Int {
+	if a.fractionDigits == 0 {
+		return value
+	}
+	return (&big.Int{}).Mul(value, pow10BigInt(a.fractionDigits))
+}
+
+// fromFloat64 returns value in the actual unit as a fixed-point number.
+// fromFloat64 returns nil if value is NaN or infinite.
+func (a *abstractNumberInput) fromFloat64(value float64) *big.Int {
+	r := (&big.Rat{}).SetFloat64(value)
+	if r == nil {
+		return nil
+	}
+	return ratToFixedPoint(r, a.fractionDigits)
+}
+
+// toInt returns the integer part of the fixed-point number value, truncated toward zero.
+func (a *abstractNumberInput) toInt(value *big.Int) *big.Int {
+	if a.fractionDigits == 0 {
+		return (&big.Int{}).Set(value)
+	}
+	return (&big.Int{}).Quo(value, pow10BigInt(a.fractionDigits))
+}
+
+// intValue returns the integer part of the value.
+func (a *abstractNumberInput) intValue() *big.Int {
+	return a.toInt(&a.value)
 }
 
 func (a *abstractNumberInput) Value() int {
-	if a.value.Cmp(&maxInt) > 0 {
+	return bigIntToInt(a.intValue())
+}
+
+// bigIntToInt returns v as int, saturating at the int range.
+func bigIntToInt(v *big.Int) int {
+	if v.Cmp(&maxInt) > 0 {
 		return math.MaxInt
 	}
-	if a.value.Cmp(&minInt) < 0 {
+	if v.Cmp(&minInt) < 0 {
 		return math.MinInt
 	}
-	if a.value.IsInt64() {
-		return int(a.value.Int64())
+	if v.IsInt64() {
+		return int(v.Int64())
 	}
 	return 0
 }
 
 func (a *abstractNumberInput) ValueString() string {
-	return a.value.String()
+	str := formatFixedPoint(&a.value, a.fractionDigits, a.symbols(), a.digitGroupingEnabled)
+	if a.value.Sign() >= 0 && a.fractionDigits == 0 && len(str) < a.minIntegerDigits {
+		str = strings.Repeat("0", a.minIntegerDigits-len(str)) + str
+	}
+	return str
 }
 
 func (a *abstractNumberInput) ValueBigInt() *big.Int {
-	return (&big.Int{}).Set(&a.value)
+	return a.intValue()
 }
 
 func (a *abstractNumberInput) ValueInt64() int64 {
-	if a.value.IsInt64() {
-		return a.value.Int64()
+	v := a.intValue()
+	if v.IsInt64() {
+		return v.Int64()
 	}
-	if a.value.Cmp(&maxInt64) > 0 {
+	if v.Cmp(&maxInt64) > 0 {
 		return math.MaxInt64
 	}
-	if a.value.Cmp(&minInt64) < 0 {
+	if v.Cmp(&minInt64) < 0 {
 		return math.MinInt64
 	}
 	return 0
 }
 
 func (a *abstractNumberInput) ValueUint64() uint64 {
-	if a.value.IsUint64() {
-		return a.value.Uint64()
+	v := a.intValue()
+	if v.IsUint64() {
+		return v.Uint64()
 	}
-	if a.value.Cmp(&maxUint64) > 0 {
+	if v.Cmp(&maxUint64) > 0 {
 		return math.MaxUint64
 	}
-	if a.value.Cmp(big.NewInt(0)) < 0 {
+	if v.Cmp(big.NewInt(0)) < 0 {
 		return 0
 	}
 	return 0
 }
 
+func (a *abstractNumberInput) ValueFloat64() float64 {
+	return fixedPointToFloat64(&a.value, a.fractionDigits)
+}
+
 func (a *abstractNumberInput) SetValue(value int, committed bool) {
-	a.setValue((&big.Int{}).SetInt64(int64(value)), false, committed)
+	a.setValue(a.fromInt((&big.Int{}).SetInt64(int64(value))), false, committed)
 }
 
 func (a *abstractNumberInput) SetValueBigInt(value *big.Int, committed bool) {
-	a.setValue(value, false, committed)
+	a.setValue(a.fromInt(value), false, committed)
 }
 
 func (a *abstractNumberInput) SetValueInt64(value int64, committed bool) {
-	a.setValue((&big.Int{}).SetInt64(value), false, committed)
+	a.setValue(a.fromInt((&big.Int{}).SetInt64(value)), false, committed)
 }
 
 func (a *abstractNumberInput) SetValueUint64(value uint64, committed bool) {
-	a.setValue((&big.Int{}).SetUint64(value), false, committed)
+	a.setValue(a.fromInt((&big.Int{}).SetUint64(value)), false, committed)
+}
+
+func (a *abstractNumberInput) SetValueFloat64(value float64, committed bool) {
+	if v := a.fromFloat64(value); v != nil {
+		a.setValue(v, false, committed)
+	}
 }
 
 func (a *abstractNumberInput) ForceSetValue(value int, committed bool) {
-	a.setValue((&big.Int{}).SetInt64(int64(value)), true, committed)
+	a.setValue(a.fromInt((&big.Int{}).SetInt64(int64(value))), true, committed)
 }
 
 func (a *abstractNumberInput) ForceSetValueBigInt(value *big.Int, committed bool) {
-	a.setValue(value, true, committed)
+	a.setValue(a.fromInt(value), true, committed)
 }
 
 func (a *abstractNumberInput) ForceSetValueInt64(value int64, committed bool) {
-	a.setValue((&big.Int{}).SetInt64(value), true, committed)
+	a.setValue(a.fromInt((&big.Int{}).SetInt64(value)), true, committed)
 }
 
 func (a *abstractNumberInput) ForceSetValueUint64(value uint64, committed bool) {
-	a.setValue((&big.Int{}).SetUint64(value), true, committed)
+	a.setValue(a.fromInt((&big.Int{}).SetUint64(value)), true, committed)
 }
 
+func (a *abstractNumberInput) ForceSetValueFloat64(value float64, committed bool) {
+	if v := a.fromFloat64(value); v != nil {
+		a.setValue(v, true, committed)
+	}
+}
+
+// setValue sets the value as a fixed-point number scaled by 10^fractionDigits.
 func (a *abstractNumberInput) setValue(value *big.Int, force bool, committed bool) {
+	value = (&big.Int{}).Set(value)
 	a.clamp(value)
 	if a.value.Cmp(value) == 0 {
 		return
@@ -178,14 +323,14 @@ func (a *abstractNumberInput) MinimumValueBigInt() *big.Int {
 	if !a.minSet {
 		return nil
 	}
-	return (&big.Int{}).Set(&a.min)
+	return a.toInt(&a.min)
 }
 
 func (a *abstractNumberInput) SetMinimumValue(minimum int) {
-	a.min.SetInt64(int64(minimum))
+	a.min.Set(a.fromInt((&big.Int{}).SetInt64(int64(minimum))))
 	a.minSet = true
 	a.minString = a.min.Text(10)
-	a.SetValueBigInt((&big.Int{}).Set(&a.value), true)
+	a.setValue(&a.value, false, true)
 }
 
 func (a *abstractNumberInput) SetMinimumValueBigInt(minimum *big.Int) {
@@ -195,38 +340,49 @@ func (a *abstractNumberInput) SetMinimumValueBigInt(minimum *big.Int) {
 		a.minString = ""
 		return
 	}
-	a.min.Set(minimum)
+	a.min.Set(a.fromInt(minimum))
 	a.minSet = true
 	a.minString = a.min.Text(10)
-	a.SetValueBigInt((&big.Int{}).Set(&a.value), true)
+	a.setValue(&a.value, false, true)
 }
 
 func (a *abstractNumberInput) SetMinimumValueInt64(minimum int64) {
-	a.min.SetInt64(minimum)
+	a.min.Set(a.fromInt((&big.Int{}).SetInt64(minimum)))
 	a.minSet = true
 	a.minString = a.min.Text(10)
-	a.SetValueBigInt((&big.Int{}).Set(&a.value), true)
+	a.setValue(&a.value, false, true)
 }
 
 func (a *abstractNumberInput) SetMinimumValueUint64(minimum uint64) {
-	a.min.SetUint64(minimum)
+	a.min.Set(a.fromInt((&big.Int{}).SetUint64(minimum)))
+	a.minSet = true
+	a.minString = a.min.Text(10)
+	a.setValue(&a.value, false, true)
+}
+
+func (a *abstractNumberInput) SetMinimumValueFloat64(minimum float64) {
+	v := a.fromFloat64(minimum)
+	if v == nil {
+		return
+	}
+	a.min.Set(v)
 	a.minSet = true
 	a.minString = a.min.Text(10)
-	a.SetValueBigInt((&big.Int{}).Set(&a.value), true)
+	a.setValue(&a.value, false, true)
 }
 
 func (a *abstractNumberInput) MaximumValueBigInt() *big.Int {
 	if !a.maxSet {
 		return nil
 	}
-	return (&big.Int{}).Set(&a.max)
+	return a.toInt(&a.max)
 }
 
 func (a *abstractNumberInput) SetMaximumValue(maximum int) {
-	a.max.SetInt64(int64(maximum))
+	a.max.Set(a.fromInt((&big.Int{}).SetInt64(int64(maximum))))
 	a.maxSet = true
 	a.maxString = a.max.Text(10)
-	a.SetValueBigInt((&big.Int{}).Set(&a.value), true)
+	a.setValue(&a.value, false, true)
 }
 
 func (a *abstractNumberInput) SetMaximumValueBigInt(maximum *big.Int) {
@@ -236,30 +392,41 @@ func (a *abstractNumberInput) SetMaximumValueBigInt(maximum *big.Int) {
 		a.maxString = ""
 		return
 	}
-	a.max.Set(maximum)
+	a.max.Set(a.fromInt(maximum))
 	a.maxSet = true
 	a.maxString = a.max.Text(10)
-	a.SetValueBigInt((&big.Int{}).Set(&a.value), true)
+	a.setValue(&a.value, false, true)
 }
 
 func (a *abstractNumberInput) SetMaximumValueInt64(maximum int64) {
-	a.max.SetInt64(maximum)
+	a.max.Set(a.fromInt((&big.Int{}).SetInt64(maximum)))
 	a.maxSet = true
 	a.maxString = a.max.Text(10)
-	a.SetValueBigInt((&big.Int{}).Set(&a.value), true)
+	a.setValue(&a.value, false, true)
 }
 
 func (a *abstractNumberInput) SetMaximumValueUint64(maximum uint64) {
-	a.max.SetUint64(maximum)
+	a.max.Set(a.fromInt((&big.Int{}).SetUint64(maximum)))
 	a.maxSet = true
 	a.maxString = a.max.Text(10)
-	a.SetValueBigInt((&big.Int{}).Set(&a.value), true)
+	a.setValue(&a.value, false, true)
+}
+
+func (a *abstractNumberInput) SetMaximumValueFloat64(maximum float64) {
+	v := a.fromFloat64(maximum)
+	if v == nil {
+		return
+	}
+	a.max.Set(v)
+	a.maxSet = true
+	a.maxString = a.max.Text(10)
+	a.setValue(&a.value, false, true)
 }
 
 func (a *abstractNumberInput) SetStep(step int) {
 	oldSet := a.stepSet
 	oldStep := a.step
-	a.step.SetInt64(int64(step))
+	a.step.Set(a.fromInt((&big.Int{}).SetInt64(int64(step))))
 	a.stepSet = true
 	if !oldSet || oldStep.Cmp(&a.step) != 0 {
 		a.stepString = a.step.Text(10)
@@ -273,7 +440,7 @@ func (a *abstractNumberInput) SetStepBigInt(step *big.Int) {
 		a.step = big.Int{}
 		a.stepSet = false
 	} else {
-		a.step.Set(step)
+		a.step.Set(a.fromInt(step))
 		a.stepSet = true
 	}
 	if oldSet != a.stepSet || oldStep.Cmp(&a.step) != 0 {
@@ -288,7 +455,7 @@ func (a *abstractNumberInput) SetStepBigInt(step *big.Int) {
 func (a *abstractNumberInput) SetStepInt64(step int64) {
 	oldSet := a.stepSet
 	oldStep := a.step
-	a.step.SetInt64(step)
+	a.step.Set(a.fromInt((&big.Int{}).SetInt64(step)))
 	a.stepSet = true
 	if !oldSet || oldStep.Cmp(&a.step) != 0 {
 		a.stepString = a.step.Text(10)
@@ -298,7 +465,21 @@ func (a *abstractNumberInput) SetStepInt64(step int64) {
 func (a *abstractNumberInput) SetStepUint64(step uint64) {
 	oldSet := a.stepSet
 	oldStep := a.step
-	a.step.SetUint64(step)
+	a.step.Set(a.fromInt((&big.Int{}).SetUint64(step)))
+	a.stepSet = true
+	if !oldSet || oldStep.Cmp(&a.step) != 0 {
+		a.stepString = a.step.Text(10)
+	}
+}
+
+func (a *abstractNumberInput) SetStepFloat64(step float64) {
+	v := a.fromFloat64(step)
+	if v == nil {
+		return
+	}
+	oldSet := a.stepSet
+	oldStep := a.step
+	a.step.Set(v)
 	a.stepSet = true
 	if !oldSet || oldStep.Cmp(&a.step) != 0 {
 		a.stepString = a.step.Text(10)
@@ -317,11 +498,17 @@ func (a *abstractNumberInput) clamp(value *big.Int) {
 }
 
 func (a *abstractNumberInput) Rate() float64 {
+	return a.rateOf(&a.value)
+}
+
+// rateOf returns the position of the fixed-point number value in the range [min, max] as [0, 1].
+// rateOf returns NaN if the range is not set.
+func (a *abstractNumberInput) rateOf(value *big.Int) float64 {
 	if !a.maxSet || !a.minSet {
 		return math.NaN()
 	}
 
-	numer := (&big.Int{}).Sub(&a.value, &a.min)
+	numer := (&big.Int{}).Sub(value, &a.min)
 	denom := (&big.Int{}).Sub(&a.max, &a.min)
 	if denom.Sign() == 0 {
 		return math.NaN()
@@ -350,17 +537,22 @@ var numberTextReplacer = strings.NewReplacer(
 	"\uff17", "7",
 	"\uff18", "8",
 	"\uff19", "9",
+	"\uff0c", ",",
+	"\uff0e", ".",
 )
 
-func (a *abstractNumberInput) SetString(text string, force bool, committed bool) {
+// SetString sets the value parsed from text.
+// SetString returns false and doesn't change the value if text is not a valid number.
+func (a *abstractNumberInput) SetString(text string, force bool, committed bool) bool {
 	text = strings.TrimSpace(text)
 	text = numberTextReplacer.Replace(text)
 
-	var v big.Int
-	if _, ok := v.SetString(text, 10); !ok {
-		return
+	v, ok := parseFixedPoint(text, a.fractionDigits, a.symbols())
+	if !ok {
+		return false
 	}
-	a.setValue(&v, force, committed)
+	a.setValue(v, force, committed)
+	return true
 }
 
 func (n *abstractNumberInput) Increment() {
@@ -368,7 +560,7 @@ func (n *abstractNumberInput) Increment() {
 	if n.stepSet {
 		step.Set(&n.step)
 	} else {
-		step.SetInt64(1)
+		step.Set(n.fromInt(big.NewInt(1)))
 	}
 	n.setValue((&big.Int{}).Add(&n.value, &step), true, true)
 }
@@ -378,7 +570,7 @@ func (n *abstractNumberInput) Decrement() {
 	if n.stepSet {
 		step.Set(&n.step)
 	} else {
-		step.SetInt64(1)
+		step.Set(n.fromInt(big.NewInt(1)))
 	}
 	n.setValue((&big.Int{}).Sub(&n.value, &step), true, true)
 }
@@ -252,3 +252,38 @@ func DrawRoundedRectBorderWithSharpCorners(context *guigui.Context, dst *ebiten.
 	draw.DrawNinePatchParts(dst, bounds, ensureWhiteRoundedRectBorder(radius, borderWidth, borderType, context.ColorMode()), clr1, clr2, sharpCorners.bools())
 	draw.DrawNinePatchParts(dst, bounds, ensureWhiteRectBorder(radius, borderWidth, borderType, context.ColorMode()), clr1, clr2, sharpCorners.invertedBools())
 }
+
+var (
+	theGradientVertices [4]ebiten.Vertex
+	theGradientIndices  = []uint32{0, 1, 2, 1, 3, 2}
+)
+
+// DrawGradientRect fills the rectangle with the colors interpolated bilinearly between the four corners.
+func DrawGradientRect(dst *ebiten.Image, bounds image.Rectangle, topLeft, topRight, bottomLeft, bottomRight color.Color) {
+	if !dst.Bounds().Overlaps(bounds) {
+		return
+	}
+	b := whiteImage.Bounds()
+	for i, clr := range [...]color.Color{topLeft, topRight, bottomLeft, bottomRight} {
+		v := &theGradientVertices[i]
+		x, y := bounds.Min.X, bounds.Min.Y
+		if i%2 == 1 {
+			x = bounds.Max.X
+		}
+		if i/2 == 1 {
+			y = bounds.Max.Y
+		}
+		v.DstX = float32(x)
+		v.DstY = float32(y)
+		v.SrcX = float32(b.Min.X+1) + 0.5
+		v.SrcY = float32(b.Min.Y+1) + 0.5
+		r, g, bl, a := clr.RGBA()
+		v.ColorR = float32(r) / 0xffff
+		v.ColorG = float32(g) / 0xffff
+		v.ColorB = float32(bl) / 0xffff
+		v.ColorA = float32(a) / 0xffff
+	}
+	op := &ebiten.DrawTrianglesOptions{}
+	op.ColorScaleMode = ebiten.ColorScaleModePremultipliedAlpha
+	dst.DrawTriangles32(theGradientVertices[:], theGradientIndices, whiteImage, op)
+}
@@ -8,7 +8,6 @@ import (
 	"slices"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
 
 	"github.com/guigui-gui/guigui"
 	"github.com/guigui-gui/guigui/basicwidget/basicwidgetdraw"
@@ -19,6 +18,7 @@ var (
 	buttonEventDown   guigui.EventKey = guigui.GenerateEventKey()
 	buttonEventUp     guigui.EventKey = guigui.GenerateEventKey()
 	buttonEventRepeat guigui.EventKey = guigui.GenerateEventKey()
+	buttonEventClick  guigui.EventKey = guigui.GenerateEventKey()
 )
 
 type Corners struct {
@@ -67,6 +67,9 @@ type Button struct {
 	sharpCorners         Corners
 	pairedButton         *Button
 	prevCanPress         bool
+
+	pressedClick    guigui.Click
+	hasPressedClick bool
 }
 
 func (b *Button) OnDown(f func(context *guigui.Context)) {
@@ -77,6 +80,14 @@ func (b *Button) OnUp(f func(context *guigui.Context)) {
 	guigui.SetEventHandler(b, buttonEventUp, f)
 }
 
+// OnClick sets the event handler that is called when a mouse button is pressed and released on the button.
+// Any of the left, right, and middle buttons can click the button.
+// click is the one recognized when the mouse button was pressed,
+// so click.Count can be used to detect a double click.
+func (b *Button) OnClick(f func(context *guigui.Context, click guigui.Click)) {
+	guigui.SetEventHandler(b, buttonEventClick, f)
+}
+
 func (b *Button) setOnRepeat(f func(context *guigui.Context)) {
 	guigui.SetEventHandler(b, buttonEventRepeat, f)
 }
@@ -333,7 +344,11 @@ func (b *Button) HandlePointingInput(context *guigui.Context, widgetBounds *guig
 		// IsMouseButtonJustPressed and IsMouseButtonJustReleased can be true at the same time as of Ebitengine v2.9.
 		// Check both.
 		var justPressedOrReleased bool
-		if inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
+		if click, ok := widgetBounds.ClickAtCursor(); ok && (!b.keepPressed || b.keepPressedClickable) {
+			b.pressedClick = click
+			b.hasPressedClick = true
+		}
+		if guigui.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
 			if b.keepPressed && !b.keepPressedClickable {
 				return guigui.AbortHandlingInputByWidget(b)
 			}
@@ -345,7 +360,7 @@ func (b *Button) HandlePointingInput(context *guigui.Context, widgetBounds *guig
 			}
 			justPressedOrReleased = true
 		}
-		if inpututil.IsMouseButtonJustReleased(ebiten.MouseButtonLeft) && b.pressed {
+		if guigui.IsMouseButtonJustReleased(ebiten.MouseButtonLeft) && b.pressed {
 			if b.keepPressed && !b.keepPressedClickable {
 				return guigui.AbortHandlingInputByWidget(b)
 			}
@@ -353,6 +368,11 @@ func (b *Button) HandlePointingInput(context *guigui.Context, widgetBounds *guig
 			guigui.DispatchEvent(b, buttonEventUp)
 			justPressedOrReleased = true
 		}
+		if b.hasPressedClick && guigui.IsMouseButtonJustReleased(b.pressedClick.Button) {
+			b.hasPressedClick = false
+			guigui.DispatchEvent(b, buttonEventClick, b.pressedClick)
+			justPressedOrReleased = true
+		}
 		if justPressedOrReleased {
 			return guigui.HandleInputByWidget(b)
 		}
@@ -361,9 +381,12 @@ func (b *Button) HandlePointingInput(context *guigui.Context, widgetBounds *guig
 			return guigui.HandleInputByWidget(b)
 		}
 	}
-	if !ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
+	if !guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
 		b.setPressed(false)
 	}
+	if b.hasPressedClick && !guigui.IsMouseButtonPressed(b.pressedClick.Button) {
+		b.hasPressedClick = false
+	}
 	return guigui.HandleInputResult{}
 }
 
@@ -452,11 +475,11 @@ func (b *Button) Draw(context *guigui.Context, widgetBounds *guigui.WidgetBounds
 }
 
 func (b *Button) canPress(context *guigui.Context, widgetBounds *guigui.WidgetBounds) bool {
-	return context.IsEnabled(b) && widgetBounds.IsHitAtCursor() && !ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft) && (!b.keepPressed || b.keepPressedClickable)
+	return context.IsEnabled(b) && widgetBounds.IsHitAtCursor() && !guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft) && (!b.keepPressed || b.keepPressedClickable)
 }
 
 func (b *Button) isActive(context *guigui.Context, widgetBounds *guigui.WidgetBounds) bool {
-	return context.IsEnabled(b) && ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft) && widgetBounds.IsHitAtCursor() && (b.pressed || b.pairedButton != nil && b.pairedButton.pressed)
+	return context.IsEnabled(b) && guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft) && widgetBounds.IsHitAtCursor() && (b.pressed || b.pairedButton != nil && b.pairedButton.pressed)
 }
 
 func (b *Button) isPressed(context *guigui.Context, widgetBounds *guigui.WidgetBounds) bool {
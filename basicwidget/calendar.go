@@ -0,0 +1,595 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package basicwidget
+
+import (
+	"image"
+	"strconv"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+
+	"github.com/guigui-gui/guigui"
+	"github.com/guigui-gui/guigui/basicwidget/basicwidgetdraw"
+	"github.com/guigui-gui/guigui/basicwidget/internal/draw"
+)
+
+var (
+	calendarEventValueChanged      guigui.EventKey = guigui.GenerateEventKey()
+	calendarEventValueRangeChanged guigui.EventKey = guigui.GenerateEventKey()
+)
+
+var (
+	calendarDayEventDown guigui.EventKey = guigui.GenerateEventKey()
+)
+
+const (
+	calendarWeekCount = 6
+	calendarDayCount  = 7 * calendarWeekCount
+)
+
+// Calendar is a widget that shows the days of a month in a grid and lets the user select a date.
+//
+// Dates are represented as [time.Time] values. Only the date part of a value in its location is used,
+// and the values returned by Calendar are the midnight in the local location.
+// A zero [time.Time] value represents no date.
+//
+// The first day of a week, the month names and the weekday names depend on [guigui.Context.FirstLocale].
+//
+// When Calendar is focused, the arrow keys move the focused day, Page Up and Page Down move the month,
+// Shift with Page Up and Page Down moves the year, Home and End move to the start and the end of the week,
+// and Enter or Space selects the focused day.
+type Calendar struct {
+	guigui.DefaultWidget
+
+	prevButton   Button
+	nextButton   Button
+	titleText    Text
+	weekdayTexts [7]Text
+	days         guigui.WidgetSlice[*calendarDay]
+
+	value        civilDate
+	valueEnd     civilDate
+	rangeEnabled bool
+	min          civilDate
+	max          civilDate
+	disabledFunc func(date time.Time) bool
+
+	displayedYear  int
+	displayedMonth time.Month
+	focusedDate    civilDate
+	focused        bool
+
+	firstDate civilDate
+
+	onPrevButtonDown func(context *guigui.Context)
+	onNextButtonDown func(context *guigui.Context)
+	onDayDowns       [calendarDayCount]func(context *guigui.Context)
+}
+
+// OnValueChanged sets the event handler that is called when the user selects a date.
+// OnValueChanged is not called in the range selection mode. Use [Calendar.OnValueRangeChanged] instead.
+func (c *Calendar) OnValueChanged(f func(context *guigui.Context, value time.Time)) {
+	guigui.SetEventHandler(c, calendarEventValueChanged, f)
+}
+
+// OnValueRangeChanged sets the event handler that is called when the user selects a date in the range selection mode.
+// end is zero while the user is selecting the end of the range.
+func (c *Calendar) OnValueRangeChanged(f func(context *guigui.Context, start, end time.Time)) {
+	guigui.SetEventHandler(c, calendarEventValueRangeChanged, f)
+}
+
+// Value returns the selected date, or the start of the selected range in the range selection mode.
+// Value returns a zero value if no date is selected.
+func (c *Calendar) Value() time.Time {
+	return c.value.time()
+}
+
+// SetValue sets the selected date.
+// A zero value clears the selection.
+// SetValue also clears the end of the range in the range selection mode.
+// If the value is changed, the month of the value is shown.
+func (c *Calendar) SetValue(value time.Time) {
+	v := civilDateOf(value)
+	if c.value == v && c.valueEnd.isZero() {
+		return
+	}
+	c.value = v
+	c.valueEnd = civilDate{}
+	if !c.value.isZero() {
+		c.showDate(c.value)
+	}
+}
+
+// ValueRange returns the start and the end of the selected range in the range selection mode.
+func (c *Calendar) ValueRange() (start, end time.Time) {
+	return c.value.time(), c.valueEnd.time()
+}
+
+// SetValueRange sets the start and the end of the selected range in the range selection mode.
+// If end is before start, they are swapped.
+func (c *Calendar) SetValueRange(start, end time.Time) {
+	s := civilDateOf(start)
+	e := civilDateOf(end)
+	if !s.isZero() && !e.isZero() && e.compare(s) < 0 {
+		s, e = e, s
+	}
+	if c.value == s && c.valueEnd == e {
+		return
+	}
+	c.value = s
+	c.valueEnd = e
+	if !c.value.isZero() {
+		c.showDate(c.value)
+	}
+}
+
+// IsRangeSelectionEnabled reports whether the user selects a range of dates.
+func (c *Calendar) IsRangeSelectionEnabled() bool {
+	return c.rangeEnabled
+}
+
+// SetRangeSelectionEnabled sets whether the user selects a range of dates.
+//
+// In the range selection mode, the first click selects the start of a range and the second click selects the end.
+func (c *Calendar) SetRangeSelectionEnabled(enabled bool) {
+	c.rangeEnabled = enabled
+	if !enabled {
+		c.valueEnd = civilDate{}
+	}
+}
+
+// MinimumValue returns the minimum selectable date.
+func (c *Calendar) MinimumValue() time.Time {
+	return c.min.time()
+}
+
+// SetMinimumValue sets the minimum selectable date.
+// A zero value removes the minimum.
+func (c *Calendar) SetMinimumValue(minimum time.Time) {
+	c.min = civilDateOf(minimum)
+}
+
+// MaximumValue returns the maximum selectable date.
+func (c *Calendar) MaximumValue() time.Time {
+	return c.max.time()
+}
+
+// SetMaximumValue sets the maximum selectable date.
+// A zero value removes the maximum.
+func (c *Calendar) SetMaximumValue(maximum time.Time) {
+	c.max = civilDateOf(maximum)
+}
+
+// SetDisabledDateFunc sets the function to report whether a date is disabled.
+// A disabled date cannot be selected.
+//
+// f is called at every build. If the result of f changes without other state changes, call [guigui.RequestRebuild].
+func (c *Calendar) SetDisabledDateFunc(f func(date time.Time) bool) {
+	c.disabledFunc = f
+}
+
+// DisplayedMonth returns the year and the month shown in the calendar.
+func (c *Calendar) DisplayedMonth() (year int, month time.Month) {
+	c.ensureDisplayedMonth()
+	return c.displayedYear, c.displayedMonth
+}
+
+// SetDisplayedMonth sets the year and the month shown in the calendar.
+func (c *Calendar) SetDisplayedMonth(year int, month time.Month) {
+	d := civilDate{year: year, month: month, day: 1}.normalize()
+	c.displayedYear = d.year
+	c.displayedMonth = d.month
+}
+
+// IsDateEnabled reports whether the date is selectable.
+func (c *Calendar) IsDateEnabled(date time.Time) bool {
+	return c.isDateEnabled(civilDateOf(date))
+}
+
+func (c *Calendar) isDateEnabled(date civilDate) bool {
+	if date.isZero() {
+		return false
+	}
+	if !c.min.isZero() && date.compare(c.min) < 0 {
+		return false
+	}
+	if !c.max.isZero() && date.compare(c.max) > 0 {
+		return false
+	}
+	if c.disabledFunc != nil && c.disabledFunc(date.time()) {
+		return false
+	}
+	return true
+}
+
+func (c *Calendar) WriteStateKey(w *guigui.StateKeyWriter) {
+	writeCivilDate(w, c.value)
+	writeCivilDate(w, c.valueEnd)
+	writeCivilDate(w, c.min)
+	writeCivilDate(w, c.max)
+	writeCivilDate(w, c.focusedDate)
+	w.WriteBool(c.rangeEnabled)
+	w.WriteInt64(int64(c.displayedYear))
+	w.WriteInt64(int64(c.displayedMonth))
+	w.WriteBool(c.focused)
+}
+
+func writeCivilDate(w *guigui.StateKeyWriter, date civilDate) {
+	w.WriteInt64(int64(date.year))
+	w.WriteInt64(int64(date.month))
+	w.WriteInt64(int64(date.day))
+}
+
+func (c *Calendar) ensureDisplayedMonth() {
+	if c.displayedYear != 0 {
+		return
+	}
+	d := c.value
+	if d.isZero() {
+		d = civilDateOf(time.Now())
+	}
+	c.displayedYear = d.year
+	c.displayedMonth = d.month
+}
+
+// showDate makes the month of the date displayed.
+func (c *Calendar) showDate(date civilDate) {
+	c.displayedYear = date.year
+	c.displayedMonth = date.month
+	c.focusedDate = date
+}
+
+// clampDate clamps the date into the range of the minimum and the maximum dates.
+func (c *Calendar) clampDate(date civilDate) civilDate {
+	if !c.min.isZero() && date.compare(c.min) < 0 {
+		return c.min
+	}
+	if !c.max.isZero() && date.compare(c.max) > 0 {
+		return c.max
+	}
+	return date
+}
+
+func (c *Calendar) ensureFocusedDate() {
+	if !c.focusedDate.isZero() && c.focusedDate.year == c.displayedYear && c.focusedDate.month == c.displayedMonth {
+		return
+	}
+	d := c.value
+	if d.isZero() || d.year != c.displayedYear || d.month != c.displayedMonth {
+		d = civilDate{year: c.displayedYear, month: c.displayedMonth, day: 1}
+		if today := civilDateOf(time.Now()); today.year == d.year && today.month == d.month {
+			d = today
+		}
+	}
+	c.focusedDate = c.clampDate(d)
+}
+
+func (c *Calendar) moveDisplayedMonth(months int) {
+	c.ensureDisplayedMonth()
+	d := civilDate{year: c.displayedYear, month: c.displayedMonth, day: 1}.addMonths(months)
+	c.displayedYear = d.year
+	c.displayedMonth = d.month
+}
+
+func (c *Calendar) canMoveDisplayedMonth(months int) bool {
+	c.ensureDisplayedMonth()
+	first := civilDate{year: c.displayedYear, month: c.displayedMonth, day: 1}.addMonths(months)
+	if months < 0 && !c.min.isZero() {
+		last := first.addDays(daysInMonth(first.year, first.month) - 1)
+		return last.compare(c.min) >= 0
+	}
+	if months > 0 && !c.max.isZero() {
+		return first.compare(c.max) <= 0
+	}
+	return true
+}
+
+func (c *Calendar) moveFocusedDate(date civilDate) {
+	c.showDate(c.clampDate(date))
+}
+
+func (c *Calendar) selectDate(date civilDate) {
+	if !c.isDateEnabled(date) {
+		return
+	}
+	c.showDate(date)
+
+	if !c.rangeEnabled {
+		if c.value == date {
+			return
+		}
+		c.value = date
+		guigui.DispatchEvent(c, calendarEventValueChanged, c.value.time())
+		return
+	}
+
+	if c.value.isZero() || !c.valueEnd.isZero() || date.compare(c.value) < 0 {
+		c.value = date
+		c.valueEnd = civilDate{}
+	} else {
+		c.valueEnd = date
+	}
+	guigui.DispatchEvent(c, calendarEventValueRangeChanged, c.value.time(), c.valueEnd.time())
+}
+
+func (c *Calendar) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
+	c.days.SetLen(calendarDayCount)
+
+	adder.AddWidget(&c.prevButton)
+	adder.AddWidget(&c.titleText)
+	adder.AddWidget(&c.nextButton)
+	for i := range c.weekdayTexts {
+		adder.AddWidget(&c.weekdayTexts[i])
+	}
+	for i := range c.days.Len() {
+		adder.AddWidget(c.days.At(i))
+	}
+
+	c.ensureDisplayedMonth()
+	c.ensureFocusedDate()
+
+	locale := context.FirstLocale()
+	symbols := dateSymbolsForLocale(locale)
+	firstWeekday := firstWeekdayForLocale(locale)
+	enabled := context.IsEnabled(c)
+
+	imgPrev, err := theResourceImages.Get("keyboard_arrow_left", context.ColorMode())
+	if err != nil {
+		return err
+	}
+	imgNext, err := theResourceImages.Get("keyboard_arrow_right", context.ColorMode())
+	if err != nil {
+		return err
+	}
+	c.prevButton.SetIcon(imgPrev)
+	if c.onPrevButtonDown == nil {
+		c.onPrevButtonDown = func(context *guigui.Context) {
+			c.moveDisplayedMonth(-1)
+		}
+	}
+	c.prevButton.setOnRepeat(c.onPrevButtonDown)
+	context.SetEnabled(&c.prevButton, c.canMoveDisplayedMonth(-1))
+
+	c.nextButton.SetIcon(imgNext)
+	if c.onNextButtonDown == nil {
+		c.onNextButtonDown = func(context *guigui.Context) {
+			c.moveDisplayedMonth(1)
+		}
+	}
+	c.nextButton.setOnRepeat(c.onNextButtonDown)
+	context.SetEnabled(&c.nextButton, c.canMoveDisplayedMonth(1))
+
+	c.titleText.SetValue(symbols.monthYear(symbols.months[c.displayedMonth-1], c.displayedYear))
+	c.titleText.SetBold(true)
+	c.titleText.SetHorizontalAlign(HorizontalAlignCenter)
+	c.titleText.SetVerticalAlign(VerticalAlignMiddle)
+
+	for i := range c.weekdayTexts {
+		t := &c.weekdayTexts[i]
+		t.SetValue(symbols.shortWeekdays[(int(firstWeekday)+i)%7])
+		t.SetScale(0.85)
+		t.SetColor(basicwidgetdraw.TextColor(context.ColorMode(), false))
+		t.SetHorizontalAlign(HorizontalAlignCenter)
+		t.SetVerticalAlign(VerticalAlignMiddle)
+	}
+
+	first := civilDate{year: c.displayedYear, month: c.displayedMonth, day: 1}
+	c.firstDate = first.addDays(-((int(first.weekday()) - int(firstWeekday) + 7) % 7))
+	today := civilDateOf(time.Now())
+	for i := range c.days.Len() {
+		date := c.firstDate.addDays(i)
+		day := c.days.At(i)
+		day.date = date
+		day.text.SetValue(strconv.Itoa(date.day))
+		day.inMonth = date.month == c.displayedMonth
+		day.today = date == today
+		day.selected = date == c.value || date == c.valueEnd
+		day.inRange = c.rangeEnabled && !c.value.isZero() && !c.valueEnd.isZero() && date.compare(c.value) > 0 && date.compare(c.valueEnd) < 0
+		day.focused = c.focused && date == c.focusedDate
+		context.SetEnabled(day, enabled && c.isDateEnabled(date))
+
+		if c.onDayDowns[i] == nil {
+			c.onDayDowns[i] = func(context *guigui.Context) {
+				context.SetFocused(c, true)
+				c.selectDate(c.firstDate.addDays(i))
+			}
+		}
+		guigui.SetEventHandler(day, calendarDayEventDown, c.onDayDowns[i])
+	}
+
+	return nil
+}
+
+func (c *Calendar) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
+	b := widgetBounds.Bounds()
+	u := UnitSize(context)
+
+	layouter.LayoutWidget(&c.prevButton, image.Rect(b.Min.X, b.Min.Y, b.Min.X+u, b.Min.Y+u))
+	layouter.LayoutWidget(&c.nextButton, image.Rect(b.Max.X-u, b.Min.Y, b.Max.X, b.Min.Y+u))
+	layouter.LayoutWidget(&c.titleText, image.Rect(b.Min.X+u, b.Min.Y, b.Max.X-u, b.Min.Y+u))
+
+	columnX := func(i int) int {
+		return b.Min.X + b.Dx()*i/7
+	}
+	for i := range c.weekdayTexts {
+		layouter.LayoutWidget(&c.weekdayTexts[i], image.Rect(columnX(i), b.Min.Y+u, columnX(i+1), b.Min.Y+2*u))
+	}
+
+	top := b.Min.Y + 2*u
+	rowY := func(i int) int {
+		return top + (b.Max.Y-top)*i/calendarWeekCount
+	}
+	for i := range c.days.Len() {
+		col := i % 7
+		row := i / 7
+		layouter.LayoutWidget(c.days.At(i), image.Rect(columnX(col), rowY(row), columnX(col+1), rowY(row+1)))
+	}
+}
+
+// HandleButtonInput implements [guigui.Widget.HandleButtonInput].
+func (c *Calendar) HandleButtonInput(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
+	if !context.IsEnabled(c) || !context.IsFocusedOrHasFocusedChild(c) {
+		return guigui.HandleInputResult{}
+	}
+	c.ensureDisplayedMonth()
+	c.ensureFocusedDate()
+
+	d := c.focusedDate
+	shift := guigui.IsKeyPressed(ebiten.KeyShift)
+	// dayInWeek is the index of the focused day in its week.
+	dayInWeek := (int(d.weekday()) - int(firstWeekdayForLocale(context.FirstLocale())) + 7) % 7
+	switch {
+	case isKeyRepeating(ebiten.KeyLeft):
+		c.moveFocusedDate(d.addDays(-1))
+	case isKeyRepeating(ebiten.KeyRight):
+		c.moveFocusedDate(d.addDays(1))
+	case isKeyRepeating(ebiten.KeyUp):
+		c.moveFocusedDate(d.addDays(-7))
+	case isKeyRepeating(ebiten.KeyDown):
+		c.moveFocusedDate(d.addDays(7))
+	case isKeyRepeating(ebiten.KeyPageUp) && shift:
+		c.moveFocusedDate(d.addMonths(-12))
+	case isKeyRepeating(ebiten.KeyPageDown) && shift:
+		c.moveFocusedDate(d.addMonths(12))
+	case isKeyRepeating(ebiten.KeyPageUp):
+		c.moveFocusedDate(d.addMonths(-1))
+	case isKeyRepeating(ebiten.KeyPageDown):
+		c.moveFocusedDate(d.addMonths(1))
+	case guigui.IsKeyJustPressed(ebiten.KeyHome):
+		c.moveFocusedDate(d.addDays(-dayInWeek))
+	case guigui.IsKeyJustPressed(ebiten.KeyEnd):
+		c.moveFocusedDate(d.addDays(6 - dayInWeek))
+	case guigui.IsKeyJustPressed(ebiten.KeyEnter), guigui.IsKeyJustPressed(ebiten.KeySpace):
+		c.selectDate(d)
+	default:
+		return guigui.HandleInputResult{}
+	}
+	return guigui.HandleInputByWidget(c)
+}
+
+func (c *Calendar) Tick(context *guigui.Context, widgetBounds *guigui.WidgetBounds) error {
+	c.focused = context.IsFocusedOrHasFocusedChild(c)
+	return nil
+}
+
+func (c *Calendar) Measure(context *guigui.Context, constraints guigui.Constraints) image.Point {
+	u := UnitSize(context)
+	w := 7 * u * 5 / 4
+	w = max(w, c.titleText.Measure(context, guigui.Constraints{}).X+2*u)
+	return image.Pt(w, (2+calendarWeekCount)*u)
+}
+
+type calendarDay struct {
+	guigui.DefaultWidget
+
+	text Text
+
+	date     civilDate
+	inMonth  bool
+	today    bool
+	selected bool
+	inRange  bool
+	focused  bool
+
+	prevCanPress bool
+}
+
+func (c *calendarDay) WriteStateKey(w *guigui.StateKeyWriter) {
+	w.WriteBool(c.inMonth)
+	w.WriteBool(c.today)
+	w.WriteBool(c.selected)
+	w.WriteBool(c.inRange)
+	w.WriteBool(c.focused)
+}
+
+func (c *calendarDay) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
+	adder.AddWidget(&c.text)
+
+	switch {
+	case c.selected && context.IsEnabled(c):
+		c.text.SetColor(basicwidgetdraw.TextColor(ebiten.ColorModeDark, true))
+	case !c.inMonth:
+		c.text.SetColor(basicwidgetdraw.TextColor(context.ColorMode(), false))
+	default:
+		c.text.SetColor(basicwidgetdraw.TextColor(context.ColorMode(), context.IsEnabled(c)))
+	}
+	c.text.SetBold(c.today || c.selected)
+	c.text.SetTabular(true)
+	c.text.SetHorizontalAlign(HorizontalAlignCenter)
+	c.text.SetVerticalAlign(VerticalAlignMiddle)
+	return nil
+}
+
+func (c *calendarDay) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
+	layouter.LayoutWidget(&c.text, widgetBounds.Bounds())
+}
+
+func (c *calendarDay) HandlePointingInput(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
+	if context.IsEnabled(c) && widgetBounds.IsHitAtCursor() && guigui.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
+		guigui.DispatchEvent(c, calendarDayEventDown)
+		return guigui.HandleInputByWidget(c)
+	}
+	return guigui.HandleInputResult{}
+}
+
+func (c *calendarDay) Tick(context *guigui.Context, widgetBounds *guigui.WidgetBounds) error {
+	if canPress := c.canPress(context, widgetBounds); canPress != c.prevCanPress {
+		c.prevCanPress = canPress
+		guigui.RequestRedraw(c)
+	}
+	return nil
+}
+
+func (c *calendarDay) CursorShape(context *guigui.Context, widgetBounds *guigui.WidgetBounds) (ebiten.CursorShapeType, bool) {
+	if c.canPress(context, widgetBounds) {
+		return ebiten.CursorShapePointer, true
+	}
+	return 0, true
+}
+
+func (c *calendarDay) canPress(context *guigui.Context, widgetBounds *guigui.WidgetBounds) bool {
+	return context.IsEnabled(c) && widgetBounds.IsHitAtCursor() && !guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft)
+}
+
+func (c *calendarDay) Draw(context *guigui.Context, widgetBounds *guigui.WidgetBounds, dst *ebiten.Image) {
+	cm := context.ColorMode()
+	bounds := widgetBounds.Bounds()
+	r := RoundedCornerRadius(context)
+
+	if c.inRange {
+		vector.FillRect(dst, float32(bounds.Min.X), float32(bounds.Min.Y), float32(bounds.Dx()), float32(bounds.Dy()), draw.Color2(cm, draw.SemanticColorAccent, 0.9, 0.3), false)
+	}
+
+	// Draw a day as a square in the cell.
+	s := min(bounds.Dx(), bounds.Dy())
+	b := image.Rectangle{
+		Min: image.Pt(bounds.Min.X+(bounds.Dx()-s)/2, bounds.Min.Y+(bounds.Dy()-s)/2),
+	}
+	b.Max = b.Min.Add(image.Pt(s, s))
+
+	switch {
+	case c.selected:
+		clr := draw.Color(cm, draw.SemanticColorAccent, 0.5)
+		if !context.IsEnabled(c) {
+			clr = basicwidgetdraw.ControlColor(cm, false)
+		}
+		basicwidgetdraw.DrawRoundedRect(context, dst, b, clr, r)
+	case c.canPress(context, widgetBounds):
+		basicwidgetdraw.DrawRoundedRect(context, dst, b, draw.Color2(cm, draw.SemanticColorBase, 0.9, 0.3), r)
+	}
+
+	if c.today && !c.selected {
+		clr := draw.Color(cm, draw.SemanticColorAccent, 0.5)
+		basicwidgetdraw.DrawRoundedRectBorder(context, dst, b, clr, clr, r, float32(1*context.Scale()), basicwidgetdraw.RoundedRectBorderTypeRegular)
+	}
+	if c.focused {
+		clr := draw.Color(cm, draw.SemanticColorAccent, 0.8)
+		if c.selected {
+			clr = draw.Color(cm, draw.SemanticColorAccent, 0.3)
+		}
+		basicwidgetdraw.DrawRoundedRectBorder(context, dst, b, clr, clr, r, float32(2*context.Scale()), basicwidgetdraw.RoundedRectBorderTypeRegular)
+	}
+}
@@ -8,7 +8,6 @@ import (
 	"image/color"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
 
 	"github.com/guigui-gui/guigui"
 	"github.com/guigui-gui/guigui/basicwidget/basicwidgetdraw"
@@ -60,18 +59,18 @@ func (c *Checkbox) setPressed(pressed bool) {
 
 func (c *Checkbox) HandlePointingInput(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
 	if context.IsEnabled(c) && widgetBounds.IsHitAtCursor() {
-		if inpututil.IsMouseButtonJustReleased(ebiten.MouseButtonLeft) {
+		if guigui.IsMouseButtonJustReleased(ebiten.MouseButtonLeft) {
 			c.SetValue(!c.value)
 			c.setPressed(false)
 			return guigui.HandleInputByWidget(c)
 		}
-		if ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
+		if guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
 			context.SetFocused(c, true)
 			c.setPressed(true)
 			return guigui.HandleInputByWidget(c)
 		}
 	}
-	if !context.IsEnabled(c) || !ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
+	if !context.IsEnabled(c) || !guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
 		c.setPressed(false)
 	}
 	return guigui.HandleInputResult{}
@@ -93,11 +92,11 @@ func (c *Checkbox) CursorShape(context *guigui.Context, widgetBounds *guigui.Wid
 }
 
 func (c *Checkbox) canPress(context *guigui.Context, widgetBounds *guigui.WidgetBounds) bool {
-	return context.IsEnabled(c) && widgetBounds.IsHitAtCursor() && !ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft)
+	return context.IsEnabled(c) && widgetBounds.IsHitAtCursor() && !guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft)
 }
 
 func (c *Checkbox) isActive(context *guigui.Context, widgetBounds *guigui.WidgetBounds) bool {
-	return context.IsEnabled(c) && widgetBounds.IsHitAtCursor() && ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft) && c.pressed
+	return context.IsEnabled(c) && widgetBounds.IsHitAtCursor() && guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft) && c.pressed
 }
 
 func (c *Checkbox) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package basicwidget
+
+import (
+	"image"
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/guigui-gui/guigui"
+)
+
+var (
+	colorButtonEventValueChanged guigui.EventKey = guigui.GenerateEventKey()
+)
+
+// ColorButton is a compact button that shows a color and opens a [ColorPicker] in a [Popup].
+type ColorButton struct {
+	guigui.DefaultWidget
+
+	button        Button
+	buttonContent colorButtonContent
+	popup         Popup
+	popupContent  colorButtonPopupContent
+
+	onButtonDown              func(context *guigui.Context)
+	onColorPickerValueChanged func(context *guigui.Context, value color.Color, committed bool)
+}
+
+// OnValueChanged sets the event handler that is called when the color is changed by the user.
+// See [ColorPicker.OnValueChanged].
+func (c *ColorButton) OnValueChanged(f func(context *guigui.Context, value color.Color, committed bool)) {
+	guigui.SetEventHandler(c, colorButtonEventValueChanged, f)
+}
+
+// Value returns the color.
+func (c *ColorButton) Value() color.Color {
+	return c.popupContent.colorPicker.Value()
+}
+
+// SetValue sets the color.
+func (c *ColorButton) SetValue(value color.Color) {
+	c.popupContent.colorPicker.SetValue(value)
+}
+
+// ColorPicker returns the color picker in the popup.
+func (c *ColorButton) ColorPicker() *ColorPicker {
+	return &c.popupContent.colorPicker
+}
+
+// IsOpen reports whether the color picker popup is open.
+func (c *ColorButton) IsOpen() bool {
+	return c.popup.IsOpen()
+}
+
+// SetOpen opens or closes the color picker popup.
+func (c *ColorButton) SetOpen(open bool) {
+	c.popup.SetOpen(open)
+}
+
+func (c *ColorButton) WriteStateKey(w *guigui.StateKeyWriter) {
+	writeColor(w, c.Value())
+}
+
+func (c *ColorButton) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
+	adder.AddWidget(&c.button)
+	adder.AddWidget(&c.popup)
+
+	c.buttonContent.color = c.Value()
+	c.button.SetContent(&c.buttonContent)
+	if c.onButtonDown == nil {
+		c.onButtonDown = func(context *guigui.Context) {
+			if c.popup.IsOpen() {
+				c.popup.SetOpen(false)
+				return
+			}
+			c.popup.SetOpen(true)
+			context.SetFocused(&c.popupContent.colorPicker.area, true)
+		}
+	}
+	c.button.OnDown(c.onButtonDown)
+
+	if c.onColorPickerValueChanged == nil {
+		c.onColorPickerValueChanged = func(context *guigui.Context, value color.Color, committed bool) {
+			guigui.DispatchEvent(c, colorButtonEventValueChanged, value, committed)
+		}
+	}
+	c.popupContent.colorPicker.OnValueChanged(c.onColorPickerValueChanged)
+
+	c.popup.SetContent(&c.popupContent)
+	c.popup.SetCloseByClickingOutside(true)
+
+	return nil
+}
+
+// HandleButtonInput implements [guigui.Widget.HandleButtonInput].
+func (c *ColorButton) HandleButtonInput(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
+	if c.popup.IsOpen() && guigui.IsKeyJustPressed(ebiten.KeyEscape) {
+		c.popup.SetOpen(false)
+		context.SetFocused(&c.button, true)
+		return guigui.HandleInputByWidget(c)
+	}
+	return guigui.HandleInputResult{}
+}
+
+func (c *ColorButton) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
+	b := widgetBounds.Bounds()
+	layouter.LayoutWidget(&c.button, b)
+
+	// Exclude the button bounds from close-by-clicking-outside detection,
+	// so that clicking the button while the popup is open toggles the popup.
+	c.popup.popup.Widget().setCloseByClickingOutsideExcludedRect(b)
+
+	popupSize := c.popupContent.Measure(context, guigui.Constraints{})
+	appBounds := context.AppBounds()
+
+	// Position the popup below the button.
+	popupPos := image.Pt(b.Min.X, b.Max.Y)
+
+	// If there is not enough room below, position above.
+	if popupPos.Y+popupSize.Y > appBounds.Max.Y {
+		popupPos.Y = b.Min.Y - popupSize.Y
+	}
+	if popupPos.X+popupSize.X > appBounds.Max.X {
+		popupPos.X = max(appBounds.Max.X-popupSize.X, appBounds.Min.X)
+	}
+
+	layouter.LayoutWidget(&c.popup, image.Rectangle{
+		Min: popupPos,
+		Max: popupPos.Add(popupSize),
+	})
+}
+
+func (c *ColorButton) Measure(context *guigui.Context, constraints guigui.Constraints) image.Point {
+	return c.button.Measure(context, constraints)
+}
+
+type colorButtonContent struct {
+	guigui.DefaultWidget
+
+	color color.Color
+}
+
+func (c *colorButtonContent) WriteStateKey(w *guigui.StateKeyWriter) {
+	writeColor(w, c.color)
+}
+
+func (c *colorButtonContent) Draw(context *guigui.Context, widgetBounds *guigui.WidgetBounds, dst *ebiten.Image) {
+	u := UnitSize(context)
+	drawColorSwatch(context, dst, widgetBounds.Bounds().Inset(u/4), c.color, context.IsEnabled(c))
+}
+
+func (c *colorButtonContent) Measure(context *guigui.Context, constraints guigui.Constraints) image.Point {
+	u := UnitSize(context)
+	return image.Pt(2*u, u)
+}
+
+type colorButtonPopupContent struct {
+	guigui.DefaultWidget
+
+	colorPicker ColorPicker
+}
+
+func (c *colorButtonPopupContent) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
+	adder.AddWidget(&c.colorPicker)
+	return nil
+}
+
+func (c *colorButtonPopupContent) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
+	p := UnitSize(context) / 4
+	layouter.LayoutWidget(&c.colorPicker, widgetBounds.Bounds().Inset(p))
+}
+
+func (c *colorButtonPopupContent) Measure(context *guigui.Context, constraints guigui.Constraints) image.Point {
+	p := UnitSize(context) / 4
+	return c.colorPicker.Measure(context, guigui.Constraints{}).Add(image.Pt(2*p, 2*p))
+}
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package basicwidget
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// hsvToRGB converts HSV to RGB.
+// h is in [0, 360), and the other values are in [0, 1].
+func hsvToRGB(h, s, v float64) (r, g, b float64) {
+	h = math.Mod(h, 360)
+	if h < 0 {
+		h += 360
+	}
+	c := v * s
+	x := c * (1 - math.Abs(math.Mod(h/60, 2)-1))
+	m := v - c
+	switch {
+	case h < 60:
+		r, g, b = c, x, 0
+	case h < 120:
+		r, g, b = x, c, 0
+	case h < 180:
+		r, g, b = 0, c, x
+	case h < 240:
+		r, g, b = 0, x, c
+	case h < 300:
+		r, g, b = x, 0, c
+	default:
+		r, g, b = c, 0, x
+	}
+	return r + m, g + m, b + m
+}
+
+// rgbToHSV converts RGB to HSV.
+// The hue of an achromatic color is 0.
+func rgbToHSV(r, g, b float64) (h, s, v float64) {
+	v = max(r, g, b)
+	c := v - min(r, g, b)
+	if v > 0 {
+		s = c / v
+	}
+	if c == 0 {
+		return 0, s, v
+	}
+	switch v {
+	case r:
+		h = math.Mod((g-b)/c, 6)
+	case g:
+		h = (b-r)/c + 2
+	default:
+		h = (r-g)/c + 4
+	}
+	h *= 60
+	if h < 0 {
+		h += 360
+	}
+	return h, s, v
+}
+
+// hsvToHSL converts the saturation and the value of HSV to the saturation and the lightness of HSL.
+func hsvToHSL(s, v float64) (sl, l float64) {
+	l = v * (1 - s/2)
+	if l > 0 && l < 1 {
+		sl = (v - l) / min(l, 1-l)
+	}
+	return sl, l
+}
+
+// hslToHSV converts the saturation and the lightness of HSL to the saturation and the value of HSV.
+func hslToHSV(sl, l float64) (s, v float64) {
+	v = l + sl*min(l, 1-l)
+	if v > 0 {
+		s = 2 * (1 - l/v)
+	}
+	return s, v
+}
+
+func colorChannelToByte(x float64) uint8 {
+	return uint8(math.Round(min(max(x, 0), 1) * 0xff))
+}
+
+// formatHexColor formats the color as #RRGGBB, or #RRGGBBAA if withAlpha is true.
+func formatHexColor(r, g, b, a float64, withAlpha bool) string {
+	if withAlpha {
+		return fmt.Sprintf("#%02X%02X%02X%02X", colorChannelToByte(r), colorChannelToByte(g), colorChannelToByte(b), colorChannelToByte(a))
+	}
+	return fmt.Sprintf("#%02X%02X%02X", colorChannelToByte(r), colorChannelToByte(g), colorChannelToByte(b))
+}
+
+// parseHexColor parses a color in the form of #RGB, #RGBA, #RRGGBB or #RRGGBBAA.
+// The leading # is optional.
+// The alpha is 1 if the text does not have it.
+func parseHexColor(text string) (r, g, b, a float64, ok bool) {
+	text = strings.TrimPrefix(strings.TrimSpace(text), "#")
+	var digits int
+	switch len(text) {
+	case 3, 4:
+		digits = 1
+	case 6, 8:
+		digits = 2
+	default:
+		return 0, 0, 0, 0, false
+	}
+	var channels [4]float64
+	channels[3] = 1
+	for i := range len(text) / digits {
+		v, err := strconv.ParseUint(text[i*digits:(i+1)*digits], 16, 8)
+		if err != nil {
+			return 0, 0, 0, 0, false
+		}
+		if digits == 1 {
+			v *= 0x11
+		}
+		channels[i] = float64(v) / 0xff
+	}
+	return channels[0], channels[1], channels[2], channels[3], true
+}
@@ -0,0 +1,158 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package basicwidget_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/guigui-gui/guigui/basicwidget"
+)
+
+func nearlyEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestHSVToRGB(t *testing.T) {
+	testCases := []struct {
+		h, s, v float64
+		r, g, b float64
+	}{
+		{h: 0, s: 0, v: 0, r: 0, g: 0, b: 0},
+		{h: 0, s: 0, v: 1, r: 1, g: 1, b: 1},
+		{h: 0, s: 1, v: 1, r: 1, g: 0, b: 0},
+		{h: 60, s: 1, v: 1, r: 1, g: 1, b: 0},
+		{h: 120, s: 1, v: 1, r: 0, g: 1, b: 0},
+		{h: 180, s: 1, v: 1, r: 0, g: 1, b: 1},
+		{h: 240, s: 1, v: 1, r: 0, g: 0, b: 1},
+		{h: 300, s: 1, v: 1, r: 1, g: 0, b: 1},
+		{h: 360, s: 1, v: 1, r: 1, g: 0, b: 0},
+		{h: 210, s: 0.5, v: 0.8, r: 0.4, g: 0.6, b: 0.8},
+	}
+	for _, tc := range testCases {
+		r, g, b := basicwidget.HSVToRGB(tc.h, tc.s, tc.v)
+		if !nearlyEqual(r, tc.r) || !nearlyEqual(g, tc.g) || !nearlyEqual(b, tc.b) {
+			t.Errorf("HSVToRGB(%v, %v, %v): got: (%v, %v, %v), want: (%v, %v, %v)", tc.h, tc.s, tc.v, r, g, b, tc.r, tc.g, tc.b)
+		}
+		if tc.h >= 360 {
+			continue
+		}
+		h, s, v := basicwidget.RGBToHSV(tc.r, tc.g, tc.b)
+		if tc.s == 0 {
+			// The hue of an achromatic color is not preserved.
+			tc.h = 0
+		}
+		if !nearlyEqual(h, tc.h) || !nearlyEqual(s, tc.s) || !nearlyEqual(v, tc.v) {
+			t.Errorf("RGBToHSV(%v, %v, %v): got: (%v, %v, %v), want: (%v, %v, %v)", tc.r, tc.g, tc.b, h, s, v, tc.h, tc.s, tc.v)
+		}
+	}
+}
+
+func TestHSVToHSL(t *testing.T) {
+	testCases := []struct {
+		s, v  float64
+		sl, l float64
+	}{
+		{s: 0, v: 0, sl: 0, l: 0},
+		{s: 0, v: 1, sl: 0, l: 1},
+		{s: 1, v: 1, sl: 1, l: 0.5},
+		{s: 0.5, v: 0.8, sl: 0.5, l: 0.6},
+	}
+	for _, tc := range testCases {
+		sl, l := basicwidget.HSVToHSL(tc.s, tc.v)
+		if !nearlyEqual(sl, tc.sl) || !nearlyEqual(l, tc.l) {
+			t.Errorf("HSVToHSL(%v, %v): got: (%v, %v), want: (%v, %v)", tc.s, tc.v, sl, l, tc.sl, tc.l)
+		}
+		if tc.v == 0 {
+			continue
+		}
+		s, v := basicwidget.HSLToHSV(tc.sl, tc.l)
+		if !nearlyEqual(s, tc.s) || !nearlyEqual(v, tc.v) {
+			t.Errorf("HSLToHSV(%v, %v): got: (%v, %v), want: (%v, %v)", tc.sl, tc.l, s, v, tc.s, tc.v)
+		}
+	}
+}
+
+func TestFormatHexColor(t *testing.T) {
+	testCases := []struct {
+		r, g, b, a float64
+		withAlpha  bool
+		out        string
+	}{
+		{r: 0, g: 0, b: 0, a: 1, out: "#000000"},
+		{r: 1, g: 0.5, b: 0, a: 1, out: "#FF8000"},
+		{r: 1, g: 0.5, b: 0, a: 0.5, withAlpha: true, out: "#FF800080"},
+		{r: 2, g: -1, b: 0, a: 1, out: "#FF0000"},
+	}
+	for _, tc := range testCases {
+		if got := basicwidget.FormatHexColor(tc.r, tc.g, tc.b, tc.a, tc.withAlpha); got != tc.out {
+			t.Errorf("FormatHexColor(%v, %v, %v, %v, %t): got: %q, want: %q", tc.r, tc.g, tc.b, tc.a, tc.withAlpha, got, tc.out)
+		}
+	}
+}
+
+func TestParseHexColor(t *testing.T) {
+	testCases := []struct {
+		text       string
+		r, g, b, a float64
+		ok         bool
+	}{
+		{text: "#FF8000", r: 1, g: 0x80 / 255.0, b: 0, a: 1, ok: true},
+		{text: "ff8000", r: 1, g: 0x80 / 255.0, b: 0, a: 1, ok: true},
+		{text: " #FF800080 ", r: 1, g: 0x80 / 255.0, b: 0, a: 0x80 / 255.0, ok: true},
+		{text: "#F80", r: 1, g: 0x88 / 255.0, b: 0, a: 1, ok: true},
+		{text: "#F808", r: 1, g: 0x88 / 255.0, b: 0, a: 0x88 / 255.0, ok: true},
+		{text: "#FF800", ok: false},
+		{text: "#GG8000", ok: false},
+		{text: "#+F8000", ok: false},
+		{text: "", ok: false},
+	}
+	for _, tc := range testCases {
+		r, g, b, a, ok := basicwidget.ParseHexColor(tc.text)
+		if ok != tc.ok {
+			t.Errorf("ParseHexColor(%q): ok: got: %t, want: %t", tc.text, ok, tc.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if !nearlyEqual(r, tc.r) || !nearlyEqual(g, tc.g) || !nearlyEqual(b, tc.b) || !nearlyEqual(a, tc.a) {
+			t.Errorf("ParseHexColor(%q): got: (%v, %v, %v, %v), want: (%v, %v, %v, %v)", tc.text, r, g, b, a, tc.r, tc.g, tc.b, tc.a)
+		}
+	}
+}
+
+func TestHSVRoundTrip(t *testing.T) {
+	testCases := []struct {
+		name    string
+		h, s, v float64
+
+		// The expected HSV after converting to RGB and back.
+		// The hue is normalized to [0, 360), and the components that don't affect the color are 0.
+		outH, outS, outV float64
+	}{
+		{name: "red", h: 0, s: 1, v: 1, outH: 0, outS: 1, outV: 1},
+		{name: "just before the hue wrap", h: 359.5, s: 1, v: 1, outH: 359.5, outS: 1, outV: 1},
+		{name: "hue wrap", h: 360, s: 0.5, v: 0.8, outH: 0, outS: 0.5, outV: 0.8},
+		{name: "negative hue", h: -30, s: 0.5, v: 0.8, outH: 330, outS: 0.5, outV: 0.8},
+		{name: "zero saturation", h: 210, s: 0, v: 0.6, outH: 0, outS: 0, outV: 0.6},
+		{name: "zero value", h: 210, s: 0.5, v: 0, outH: 0, outS: 0, outV: 0},
+		{name: "white", h: 120, s: 0, v: 1, outH: 0, outS: 0, outV: 1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r, g, b := basicwidget.HSVToRGB(tc.h, tc.s, tc.v)
+			h, s, v := basicwidget.RGBToHSV(r, g, b)
+			if !nearlyEqual(h, tc.outH) || !nearlyEqual(s, tc.outS) || !nearlyEqual(v, tc.outV) {
+				t.Errorf("RGBToHSV(HSVToRGB(%v, %v, %v)): got: (%v, %v, %v), want: (%v, %v, %v)", tc.h, tc.s, tc.v, h, s, v, tc.outH, tc.outS, tc.outV)
+			}
+
+			sl, l := basicwidget.HSVToHSL(s, v)
+			s2, v2 := basicwidget.HSLToHSV(sl, l)
+			if !nearlyEqual(s2, tc.outS) || !nearlyEqual(v2, tc.outV) {
+				t.Errorf("HSLToHSV(HSVToHSL(%v, %v)): got: (%v, %v), want: (%v, %v)", s, v, s2, v2, tc.outS, tc.outV)
+			}
+		})
+	}
+}
@@ -0,0 +1,848 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package basicwidget
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"slices"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+	"github.com/hajimehoshi/iro"
+
+	"github.com/guigui-gui/guigui"
+	"github.com/guigui-gui/guigui/basicwidget/basicwidgetdraw"
+	"github.com/guigui-gui/guigui/basicwidget/internal/draw"
+)
+
+var (
+	colorPickerEventValueChanged guigui.EventKey = guigui.GenerateEventKey()
+)
+
+var (
+	colorPickerAreaEventValueChanged  guigui.EventKey = guigui.GenerateEventKey()
+	colorPickerStripEventValueChanged guigui.EventKey = guigui.GenerateEventKey()
+	colorPickerSwatchEventDown        guigui.EventKey = guigui.GenerateEventKey()
+)
+
+type colorPickerMode int
+
+const (
+	colorPickerModeRGB colorPickerMode = iota
+	colorPickerModeHSL
+)
+
+var defaultColorPickerSwatches = []color.Color{
+	color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0xff},
+	color.NRGBA{R: 0x42, G: 0x42, B: 0x42, A: 0xff},
+	color.NRGBA{R: 0x80, G: 0x80, B: 0x80, A: 0xff},
+	color.NRGBA{R: 0xbd, G: 0xbd, B: 0xbd, A: 0xff},
+	color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+	color.NRGBA{R: 0xf4, G: 0x43, B: 0x36, A: 0xff},
+	color.NRGBA{R: 0xff, G: 0x98, B: 0x00, A: 0xff},
+	color.NRGBA{R: 0xff, G: 0xeb, B: 0x3b, A: 0xff},
+	color.NRGBA{R: 0x8b, G: 0xc3, B: 0x4a, A: 0xff},
+	color.NRGBA{R: 0x4c, G: 0xaf, B: 0x50, A: 0xff},
+	color.NRGBA{R: 0x00, G: 0x96, B: 0x88, A: 0xff},
+	color.NRGBA{R: 0x03, G: 0xa9, B: 0xf4, A: 0xff},
+	color.NRGBA{R: 0x21, G: 0x96, B: 0xf3, A: 0xff},
+	color.NRGBA{R: 0x3f, G: 0x51, B: 0xb5, A: 0xff},
+	color.NRGBA{R: 0x9c, G: 0x27, B: 0xb0, A: 0xff},
+	color.NRGBA{R: 0xe9, G: 0x1e, B: 0x63, A: 0xff},
+	color.NRGBA{R: 0x79, G: 0x55, B: 0x48, A: 0xff},
+	color.NRGBA{R: 0x60, G: 0x7d, B: 0x8b, A: 0xff},
+}
+
+// srgbColor returns a color.Color from nonlinear sRGB channels and alpha in [0, 1].
+func srgbColor(r, g, b, a float64) color.Color {
+	return iro.ColorFromSRGB(r, g, b, a).SRGBColor()
+}
+
+// ColorPicker is a widget to pick a color.
+//
+// ColorPicker has a saturation and value area, a hue strip, an optional alpha strip,
+// a text input for the hexadecimal notation, number inputs for the RGB or HSL channels, and a swatch palette.
+//
+// Colors are in the sRGB color space.
+// The zero value of ColorPicker has an opaque black color.
+type ColorPicker struct {
+	guigui.DefaultWidget
+
+	area          colorPickerArea
+	hueStrip      colorPickerStrip
+	alphaStrip    colorPickerStrip
+	hexInput      TextInput
+	modeControl   SegmentedControl[colorPickerMode]
+	channelInputs [3]NumberInput
+	alphaInput    NumberInput
+	swatches      guigui.WidgetSlice[*colorPickerSwatch]
+
+	// The color is held in HSV so that the hue is kept for achromatic colors.
+	hue        float64
+	saturation float64
+	brightness float64
+	// transparency is 1 - alpha, so that the zero value is opaque.
+	transparency float64
+
+	alphaEnabled    bool
+	mode            colorPickerMode
+	swatchColors    []color.Color
+	swatchColorsSet bool
+
+	onAreaValueChanged         func(context *guigui.Context, saturation, brightness float64, committed bool)
+	onHueStripValueChanged     func(context *guigui.Context, rate float64, committed bool)
+	onAlphaStripValueChanged   func(context *guigui.Context, rate float64, committed bool)
+	onHexInputValueChanged     func(context *guigui.Context, text string, committed bool)
+	onModeControlItemSelected  func(context *guigui.Context, index int)
+	onChannelInputValueChanged [3]func(context *guigui.Context, value int, committed bool)
+	onAlphaInputValueChanged   func(context *guigui.Context, value int, committed bool)
+	onSwatchDowns              []func(context *guigui.Context)
+}
+
+// OnValueChanged sets the event handler that is called when the color is changed by the user.
+// committed is false while the user is dragging in the area or a strip, and true when the change is settled.
+func (c *ColorPicker) OnValueChanged(f func(context *guigui.Context, value color.Color, committed bool)) {
+	guigui.SetEventHandler(c, colorPickerEventValueChanged, f)
+}
+
+// Value returns the color as a [color.NRGBA64] value.
+func (c *ColorPicker) Value() color.Color {
+	r, g, b := hsvToRGB(c.hue, c.saturation, c.brightness)
+	return srgbColor(r, g, b, 1-c.transparency)
+}
+
+// SetValue sets the color.
+// A nil value is treated as an opaque black.
+// The alpha of the value is kept even if the alpha is not enabled.
+func (c *ColorPicker) SetValue(value color.Color) {
+	if value == nil {
+		value = color.Black
+	}
+	if draw.EqualColor(c.Value(), value) {
+		return
+	}
+	var a float64
+	c.hue, c.saturation, c.brightness, a = c.hsvaFromRGBA(iro.ColorFromSRGBColor(value).SRGB())
+	c.transparency = 1 - a
+}
+
+// IsAlphaEnabled reports whether the user can edit the alpha.
+func (c *ColorPicker) IsAlphaEnabled() bool {
+	return c.alphaEnabled
+}
+
+// SetAlphaEnabled sets whether the user can edit the alpha.
+// The default value is false.
+func (c *ColorPicker) SetAlphaEnabled(enabled bool) {
+	c.alphaEnabled = enabled
+}
+
+// SetSwatches sets the colors of the swatch palette.
+// If SetSwatches is not called, a default palette is used.
+// An empty slice hides the palette.
+func (c *ColorPicker) SetSwatches(colors []color.Color) {
+	c.swatchColors = slices.Clone(colors)
+	c.swatchColorsSet = true
+}
+
+func (c *ColorPicker) WriteStateKey(w *guigui.StateKeyWriter) {
+	w.WriteFloat64(c.hue)
+	w.WriteFloat64(c.saturation)
+	w.WriteFloat64(c.brightness)
+	w.WriteFloat64(c.transparency)
+	w.WriteBool(c.alphaEnabled)
+	w.WriteInt64(int64(c.mode))
+	w.WriteBool(c.swatchColorsSet)
+	w.WriteInt64(int64(len(c.swatchColors)))
+	for _, clr := range c.swatchColors {
+		writeColor(w, clr)
+	}
+}
+
+func (c *ColorPicker) swatchColorsToShow() []color.Color {
+	if c.swatchColorsSet {
+		return c.swatchColors
+	}
+	return defaultColorPickerSwatches
+}
+
+func (c *ColorPicker) rgb() (r, g, b float64) {
+	return hsvToRGB(c.hue, c.saturation, c.brightness)
+}
+
+func (c *ColorPicker) alpha() float64 {
+	return 1 - c.transparency
+}
+
+// hsvaFromRGBA converts RGBA to HSVA.
+// The current hue is kept for an achromatic color, and the current saturation is kept for black.
+func (c *ColorPicker) hsvaFromRGBA(r, g, b, a float64) (h, s, v, alpha float64) {
+	h, s, v = rgbToHSV(min(max(r, 0), 1), min(max(g, 0), 1), min(max(b, 0), 1))
+	if s == 0 {
+		h = c.hue
+	}
+	if v == 0 {
+		s = c.saturation
+	}
+	return h, s, v, min(max(a, 0), 1)
+}
+
+func (c *ColorPicker) setHSVA(context *guigui.Context, h, s, v, a float64, committed bool) {
+	h = min(max(h, 0), 360)
+	s = min(max(s, 0), 1)
+	v = min(max(v, 0), 1)
+	t := 1 - min(max(a, 0), 1)
+	if c.hue == h && c.saturation == s && c.brightness == v && c.transparency == t && !committed {
+		return
+	}
+	c.hue = h
+	c.saturation = s
+	c.brightness = v
+	c.transparency = t
+	c.hexInput.ForceSetValue(c.hexString())
+	guigui.DispatchEvent(c, colorPickerEventValueChanged, c.Value(), committed)
+}
+
+func (c *ColorPicker) setRGBAByUser(context *guigui.Context, r, g, b, a float64, committed bool) {
+	h, s, v, a := c.hsvaFromRGBA(r, g, b, a)
+	c.setHSVA(context, h, s, v, a, committed)
+}
+
+func (c *ColorPicker) hexString() string {
+	r, g, b := c.rgb()
+	return formatHexColor(r, g, b, c.alpha(), c.alphaEnabled)
+}
+
+func (c *ColorPicker) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
+	adder.AddWidget(&c.area)
+	adder.AddWidget(&c.hueStrip)
+	if c.alphaEnabled {
+		adder.AddWidget(&c.alphaStrip)
+	}
+	adder.AddWidget(&c.hexInput)
+	adder.AddWidget(&c.modeControl)
+	for i := range c.channelInputs {
+		adder.AddWidget(&c.channelInputs[i])
+	}
+	if c.alphaEnabled {
+		adder.AddWidget(&c.alphaInput)
+	}
+	swatchColors := c.swatchColorsToShow()
+	c.swatches.SetLen(len(swatchColors))
+	for i := range c.swatches.Len() {
+		adder.AddWidget(c.swatches.At(i))
+	}
+
+	r, g, b := c.rgb()
+
+	c.area.setColor(c.hue, c.saturation, c.brightness)
+	if c.onAreaValueChanged == nil {
+		c.onAreaValueChanged = func(context *guigui.Context, saturation, brightness float64, committed bool) {
+			c.setHSVA(context, c.hue, saturation, brightness, c.alpha(), committed)
+		}
+	}
+	guigui.SetEventHandler(&c.area, colorPickerAreaEventValueChanged, c.onAreaValueChanged)
+
+	c.hueStrip.setAlphaStrip(false, 0, 0, 0)
+	c.hueStrip.setRate(c.hue / 360)
+	if c.onHueStripValueChanged == nil {
+		c.onHueStripValueChanged = func(context *guigui.Context, rate float64, committed bool) {
+			c.setHSVA(context, rate*360, c.saturation, c.brightness, c.alpha(), committed)
+		}
+	}
+	guigui.SetEventHandler(&c.hueStrip, colorPickerStripEventValueChanged, c.onHueStripValueChanged)
+
+	c.alphaStrip.setAlphaStrip(true, r, g, b)
+	c.alphaStrip.setRate(c.alpha())
+	if c.onAlphaStripValueChanged == nil {
+		c.onAlphaStripValueChanged = func(context *guigui.Context, rate float64, committed bool) {
+			c.setHSVA(context, c.hue, c.saturation, c.brightness, rate, committed)
+		}
+	}
+	guigui.SetEventHandler(&c.alphaStrip, colorPickerStripEventValueChanged, c.onAlphaStripValueChanged)
+
+	c.hexInput.SetValue(c.hexString())
+	c.hexInput.SetTabular(true)
+	if c.onHexInputValueChanged == nil {
+		c.onHexInputValueChanged = func(context *guigui.Context, text string, committed bool) {
+			if !committed {
+				return
+			}
+			r, g, b, a, ok := parseHexColor(text)
+			if !ok {
+				// Revert to the current value.
+				c.hexInput.ForceSetValue(c.hexString())
+				return
+			}
+			if !c.alphaEnabled {
+				a = c.alpha()
+			}
+			c.setRGBAByUser(context, r, g, b, a, true)
+		}
+	}
+	c.hexInput.OnValueChanged(c.onHexInputValueChanged)
+
+	c.modeControl.SetItems([]SegmentedControlItem[colorPickerMode]{
+		{
+			Text:  "RGB",
+			Value: colorPickerModeRGB,
+		},
+		{
+			Text:  "HSL",
+			Value: colorPickerModeHSL,
+		},
+	})
+	c.modeControl.SelectItemByValue(c.mode)
+	if c.onModeControlItemSelected == nil {
+		c.onModeControlItemSelected = func(context *guigui.Context, index int) {
+			item, ok := c.modeControl.ItemByIndex(index)
+			if !ok {
+				return
+			}
+			c.mode = item.Value
+		}
+	}
+	c.modeControl.OnItemSelected(c.onModeControlItemSelected)
+
+	switch c.mode {
+	case colorPickerModeRGB:
+		for i, v := range [...]float64{r, g, b} {
+			c.channelInputs[i].SetMinimumValue(0)
+			c.channelInputs[i].SetMaximumValue(0xff)
+			c.channelInputs[i].SetValue(int(colorChannelToByte(v)))
+		}
+		c.channelInputs[0].SetSupportText("R")
+		c.channelInputs[1].SetSupportText("G")
+		c.channelInputs[2].SetSupportText("B")
+	case colorPickerModeHSL:
+		sl, l := hsvToHSL(c.saturation, c.brightness)
+		c.channelInputs[0].SetMinimumValue(0)
+		c.channelInputs[0].SetMaximumValue(360)
+		c.channelInputs[0].SetValue(int(math.Round(c.hue)))
+		c.channelInputs[1].SetMinimumValue(0)
+		c.channelInputs[1].SetMaximumValue(100)
+		c.channelInputs[1].SetValue(int(math.Round(sl * 100)))
+		c.channelInputs[2].SetMinimumValue(0)
+		c.channelInputs[2].SetMaximumValue(100)
+		c.channelInputs[2].SetValue(int(math.Round(l * 100)))
+		c.channelInputs[0].SetSupportText("H")
+		c.channelInputs[1].SetSupportText("S")
+		c.channelInputs[2].SetSupportText("L")
+	}
+	for i := range c.channelInputs {
+		if c.onChannelInputValueChanged[i] == nil {
+			c.onChannelInputValueChanged[i] = func(context *guigui.Context, value int, committed bool) {
+				c.setChannelValue(context, i, value, committed)
+			}
+		}
+		c.channelInputs[i].OnValueChanged(c.onChannelInputValueChanged[i])
+	}
+
+	c.alphaInput.SetMinimumValue(0)
+	c.alphaInput.SetMaximumValue(100)
+	c.alphaInput.SetValue(int(math.Round(c.alpha() * 100)))
+	c.alphaInput.SetSupportText("A (%)")
+	if c.onAlphaInputValueChanged == nil {
+		c.onAlphaInputValueChanged = func(context *guigui.Context, value int, committed bool) {
+			c.setHSVA(context, c.hue, c.saturation, c.brightness, float64(value)/100, committed)
+		}
+	}
+	c.alphaInput.OnValueChanged(c.onAlphaInputValueChanged)
+
+	current := c.hexString()
+	for i := range c.swatches.Len() {
+		swatch := c.swatches.At(i)
+		swatch.setColor(swatchColors[i])
+		sr, sg, sb, sa := iro.ColorFromSRGBColor(swatchColors[i]).SRGB()
+		swatch.setSelected(formatHexColor(sr, sg, sb, sa, c.alphaEnabled) == current)
+		if i >= len(c.onSwatchDowns) {
+			c.onSwatchDowns = append(c.onSwatchDowns, func(context *guigui.Context) {
+				clr := c.swatchColorsToShow()[i]
+				r, g, b, a := iro.ColorFromSRGBColor(clr).SRGB()
+				if !c.alphaEnabled {
+					a = c.alpha()
+				}
+				c.setRGBAByUser(context, r, g, b, a, true)
+			})
+		}
+		guigui.SetEventHandler(swatch, colorPickerSwatchEventDown, c.onSwatchDowns[i])
+	}
+
+	return nil
+}
+
+func (c *ColorPicker) setChannelValue(context *guigui.Context, index int, value int, committed bool) {
+	switch c.mode {
+	case colorPickerModeRGB:
+		r, g, b := c.rgb()
+		channels := [...]float64{r, g, b}
+		channels[index] = float64(value) / 0xff
+		c.setRGBAByUser(context, channels[0], channels[1], channels[2], c.alpha(), committed)
+	case colorPickerModeHSL:
+		sl, l := hsvToHSL(c.saturation, c.brightness)
+		h := c.hue
+		switch index {
+		case 0:
+			h = float64(value)
+		case 1:
+			sl = float64(value) / 100
+		case 2:
+			l = float64(value) / 100
+		}
+		s, v := hslToHSV(sl, l)
+		if v == 0 {
+			s = c.saturation
+		}
+		c.setHSVA(context, h, s, v, c.alpha(), committed)
+	}
+}
+
+func (c *ColorPicker) gap(context *guigui.Context) int {
+	return UnitSize(context) / 4
+}
+
+func (c *ColorPicker) contentWidth(context *guigui.Context) int {
+	return 12 * UnitSize(context)
+}
+
+func (c *ColorPicker) stripHeight(context *guigui.Context) int {
+	return UnitSize(context) * 3 / 4
+}
+
+func (c *ColorPicker) swatchColumnCount(context *guigui.Context) int {
+	u := UnitSize(context)
+	return max((c.contentWidth(context)+c.gap(context))/(u+c.gap(context)), 1)
+}
+
+func (c *ColorPicker) swatchRowCount(context *guigui.Context) int {
+	n := c.swatchColumnCount(context)
+	return (c.swatches.Len() + n - 1) / n
+}
+
+func (c *ColorPicker) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
+	u := UnitSize(context)
+	gap := c.gap(context)
+	b := widgetBounds.Bounds()
+	w := b.Dx()
+
+	y := b.Min.Y
+	layouter.LayoutWidget(&c.area, image.Rect(b.Min.X, y, b.Max.X, y+7*u))
+	y += 7*u + gap
+
+	layouter.LayoutWidget(&c.hueStrip, image.Rect(b.Min.X, y, b.Max.X, y+c.stripHeight(context)))
+	y += c.stripHeight(context) + gap
+	if c.alphaEnabled {
+		layouter.LayoutWidget(&c.alphaStrip, image.Rect(b.Min.X, y, b.Max.X, y+c.stripHeight(context)))
+		y += c.stripHeight(context) + gap
+	}
+
+	modeSize := c.modeControl.Measure(context, guigui.Constraints{})
+	hexHeight := c.hexInput.Measure(context, guigui.FixedWidthConstraints(w-modeSize.X-gap)).Y
+	rowHeight := max(modeSize.Y, hexHeight)
+	layouter.LayoutWidget(&c.hexInput, image.Rect(b.Min.X, y, b.Max.X-modeSize.X-gap, y+hexHeight))
+	layouter.LayoutWidget(&c.modeControl, image.Rect(b.Max.X-modeSize.X, y, b.Max.X, y+modeSize.Y))
+	y += rowHeight + gap
+
+	fieldCount := len(c.channelInputs)
+	if c.alphaEnabled {
+		fieldCount++
+	}
+	fw := (w - (fieldCount-1)*gap) / fieldCount
+	fh := c.channelInputs[0].Measure(context, guigui.FixedWidthConstraints(fw)).Y
+	x := b.Min.X
+	for i := range c.channelInputs {
+		layouter.LayoutWidget(&c.channelInputs[i], image.Rect(x, y, x+fw, y+fh))
+		x += fw + gap
+	}
+	if c.alphaEnabled {
+		layouter.LayoutWidget(&c.alphaInput, image.Rect(x, y, b.Max.X, y+fh))
+	}
+	y += fh + gap
+
+	cols := c.swatchColumnCount(context)
+	for i := range c.swatches.Len() {
+		sx := b.Min.X + (i%cols)*(u+gap)
+		sy := y + (i/cols)*(u+gap)
+		layouter.LayoutWidget(c.swatches.At(i), image.Rect(sx, sy, sx+u, sy+u))
+	}
+}
+
+func (c *ColorPicker) Measure(context *guigui.Context, constraints guigui.Constraints) image.Point {
+	u := UnitSize(context)
+	gap := c.gap(context)
+	w := c.contentWidth(context)
+
+	h := 7*u + gap
+	h += c.stripHeight(context) + gap
+	if c.alphaEnabled {
+		h += c.stripHeight(context) + gap
+	}
+
+	modeSize := c.modeControl.Measure(context, guigui.Constraints{})
+	h += max(modeSize.Y, c.hexInput.Measure(context, guigui.FixedWidthConstraints(w-modeSize.X-gap)).Y) + gap
+
+	fieldCount := len(c.channelInputs)
+	if c.alphaEnabled {
+		fieldCount++
+	}
+	fw := (w - (fieldCount-1)*gap) / fieldCount
+	h += c.channelInputs[0].Measure(context, guigui.FixedWidthConstraints(fw)).Y
+
+	if rows := c.swatchRowCount(context); rows > 0 {
+		h += gap + rows*u + (rows-1)*gap
+	}
+	return image.Pt(w, h)
+}
+
+// drawCheckerboard draws a checkerboard pattern to show the transparency of colors on it.
+func drawCheckerboard(context *guigui.Context, dst *ebiten.Image, bounds image.Rectangle) {
+	dst = dst.SubImage(bounds).(*ebiten.Image)
+	s := UnitSize(context) / 4
+	for j := 0; bounds.Min.Y+j*s < bounds.Max.Y; j++ {
+		for i := 0; bounds.Min.X+i*s < bounds.Max.X; i++ {
+			clr := color.Gray{Y: 0xff}
+			if (i+j)%2 == 1 {
+				clr = color.Gray{Y: 0xcc}
+			}
+			vector.FillRect(dst, float32(bounds.Min.X+i*s), float32(bounds.Min.Y+j*s), float32(s), float32(s), clr, false)
+		}
+	}
+}
+
+// drawColorPickerThumb draws a ring thumb centered at (x, y).
+func drawColorPickerThumb(context *guigui.Context, dst *ebiten.Image, x, y float32, focused bool) {
+	u := float32(UnitSize(context))
+	scale := float32(context.Scale())
+	vector.StrokeCircle(dst, x, y, u/4, 4*scale, color.Black, true)
+	clr := color.Color(color.White)
+	if focused {
+		clr = draw.Color(context.ColorMode(), draw.SemanticColorAccent, 0.5)
+	}
+	vector.StrokeCircle(dst, x, y, u/4, 2*scale, clr, true)
+}
+
+// colorPickerArea is a two-dimensional area to pick the saturation horizontally and the value vertically.
+type colorPickerArea struct {
+	guigui.DefaultWidget
+
+	hue        float64
+	saturation float64
+	brightness float64
+
+	dragging bool
+	focused  bool
+}
+
+func (c *colorPickerArea) setColor(hue, saturation, brightness float64) {
+	c.hue = hue
+	c.saturation = saturation
+	c.brightness = brightness
+}
+
+func (c *colorPickerArea) WriteStateKey(w *guigui.StateKeyWriter) {
+	w.WriteFloat64(c.hue)
+	w.WriteFloat64(c.saturation)
+	w.WriteFloat64(c.brightness)
+	w.WriteBool(c.focused)
+}
+
+func (c *colorPickerArea) valueAtCursor(widgetBounds *guigui.WidgetBounds) (saturation, brightness float64) {
+	b := widgetBounds.Bounds()
+	x, y := guigui.CursorPosition()
+	s := float64(x-b.Min.X) / float64(max(b.Dx(), 1))
+	v := 1 - float64(y-b.Min.Y)/float64(max(b.Dy(), 1))
+	return min(max(s, 0), 1), min(max(v, 0), 1)
+}
+
+func (c *colorPickerArea) HandlePointingInput(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
+	if context.IsEnabled(c) && widgetBounds.IsHitAtCursor() && guigui.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) && !c.dragging {
+		context.SetFocused(c, true)
+		c.dragging = true
+		s, v := c.valueAtCursor(widgetBounds)
+		guigui.DispatchEvent(c, colorPickerAreaEventValueChanged, s, v, false)
+		return guigui.HandleInputByWidget(c)
+	}
+	if !c.dragging {
+		return guigui.HandleInputResult{}
+	}
+	if !context.IsEnabled(c) || !guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
+		c.dragging = false
+		guigui.DispatchEvent(c, colorPickerAreaEventValueChanged, c.saturation, c.brightness, true)
+		return guigui.HandleInputResult{}
+	}
+	s, v := c.valueAtCursor(widgetBounds)
+	guigui.DispatchEvent(c, colorPickerAreaEventValueChanged, s, v, false)
+	return guigui.HandleInputByWidget(c)
+}
+
+// HandleButtonInput implements [guigui.Widget.HandleButtonInput].
+func (c *colorPickerArea) HandleButtonInput(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
+	if !context.IsEnabled(c) || !context.IsFocused(c) {
+		return guigui.HandleInputResult{}
+	}
+	const step = 0.01
+	s, v := c.saturation, c.brightness
+	switch {
+	case isKeyRepeating(ebiten.KeyLeft):
+		s -= step
+	case isKeyRepeating(ebiten.KeyRight):
+		s += step
+	case isKeyRepeating(ebiten.KeyUp):
+		v += step
+	case isKeyRepeating(ebiten.KeyDown):
+		v -= step
+	default:
+		return guigui.HandleInputResult{}
+	}
+	guigui.DispatchEvent(c, colorPickerAreaEventValueChanged, min(max(s, 0), 1), min(max(v, 0), 1), true)
+	return guigui.HandleInputByWidget(c)
+}
+
+func (c *colorPickerArea) Tick(context *guigui.Context, widgetBounds *guigui.WidgetBounds) error {
+	c.focused = context.IsFocused(c)
+	return nil
+}
+
+func (c *colorPickerArea) CursorShape(context *guigui.Context, widgetBounds *guigui.WidgetBounds) (ebiten.CursorShapeType, bool) {
+	if context.IsEnabled(c) {
+		return ebiten.CursorShapeCrosshair, true
+	}
+	return 0, true
+}
+
+func (c *colorPickerArea) Draw(context *guigui.Context, widgetBounds *guigui.WidgetBounds, dst *ebiten.Image) {
+	b := widgetBounds.Bounds()
+	r, g, bl := hsvToRGB(c.hue, 1, 1)
+	basicwidgetdraw.DrawGradientRect(dst, b, color.White, srgbColor(r, g, bl, 1), color.White, srgbColor(r, g, bl, 1))
+	basicwidgetdraw.DrawGradientRect(dst, b, color.Transparent, color.Transparent, color.Black, color.Black)
+	if !context.IsEnabled(c) {
+		vector.FillRect(dst, float32(b.Min.X), float32(b.Min.Y), float32(b.Dx()), float32(b.Dy()), draw.ScaleAlpha(basicwidgetdraw.BackgroundColor(context.ColorMode()), 0.75), false)
+	}
+
+	x := float32(b.Min.X) + float32(c.saturation)*float32(b.Dx())
+	y := float32(b.Min.Y) + float32(1-c.brightness)*float32(b.Dy())
+	drawColorPickerThumb(context, dst.SubImage(b).(*ebiten.Image), x, y, c.focused)
+}
+
+// colorPickerStrip is a one-dimensional strip to pick the hue or the alpha.
+type colorPickerStrip struct {
+	guigui.DefaultWidget
+
+	alpha bool
+	r     float64
+	g     float64
+	b     float64
+	rate  float64
+
+	dragging bool
+	focused  bool
+}
+
+// setAlphaStrip sets whether the strip is for the alpha.
+// r, g and b are the color of the alpha strip.
+func (c *colorPickerStrip) setAlphaStrip(alpha bool, r, g, b float64) {
+	c.alpha = alpha
+	c.r = r
+	c.g = g
+	c.b = b
+}
+
+func (c *colorPickerStrip) setRate(rate float64) {
+	c.rate = rate
+}
+
+func (c *colorPickerStrip) WriteStateKey(w *guigui.StateKeyWriter) {
+	w.WriteBool(c.alpha)
+	w.WriteFloat64(c.r)
+	w.WriteFloat64(c.g)
+	w.WriteFloat64(c.b)
+	w.WriteFloat64(c.rate)
+	w.WriteBool(c.focused)
+}
+
+func (c *colorPickerStrip) trackBounds(context *guigui.Context, bounds image.Rectangle) image.Rectangle {
+	u := UnitSize(context)
+	return image.Rect(bounds.Min.X+u/4, bounds.Min.Y+u/8, bounds.Max.X-u/4, bounds.Max.Y-u/8)
+}
+
+func (c *colorPickerStrip) rateAtCursor(context *guigui.Context, widgetBounds *guigui.WidgetBounds) float64 {
+	b := c.trackBounds(context, widgetBounds.Bounds())
+	x, _ := guigui.CursorPosition()
+	rate := float64(x-b.Min.X) / float64(max(b.Dx(), 1))
+	return min(max(rate, 0), 1)
+}
+
+func (c *colorPickerStrip) HandlePointingInput(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
+	if context.IsEnabled(c) && widgetBounds.IsHitAtCursor() && guigui.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) && !c.dragging {
+		context.SetFocused(c, true)
+		c.dragging = true
+		guigui.DispatchEvent(c, colorPickerStripEventValueChanged, c.rateAtCursor(context, widgetBounds), false)
+		return guigui.HandleInputByWidget(c)
+	}
+	if !c.dragging {
+		return guigui.HandleInputResult{}
+	}
+	if !context.IsEnabled(c) || !guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
+		c.dragging = false
+		guigui.DispatchEvent(c, colorPickerStripEventValueChanged, c.rate, true)
+		return guigui.HandleInputResult{}
+	}
+	guigui.DispatchEvent(c, colorPickerStripEventValueChanged, c.rateAtCursor(context, widgetBounds), false)
+	return guigui.HandleInputByWidget(c)
+}
+
+// HandleButtonInput implements [guigui.Widget.HandleButtonInput].
+func (c *colorPickerStrip) HandleButtonInput(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
+	if !context.IsEnabled(c) || !context.IsFocused(c) {
+		return guigui.HandleInputResult{}
+	}
+	step := 0.01
+	if !c.alpha {
+		step = 1.0 / 360
+	}
+	rate := c.rate
+	switch {
+	case isKeyRepeating(ebiten.KeyLeft), isKeyRepeating(ebiten.KeyDown):
+		rate -= step
+	case isKeyRepeating(ebiten.KeyRight), isKeyRepeating(ebiten.KeyUp):
+		rate += step
+	case guigui.IsKeyJustPressed(ebiten.KeyHome):
+		rate = 0
+	case guigui.IsKeyJustPressed(ebiten.KeyEnd):
+		rate = 1
+	default:
+		return guigui.HandleInputResult{}
+	}
+	guigui.DispatchEvent(c, colorPickerStripEventValueChanged, min(max(rate, 0), 1), true)
+	return guigui.HandleInputByWidget(c)
+}
+
+func (c *colorPickerStrip) Tick(context *guigui.Context, widgetBounds *guigui.WidgetBounds) error {
+	c.focused = context.IsFocused(c)
+	return nil
+}
+
+func (c *colorPickerStrip) CursorShape(context *guigui.Context, widgetBounds *guigui.WidgetBounds) (ebiten.CursorShapeType, bool) {
+	if context.IsEnabled(c) {
+		return ebiten.CursorShapePointer, true
+	}
+	return 0, true
+}
+
+func (c *colorPickerStrip) Draw(context *guigui.Context, widgetBounds *guigui.WidgetBounds, dst *ebiten.Image) {
+	tb := c.trackBounds(context, widgetBounds.Bounds())
+	if c.alpha {
+		drawCheckerboard(context, dst, tb)
+		basicwidgetdraw.DrawGradientRect(dst, tb, srgbColor(c.r, c.g, c.b, 0), srgbColor(c.r, c.g, c.b, 1), srgbColor(c.r, c.g, c.b, 0), srgbColor(c.r, c.g, c.b, 1))
+	} else {
+		// Draw the hue circle in six segments, as each segment is linear in sRGB.
+		for i := range 6 {
+			x0 := tb.Min.X + tb.Dx()*i/6
+			x1 := tb.Min.X + tb.Dx()*(i+1)/6
+			r0, g0, b0 := hsvToRGB(float64(i)*60, 1, 1)
+			r1, g1, b1 := hsvToRGB(float64(i+1)*60, 1, 1)
+			clr0 := srgbColor(r0, g0, b0, 1)
+			clr1 := srgbColor(r1, g1, b1, 1)
+			basicwidgetdraw.DrawGradientRect(dst, image.Rect(x0, tb.Min.Y, x1, tb.Max.Y), clr0, clr1, clr0, clr1)
+		}
+	}
+	if !context.IsEnabled(c) {
+		vector.FillRect(dst, float32(tb.Min.X), float32(tb.Min.Y), float32(tb.Dx()), float32(tb.Dy()), draw.ScaleAlpha(basicwidgetdraw.BackgroundColor(context.ColorMode()), 0.75), false)
+	}
+
+	x := float32(tb.Min.X) + float32(c.rate)*float32(tb.Dx())
+	y := float32(tb.Min.Y+tb.Max.Y) / 2
+	drawColorPickerThumb(context, dst, x, y, c.focused)
+}
+
+func (c *colorPickerStrip) Measure(context *guigui.Context, constraints guigui.Constraints) image.Point {
+	return image.Pt(6*UnitSize(context), UnitSize(context)*3/4)
+}
+
+// colorPickerSwatch is a swatch in the palette of [ColorPicker].
+type colorPickerSwatch struct {
+	guigui.DefaultWidget
+
+	color    color.Color
+	selected bool
+
+	prevCanPress bool
+}
+
+func (c *colorPickerSwatch) setColor(clr color.Color) {
+	c.color = clr
+}
+
+func (c *colorPickerSwatch) setSelected(selected bool) {
+	c.selected = selected
+}
+
+func (c *colorPickerSwatch) WriteStateKey(w *guigui.StateKeyWriter) {
+	writeColor(w, c.color)
+	w.WriteBool(c.selected)
+}
+
+func (c *colorPickerSwatch) HandlePointingInput(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
+	if context.IsEnabled(c) && widgetBounds.IsHitAtCursor() && guigui.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
+		guigui.DispatchEvent(c, colorPickerSwatchEventDown)
+		return guigui.HandleInputByWidget(c)
+	}
+	return guigui.HandleInputResult{}
+}
+
+func (c *colorPickerSwatch) Tick(context *guigui.Context, widgetBounds *guigui.WidgetBounds) error {
+	if canPress := c.canPress(context, widgetBounds); canPress != c.prevCanPress {
+		c.prevCanPress = canPress
+		guigui.RequestRedraw(c)
+	}
+	return nil
+}
+
+func (c *colorPickerSwatch) CursorShape(context *guigui.Context, widgetBounds *guigui.WidgetBounds) (ebiten.CursorShapeType, bool) {
+	if c.canPress(context, widgetBounds) {
+		return ebiten.CursorShapePointer, true
+	}
+	return 0, true
+}
+
+func (c *colorPickerSwatch) canPress(context *guigui.Context, widgetBounds *guigui.WidgetBounds) bool {
+	return context.IsEnabled(c) && widgetBounds.IsHitAtCursor() && !guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft)
+}
+
+func (c *colorPickerSwatch) Draw(context *guigui.Context, widgetBounds *guigui.WidgetBounds, dst *ebiten.Image) {
+	drawColorSwatch(context, dst, widgetBounds.Bounds(), c.color, context.IsEnabled(c))
+
+	cm := context.ColorMode()
+	b := widgetBounds.Bounds()
+	r := RoundedCornerRadius(context)
+	switch {
+	case c.selected:
+		clr := draw.Color(cm, draw.SemanticColorAccent, 0.5)
+		basicwidgetdraw.DrawRoundedRectBorder(context, dst, b, clr, clr, r, float32(2*context.Scale()), basicwidgetdraw.RoundedRectBorderTypeRegular)
+	case c.canPress(context, widgetBounds):
+		clr := draw.Color(cm, draw.SemanticColorBase, 0.5)
+		basicwidgetdraw.DrawRoundedRectBorder(context, dst, b, clr, clr, r, float32(2*context.Scale()), basicwidgetdraw.RoundedRectBorderTypeRegular)
+	}
+}
+
+// drawColorSwatch draws a rounded swatch of the color with a border.
+// A checkerboard is drawn under a translucent color.
+func drawColorSwatch(context *guigui.Context, dst *ebiten.Image, bounds image.Rectangle, clr color.Color, enabled bool) {
+	cm := context.ColorMode()
+	r := RoundedCornerRadius(context)
+	if clr == nil {
+		clr = color.Black
+	}
+	if _, _, _, a := clr.RGBA(); a < 0xffff {
+		drawCheckerboard(context, dst, bounds.Inset(r/2))
+	}
+	basicwidgetdraw.DrawRoundedRect(context, dst, bounds, clr, r)
+	if !enabled {
+		basicwidgetdraw.DrawRoundedRect(context, dst, bounds, draw.ScaleAlpha(basicwidgetdraw.BackgroundColor(cm), 0.75), r)
+	}
+	clr1, clr2 := basicwidgetdraw.BorderColors(cm, basicwidgetdraw.RoundedRectBorderTypeInset)
+	basicwidgetdraw.DrawRoundedRectBorder(context, dst, bounds, clr1, clr2, r, float32(1*context.Scale()), basicwidgetdraw.RoundedRectBorderTypeInset)
+}
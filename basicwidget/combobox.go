@@ -6,7 +6,6 @@ package basicwidget
 import (
 	"image"
 	"slices"
-	"strings"
 
 	"github.com/guigui-gui/guigui"
 )
@@ -16,7 +15,8 @@ var (
 )
 
 // Combobox is a composite widget that combines a [TextInput] with a [PopupMenu].
-// The popup menu shows filtered items based on the current input text.
+// The popup menu shows filtered items based on the current input text, and highlights the matched parts.
+// The matching is case-insensitive and based on the collation of the locale.
 // When the user focuses the text input, the popup opens below (or above) the text input.
 // The popup is modeless, so the text input retains focus while the popup is shown.
 // The popup closes when the text input loses focus.
@@ -26,10 +26,11 @@ type Combobox struct {
 	textInput TextInput
 	popupMenu PopupMenu[string]
 
-	items          []string
-	filteredItems  []PopupMenuItem[string]
-	allowFreeInput bool
-	lastValidValue string
+	items             []string
+	popupMenuItems    []PopupMenuItem[string]
+	filteredItemCount int
+	allowFreeInput    bool
+	lastValidValue    string
 
 	prevFocused bool
 
@@ -108,33 +109,42 @@ func (c *Combobox) OnValueChanged(f func(context *guigui.Context, value string,
 	guigui.SetEventHandler(c, comboboxEventValueChanged, f)
 }
 
-func (c *Combobox) updateFilteredItems() {
-	input := strings.ToLower(c.textInput.Value())
-	c.filteredItems = c.filteredItems[:0]
-	for _, item := range c.items {
-		if input == "" || strings.Contains(strings.ToLower(item), input) {
-			c.filteredItems = append(c.filteredItems, PopupMenuItem[string]{
-				Text:  item,
-				Value: item,
-			})
+func (c *Combobox) updateFilteredItems(context *guigui.Context) {
+	c.popupMenuItems = adjustSliceSize(c.popupMenuItems, len(c.items))
+	for i, item := range c.items {
+		c.popupMenuItems[i] = PopupMenuItem[string]{
+			Text:  item,
+			Value: item,
 		}
 	}
+	c.popupMenu.SetItems(c.popupMenuItems)
+
+	collator := collatorForLocale(context.FirstLocale())
+	input := c.textInput.Value()
+	c.filteredItemCount = c.popupMenu.filteredItemCount(collator, input)
 	// Don't update the popup with an empty list. This keeps the previous items
 	// visible during the fade-out animation instead of showing a thin empty popup.
-	if len(c.filteredItems) > 0 {
-		c.popupMenu.SetItems(c.filteredItems)
+	if c.filteredItemCount > 0 {
+		c.popupMenu.setFilterQuery(collator, input)
 	}
 }
 
-func (c *Combobox) highlightClosestCandidate(input string) {
-	if input == "" || len(c.filteredItems) == 0 {
+func (c *Combobox) highlightClosestCandidate(context *guigui.Context, input string) {
+	if input == "" || c.filteredItemCount == 0 {
 		c.popupMenu.setKeyboardHighlightIndex(-1)
 		return
 	}
 	// Prefer the first item with a prefix match.
-	var bestIndex int
-	for i, item := range c.filteredItems {
-		if strings.HasPrefix(strings.ToLower(item.Text), input) {
+	collator := collatorForLocale(context.FirstLocale())
+	bestIndex := -1
+	for i, item := range c.items {
+		if _, _, ok := indexCollated(collator, item, input); !ok {
+			continue
+		}
+		if bestIndex < 0 {
+			bestIndex = i
+		}
+		if hasPrefixCollated(collator, item, input) {
 			bestIndex = i
 			break
 		}
@@ -171,11 +181,13 @@ func (c *Combobox) Build(context *guigui.Context, adder *guigui.ChildAdder) erro
 	adder.AddWidget(&c.popupMenu)
 
 	c.popupMenu.setModal(false)
+	// The characters are typed in the text input.
+	c.popupMenu.setTypeAheadDisabled(true)
 	context.DelegateFocus(c, &c.textInput)
 	context.SetButtonInputReceptive(c, c.popupMenu.IsOpen())
 
-	c.updateFilteredItems()
-	if len(c.filteredItems) == 0 && c.popupMenu.IsOpen() {
+	c.updateFilteredItems(context)
+	if c.filteredItemCount == 0 && c.popupMenu.IsOpen() {
 		c.popupMenu.SetOpen(false)
 	}
 
@@ -186,9 +198,9 @@ func (c *Combobox) Build(context *guigui.Context, adder *guigui.ChildAdder) erro
 				c.popupMenu.SetOpen(false)
 				return
 			}
-			c.updateFilteredItems()
-			c.highlightClosestCandidate(strings.ToLower(text))
-			if len(c.filteredItems) == 0 {
+			c.updateFilteredItems(context)
+			c.highlightClosestCandidate(context, text)
+			if c.filteredItemCount == 0 {
 				c.popupMenu.SetOpen(false)
 			} else if !c.popupMenu.IsOpen() && context.IsFocusedOrHasFocusedChild(&c.textInput) {
 				c.popupMenu.SetOpen(true)
@@ -245,8 +257,8 @@ func (c *Combobox) Tick(context *guigui.Context, widgetBounds *guigui.WidgetBoun
 
 	if focused && !c.prevFocused {
 		// Focus gained: open popup if there are items.
-		c.updateFilteredItems()
-		if len(c.filteredItems) > 0 {
+		c.updateFilteredItems(context)
+		if c.filteredItemCount > 0 {
 			c.popupMenu.SetOpen(true)
 		}
 	}
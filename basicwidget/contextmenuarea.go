@@ -7,7 +7,6 @@ import (
 	"image"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
 
 	"github.com/guigui-gui/guigui"
 )
@@ -64,9 +63,9 @@ func (c *ContextMenuArea[T]) Measure(context *guigui.Context, constraints guigui
 
 // HandlePointingInput implements [guigui.Widget.HandlePointingInput].
 func (c *ContextMenuArea[T]) HandlePointingInput(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
-	if inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonRight) {
+	if guigui.IsMouseButtonJustPressed(ebiten.MouseButtonRight) {
 		if widgetBounds.IsHitAtCursor() {
-			c.menuPosition = image.Pt(ebiten.CursorPosition())
+			c.menuPosition = image.Pt(guigui.CursorPosition())
 			c.popupMenu.SetOpen(true)
 			return guigui.HandleInputByWidget(c)
 		}
@@ -0,0 +1,404 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package basicwidget
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"golang.org/x/text/language"
+)
+
+// civilDate is a date without a time and a location.
+// The zero value represents no date.
+type civilDate struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+// civilDateOf returns the date of t in t's location.
+// civilDateOf returns the zero value if t is zero.
+func civilDateOf(t time.Time) civilDate {
+	if t.IsZero() {
+		return civilDate{}
+	}
+	y, m, d := t.Date()
+	return civilDate{year: y, month: m, day: d}
+}
+
+func (c civilDate) isZero() bool {
+	return c == civilDate{}
+}
+
+// time returns the midnight of the date in the local location.
+// time returns the zero time if c is zero.
+func (c civilDate) time() time.Time {
+	if c.isZero() {
+		return time.Time{}
+	}
+	return time.Date(c.year, c.month, c.day, 0, 0, 0, 0, time.Local)
+}
+
+func (c civilDate) normalize() civilDate {
+	return civilDateOf(time.Date(c.year, c.month, c.day, 0, 0, 0, 0, time.UTC))
+}
+
+func (c civilDate) addDays(days int) civilDate {
+	c.day += days
+	return c.normalize()
+}
+
+// addMonths returns the date months later.
+// The day is adjusted to the last day of the month if the month doesn't have the day.
+func (c civilDate) addMonths(months int) civilDate {
+	m := int(c.month) - 1 + months
+	y := c.year + m/12
+	m %= 12
+	if m < 0 {
+		m += 12
+		y--
+	}
+	c.year = y
+	c.month = time.Month(m + 1)
+	c.day = min(c.day, daysInMonth(c.year, c.month))
+	return c
+}
+
+func (c civilDate) weekday() time.Weekday {
+	return time.Date(c.year, c.month, c.day, 0, 0, 0, 0, time.UTC).Weekday()
+}
+
+func (c civilDate) compare(other civilDate) int {
+	switch {
+	case c.year != other.year:
+		return c.year - other.year
+	case c.month != other.month:
+		return int(c.month - other.month)
+	default:
+		return c.day - other.day
+	}
+}
+
+func daysInMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// dateSymbols is a set of names and formats to show dates for a language.
+type dateSymbols struct {
+	months [12]string
+
+	// shortWeekdays is the short names of the weekdays starting from Sunday.
+	shortWeekdays [7]string
+
+	// monthYear formats a month and a year for a calendar title.
+	monthYear func(month string, year int) string
+
+	am string
+	pm string
+}
+
+var englishDateSymbols = dateSymbols{
+	months:        [...]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	shortWeekdays: [...]string{"Su", "Mo", "Tu", "We", "Th", "Fr", "Sa"},
+	monthYear: func(month string, year int) string {
+		return fmt.Sprintf("%s %d", month, year)
+	},
+	am: "AM",
+	pm: "PM",
+}
+
+func cjkMonths(suffix string) [12]string {
+	var months [12]string
+	for i := range months {
+		months[i] = strconv.Itoa(i+1) + suffix
+	}
+	return months
+}
+
+// theDateSymbols is the date symbols for the base languages.
+// A language not in this map uses englishDateSymbols.
+var theDateSymbols = map[string]dateSymbols{
+	"de": {
+		months:        [...]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		shortWeekdays: [...]string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"},
+		monthYear:     englishDateSymbols.monthYear,
+		am:            "AM",
+		pm:            "PM",
+	},
+	"es": {
+		months:        [...]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		shortWeekdays: [...]string{"do", "lu", "ma", "mi", "ju", "vi", "sá"},
+		monthYear: func(month string, year int) string {
+			return fmt.Sprintf("%s de %d", month, year)
+		},
+		am: "a. m.",
+		pm: "p. m.",
+	},
+	"fr": {
+		months:        [...]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		shortWeekdays: [...]string{"di", "lu", "ma", "me", "je", "ve", "sa"},
+		monthYear:     englishDateSymbols.monthYear,
+		am:            "AM",
+		pm:            "PM",
+	},
+	"it": {
+		months:        [...]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+		shortWeekdays: [...]string{"do", "lu", "ma", "me", "gi", "ve", "sa"},
+		monthYear:     englishDateSymbols.monthYear,
+		am:            "AM",
+		pm:            "PM",
+	},
+	"ja": {
+		months:        cjkMonths("月"),
+		shortWeekdays: [...]string{"日", "月", "火", "水", "木", "金", "土"},
+		monthYear: func(month string, year int) string {
+			return fmt.Sprintf("%d年%s", year, month)
+		},
+		am: "午前",
+		pm: "午後",
+	},
+	"ko": {
+		months:        cjkMonths("월"),
+		shortWeekdays: [...]string{"일", "월", "화", "수", "목", "금", "토"},
+		monthYear: func(month string, year int) string {
+			return fmt.Sprintf("%d년 %s", year, month)
+		},
+		am: "오전",
+		pm: "오후",
+	},
+	"pt": {
+		months:        [...]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+		shortWeekdays: [...]string{"dom", "seg", "ter", "qua", "qui", "sex", "sáb"},
+		monthYear: func(month string, year int) string {
+			return fmt.Sprintf("%s de %d", month, year)
+		},
+		am: "AM",
+		pm: "PM",
+	},
+	"zh": {
+		months:        cjkMonths("月"),
+		shortWeekdays: [...]string{"日", "一", "二", "三", "四", "五", "六"},
+		monthYear: func(month string, year int) string {
+			return fmt.Sprintf("%d年%s", year, month)
+		},
+		am: "上午",
+		pm: "下午",
+	},
+}
+
+func dateSymbolsForLocale(locale language.Tag) dateSymbols {
+	base, _ := locale.Base()
+	if s, ok := theDateSymbols[base.String()]; ok {
+		return s
+	}
+	return englishDateSymbols
+}
+
+// localeRegion returns the region of the locale.
+// If the locale doesn't have an explicit region, the most likely region is used.
+func localeRegion(locale language.Tag) string {
+	if locale == (language.Tag{}) {
+		return ""
+	}
+	region, conf := locale.Region()
+	if conf == language.No {
+		return ""
+	}
+	return region.String()
+}
+
+// sundayFirstRegions and saturdayFirstRegions are the regions where a week starts on Sunday or Saturday.
+// A week starts on Monday in the other regions.
+// These are taken from the week data of CLDR.
+var (
+	sundayFirstRegions   = regionSet("AG AS BD BR BS BT BW BZ CA CO DM DO ET GT GU HK HN ID IL IN JM JP KE KH KR LA MH MM MO MT MX MZ NI NP PA PE PH PK PR PT PY SA SG SV TH TT TW UM US VE VI WS YE ZA ZW")
+	saturdayFirstRegions = regionSet("AE AF BH DJ DZ EG IQ IR JO KW LY OM QA SD SY")
+)
+
+func regionSet(regions string) map[string]struct{} {
+	m := map[string]struct{}{}
+	for _, r := range strings.Fields(regions) {
+		m[r] = struct{}{}
+	}
+	return m
+}
+
+// firstWeekdayForLocale returns the first day of a week for the locale.
+func firstWeekdayForLocale(locale language.Tag) time.Weekday {
+	region := localeRegion(locale)
+	if region == "" {
+		return time.Sunday
+	}
+	if _, ok := sundayFirstRegions[region]; ok {
+		return time.Sunday
+	}
+	if _, ok := saturdayFirstRegions[region]; ok {
+		return time.Saturday
+	}
+	return time.Monday
+}
+
+// twelveHourClockRegions is the regions where the 12-hour clock is commonly used.
+var twelveHourClockRegions = regionSet("AU BD CA EG IN KR NZ PH PK SA TW US")
+
+// uses12HourClockForLocale reports whether the 12-hour clock is commonly used for the locale.
+func uses12HourClockForLocale(locale language.Tag) bool {
+	region := localeRegion(locale)
+	if region == "" {
+		return false
+	}
+	_, ok := twelveHourClockRegions[region]
+	return ok
+}
+
+// dateFieldOrder is the order of the year, the month and the day in a numeric date.
+type dateFieldOrder int
+
+const (
+	dateFieldOrderYMD dateFieldOrder = iota
+	dateFieldOrderMDY
+	dateFieldOrderDMY
+)
+
+// dateFormat is a numeric date format.
+type dateFormat struct {
+	order     dateFieldOrder
+	separator string
+}
+
+var isoDateFormat = dateFormat{
+	order:     dateFieldOrderYMD,
+	separator: "-",
+}
+
+// dateFormatForLocale returns the numeric date format for the locale.
+func dateFormatForLocale(locale language.Tag) dateFormat {
+	if locale == (language.Tag{}) {
+		return isoDateFormat
+	}
+	base, _ := locale.Base()
+	switch base.String() {
+	case "en":
+		switch localeRegion(locale) {
+		case "US", "PH":
+			return dateFormat{order: dateFieldOrderMDY, separator: "/"}
+		case "CA":
+			return isoDateFormat
+		default:
+			return dateFormat{order: dateFieldOrderDMY, separator: "/"}
+		}
+	case "de", "ru":
+		return dateFormat{order: dateFieldOrderDMY, separator: "."}
+	case "es", "fr", "it", "pt":
+		return dateFormat{order: dateFieldOrderDMY, separator: "/"}
+	case "ja", "zh":
+		return dateFormat{order: dateFieldOrderYMD, separator: "/"}
+	case "ko":
+		return dateFormat{order: dateFieldOrderYMD, separator: ". "}
+	default:
+		return isoDateFormat
+	}
+}
+
+// format formats the date as a numeric date.
+func (d dateFormat) format(date civilDate) string {
+	y := fmt.Sprintf("%04d", date.year)
+	m := fmt.Sprintf("%02d", date.month)
+	dd := fmt.Sprintf("%02d", date.day)
+	switch d.order {
+	case dateFieldOrderMDY:
+		return m + d.separator + dd + d.separator + y
+	case dateFieldOrderDMY:
+		return dd + d.separator + m + d.separator + y
+	default:
+		return y + d.separator + m + d.separator + dd
+	}
+}
+
+// dateRangeSeparator is the separator between the start and the end of a formatted date range.
+const dateRangeSeparator = " – "
+
+// formatRange formats the range of the dates as numeric dates.
+// If end is zero, only start is formatted.
+func (d dateFormat) formatRange(start, end civilDate) string {
+	if start.isZero() {
+		return ""
+	}
+	if end.isZero() {
+		return d.format(start)
+	}
+	return d.format(start) + dateRangeSeparator + d.format(end)
+}
+
+func digitFields(text string) []string {
+	return strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsDigit(r)
+	})
+}
+
+// parse parses text as a numeric date.
+// Any non-digit characters are accepted as separators.
+// A two-digit year is interpreted as a year in the 2000s.
+// parse returns false if text is not a valid date.
+func (d dateFormat) parse(text string) (civilDate, bool) {
+	fields := digitFields(text)
+	if len(fields) != 3 {
+		return civilDate{}, false
+	}
+	return d.parseFields(fields)
+}
+
+// parseRange parses text as a range of two numeric dates.
+// parseRange returns false if text is not a valid range.
+func (d dateFormat) parseRange(text string) (start, end civilDate, ok bool) {
+	fields := digitFields(text)
+	if len(fields) != 6 {
+		return civilDate{}, civilDate{}, false
+	}
+	start, ok = d.parseFields(fields[:3])
+	if !ok {
+		return civilDate{}, civilDate{}, false
+	}
+	end, ok = d.parseFields(fields[3:])
+	if !ok || end.compare(start) < 0 {
+		return civilDate{}, civilDate{}, false
+	}
+	return start, end, true
+}
+
+func (d dateFormat) parseFields(fields []string) (civilDate, bool) {
+	var nums [3]int
+	for i, f := range fields {
+		n, err := strconv.Atoi(f)
+		if err != nil {
+			return civilDate{}, false
+		}
+		nums[i] = n
+	}
+
+	var y, m, dd int
+	var yearField string
+	switch d.order {
+	case dateFieldOrderMDY:
+		m, dd, y = nums[0], nums[1], nums[2]
+		yearField = fields[2]
+	case dateFieldOrderDMY:
+		dd, m, y = nums[0], nums[1], nums[2]
+		yearField = fields[2]
+	default:
+		y, m, dd = nums[0], nums[1], nums[2]
+		yearField = fields[0]
+	}
+	if len(yearField) <= 2 {
+		y += 2000
+	}
+	if m < 1 || m > 12 || dd < 1 || dd > daysInMonth(y, time.Month(m)) {
+		return civilDate{}, false
+	}
+	return civilDate{year: y, month: time.Month(m), day: dd}, true
+}
@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package basicwidget_test
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/text/language"
+
+	"github.com/guigui-gui/guigui/basicwidget"
+)
+
+func TestFormatDate(t *testing.T) {
+	date := time.Date(2026, time.March, 7, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		locale language.Tag
+		out    string
+	}{
+		{locale: language.Und, out: "2026-03-07"},
+		{locale: language.AmericanEnglish, out: "03/07/2026"},
+		{locale: language.BritishEnglish, out: "07/03/2026"},
+		{locale: language.German, out: "07.03.2026"},
+		{locale: language.Japanese, out: "2026/03/07"},
+		{locale: language.Korean, out: "2026. 03. 07"},
+	}
+	for _, tc := range testCases {
+		if got := basicwidget.FormatDate(date, tc.locale); got != tc.out {
+			t.Errorf("FormatDate(%v): got: %q, want: %q", tc.locale, got, tc.out)
+		}
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	testCases := []struct {
+		text   string
+		locale language.Tag
+		year   int
+		month  time.Month
+		day    int
+		ok     bool
+	}{
+		{text: "2026-03-07", locale: language.Und, year: 2026, month: time.March, day: 7, ok: true},
+		{text: "3/7/2026", locale: language.AmericanEnglish, year: 2026, month: time.March, day: 7, ok: true},
+		{text: "7/3/26", locale: language.BritishEnglish, year: 2026, month: time.March, day: 7, ok: true},
+		{text: "7.3.2026", locale: language.German, year: 2026, month: time.March, day: 7, ok: true},
+		{text: "2026年3月7日", locale: language.Japanese, year: 2026, month: time.March, day: 7, ok: true},
+		{text: "2024-02-29", locale: language.Und, year: 2024, month: time.February, day: 29, ok: true},
+		{text: "2026-02-29", locale: language.Und},
+		{text: "2026-13-01", locale: language.Und},
+		{text: "2026-03", locale: language.Und},
+		{text: "", locale: language.Und},
+	}
+	for _, tc := range testCases {
+		got, ok := basicwidget.ParseDate(tc.text, tc.locale)
+		if ok != tc.ok {
+			t.Errorf("ParseDate(%q, %v): ok: got: %t, want: %t", tc.text, tc.locale, ok, tc.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if y, m, d := got.Date(); y != tc.year || m != tc.month || d != tc.day {
+			t.Errorf("ParseDate(%q, %v): got: %d-%d-%d, want: %d-%d-%d", tc.text, tc.locale, y, m, d, tc.year, tc.month, tc.day)
+		}
+	}
+}
+
+func TestFirstWeekdayForLocale(t *testing.T) {
+	testCases := []struct {
+		locale language.Tag
+		out    time.Weekday
+	}{
+		{locale: language.Und, out: time.Sunday},
+		{locale: language.AmericanEnglish, out: time.Sunday},
+		{locale: language.BritishEnglish, out: time.Monday},
+		{locale: language.German, out: time.Monday},
+		{locale: language.Japanese, out: time.Sunday},
+		{locale: language.MustParse("ar-EG"), out: time.Saturday},
+	}
+	for _, tc := range testCases {
+		if got := basicwidget.FirstWeekdayForLocale(tc.locale); got != tc.out {
+			t.Errorf("FirstWeekdayForLocale(%v): got: %v, want: %v", tc.locale, got, tc.out)
+		}
+	}
+}
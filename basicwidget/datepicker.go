@@ -0,0 +1,386 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package basicwidget
+
+import (
+	"image"
+	"strings"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/guigui-gui/guigui"
+)
+
+var (
+	datePickerEventValueChanged      guigui.EventKey = guigui.GenerateEventKey()
+	datePickerEventValueRangeChanged guigui.EventKey = guigui.GenerateEventKey()
+)
+
+// DatePicker is a composite widget that combines a [TextInput] with a [Calendar] in a [Popup].
+//
+// The user can type a date in the text input, or pick a date from the calendar opened by the button.
+// The date format in the text input depends on [guigui.Context.FirstLocale].
+// An invalid or disabled date in the text input is reverted on commit.
+//
+// See [Calendar] for how dates are represented.
+type DatePicker struct {
+	guigui.DefaultWidget
+
+	textInput    TextInput
+	button       Button
+	popup        Popup
+	popupContent datePickerPopupContent
+
+	value        civilDate
+	valueEnd     civilDate
+	rangeEnabled bool
+	format       dateFormat
+
+	// calendarValue and calendarValueEnd are the value last pushed to the calendar.
+	calendarValue    civilDate
+	calendarValueEnd civilDate
+
+	onTextInputValueChanged     func(context *guigui.Context, text string, committed bool)
+	onButtonDown                func(context *guigui.Context)
+	onCalendarValueChanged      func(context *guigui.Context, value time.Time)
+	onCalendarValueRangeChanged func(context *guigui.Context, start, end time.Time)
+}
+
+// OnValueChanged sets the event handler that is called when the user changes the date.
+// OnValueChanged is not called in the range selection mode. Use [DatePicker.OnValueRangeChanged] instead.
+func (d *DatePicker) OnValueChanged(f func(context *guigui.Context, value time.Time)) {
+	guigui.SetEventHandler(d, datePickerEventValueChanged, f)
+}
+
+// OnValueRangeChanged sets the event handler that is called when the user changes the range in the range selection mode.
+func (d *DatePicker) OnValueRangeChanged(f func(context *guigui.Context, start, end time.Time)) {
+	guigui.SetEventHandler(d, datePickerEventValueRangeChanged, f)
+}
+
+// Value returns the date, or the start of the range in the range selection mode.
+// Value returns a zero value if no date is set.
+func (d *DatePicker) Value() time.Time {
+	return d.value.time()
+}
+
+// SetValue sets the date.
+// A zero value clears the date.
+func (d *DatePicker) SetValue(value time.Time) {
+	d.value = civilDateOf(value)
+	d.valueEnd = civilDate{}
+}
+
+// ValueRange returns the start and the end of the range in the range selection mode.
+func (d *DatePicker) ValueRange() (start, end time.Time) {
+	return d.value.time(), d.valueEnd.time()
+}
+
+// SetValueRange sets the start and the end of the range in the range selection mode.
+// If end is before start, they are swapped.
+func (d *DatePicker) SetValueRange(start, end time.Time) {
+	s := civilDateOf(start)
+	e := civilDateOf(end)
+	if !s.isZero() && !e.isZero() && e.compare(s) < 0 {
+		s, e = e, s
+	}
+	d.value = s
+	d.valueEnd = e
+}
+
+// IsRangeSelectionEnabled reports whether the user selects a range of dates.
+func (d *DatePicker) IsRangeSelectionEnabled() bool {
+	return d.rangeEnabled
+}
+
+// SetRangeSelectionEnabled sets whether the user selects a range of dates.
+func (d *DatePicker) SetRangeSelectionEnabled(enabled bool) {
+	d.rangeEnabled = enabled
+	if !enabled {
+		d.valueEnd = civilDate{}
+	}
+}
+
+// MinimumValue returns the minimum selectable date.
+func (d *DatePicker) MinimumValue() time.Time {
+	return d.popupContent.calendar.MinimumValue()
+}
+
+// SetMinimumValue sets the minimum selectable date.
+// A zero value removes the minimum.
+func (d *DatePicker) SetMinimumValue(minimum time.Time) {
+	d.popupContent.calendar.SetMinimumValue(minimum)
+}
+
+// MaximumValue returns the maximum selectable date.
+func (d *DatePicker) MaximumValue() time.Time {
+	return d.popupContent.calendar.MaximumValue()
+}
+
+// SetMaximumValue sets the maximum selectable date.
+// A zero value removes the maximum.
+func (d *DatePicker) SetMaximumValue(maximum time.Time) {
+	d.popupContent.calendar.SetMaximumValue(maximum)
+}
+
+// SetDisabledDateFunc sets the function to report whether a date is disabled.
+// See [Calendar.SetDisabledDateFunc].
+func (d *DatePicker) SetDisabledDateFunc(f func(date time.Time) bool) {
+	d.popupContent.calendar.SetDisabledDateFunc(f)
+}
+
+// IsError reports whether the date picker is in the error state.
+func (d *DatePicker) IsError() bool {
+	return d.textInput.IsError()
+}
+
+// SetError sets whether the date picker is in the error state.
+func (d *DatePicker) SetError(hasError bool) {
+	d.textInput.SetError(hasError)
+}
+
+// SupportText returns the support text displayed below the date picker.
+func (d *DatePicker) SupportText() string {
+	return d.textInput.SupportText()
+}
+
+// SetSupportText sets the support text displayed below the date picker.
+func (d *DatePicker) SetSupportText(text string) {
+	d.textInput.SetSupportText(text)
+}
+
+// IsOpen reports whether the calendar popup is open.
+func (d *DatePicker) IsOpen() bool {
+	return d.popup.IsOpen()
+}
+
+// SetOpen opens or closes the calendar popup.
+func (d *DatePicker) SetOpen(open bool) {
+	d.popup.SetOpen(open)
+}
+
+func (d *DatePicker) WriteStateKey(w *guigui.StateKeyWriter) {
+	writeCivilDate(w, d.value)
+	writeCivilDate(w, d.valueEnd)
+	w.WriteBool(d.rangeEnabled)
+}
+
+func (d *DatePicker) valueString() string {
+	if d.rangeEnabled {
+		return d.format.formatRange(d.value, d.valueEnd)
+	}
+	if d.value.isZero() {
+		return ""
+	}
+	return d.format.format(d.value)
+}
+
+func (d *DatePicker) handleCommit(text string) {
+	calendar := &d.popupContent.calendar
+	if strings.TrimSpace(text) == "" {
+		d.setValue(civilDate{}, civilDate{})
+		return
+	}
+	if d.rangeEnabled {
+		if start, end, ok := d.format.parseRange(text); ok && calendar.isDateEnabled(start) && calendar.isDateEnabled(end) {
+			d.setValue(start, end)
+			return
+		}
+	} else {
+		if v, ok := d.format.parse(text); ok && calendar.isDateEnabled(v) {
+			d.setValue(v, civilDate{})
+			return
+		}
+	}
+	// Revert to the current value.
+	d.textInput.ForceSetValue(d.valueString())
+}
+
+func (d *DatePicker) setValue(value, valueEnd civilDate) {
+	changed := d.value != value || d.valueEnd != valueEnd
+	d.value = value
+	d.valueEnd = valueEnd
+	d.textInput.ForceSetValue(d.valueString())
+	if !changed {
+		return
+	}
+	if d.rangeEnabled {
+		guigui.DispatchEvent(d, datePickerEventValueRangeChanged, d.value.time(), d.valueEnd.time())
+	} else {
+		guigui.DispatchEvent(d, datePickerEventValueChanged, d.value.time())
+	}
+}
+
+// updateCalendar pushes the value to the calendar.
+// Unless force is true, the value is pushed only when it is changed since the last push,
+// so that the start of a range picked in the calendar is kept until the end is picked.
+func (d *DatePicker) updateCalendar(force bool) {
+	calendar := &d.popupContent.calendar
+	calendar.SetRangeSelectionEnabled(d.rangeEnabled)
+	if !force && d.calendarValue == d.value && d.calendarValueEnd == d.valueEnd {
+		return
+	}
+	d.calendarValue = d.value
+	d.calendarValueEnd = d.valueEnd
+	if d.rangeEnabled {
+		calendar.SetValueRange(d.value.time(), d.valueEnd.time())
+	} else {
+		calendar.SetValue(d.value.time())
+	}
+}
+
+// handleCalendarValueRange sets the range picked in the calendar.
+// handleCalendarValueRange reports false if only the start of the range is picked yet.
+func (d *DatePicker) handleCalendarValueRange(start, end civilDate) bool {
+	// Wait for the end of the range.
+	if end.isZero() {
+		return false
+	}
+	d.setValue(start, end)
+	return true
+}
+
+func (d *DatePicker) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
+	adder.AddWidget(&d.textInput)
+	adder.AddWidget(&d.button)
+	adder.AddWidget(&d.popup)
+
+	d.format = dateFormatForLocale(context.FirstLocale())
+
+	d.textInput.SetValue(d.valueString())
+	d.textInput.SetTabular(true)
+	d.textInput.setPaddingEnd(UnitSize(context))
+	if d.onTextInputValueChanged == nil {
+		d.onTextInputValueChanged = func(context *guigui.Context, text string, committed bool) {
+			if committed {
+				d.handleCommit(text)
+			}
+		}
+	}
+	d.textInput.OnValueChanged(d.onTextInputValueChanged)
+
+	img, err := theResourceImages.Get("keyboard_arrow_down", context.ColorMode())
+	if err != nil {
+		return err
+	}
+	d.button.SetIcon(img)
+	d.button.SetSharpCorners(Corners{
+		TopStart:    true,
+		BottomStart: true,
+	})
+	if d.onButtonDown == nil {
+		d.onButtonDown = func(context *guigui.Context) {
+			if d.popup.IsOpen() {
+				d.popup.SetOpen(false)
+				return
+			}
+			d.textInput.CommitWithCurrentInputValue()
+			// Discard the pending start of a range picked before the popup was closed.
+			d.updateCalendar(true)
+			d.popup.SetOpen(true)
+			context.SetFocused(&d.popupContent.calendar, true)
+		}
+	}
+	d.button.OnDown(d.onButtonDown)
+	context.SetEnabled(&d.button, d.textInput.IsEditable())
+
+	calendar := &d.popupContent.calendar
+	d.updateCalendar(false)
+	if d.onCalendarValueChanged == nil {
+		d.onCalendarValueChanged = func(context *guigui.Context, value time.Time) {
+			d.setValue(civilDateOf(value), civilDate{})
+			d.popup.SetOpen(false)
+			context.SetFocused(&d.textInput, true)
+		}
+	}
+	calendar.OnValueChanged(d.onCalendarValueChanged)
+	if d.onCalendarValueRangeChanged == nil {
+		d.onCalendarValueRangeChanged = func(context *guigui.Context, start, end time.Time) {
+			if !d.handleCalendarValueRange(civilDateOf(start), civilDateOf(end)) {
+				return
+			}
+			d.popup.SetOpen(false)
+			context.SetFocused(&d.textInput, true)
+		}
+	}
+	calendar.OnValueRangeChanged(d.onCalendarValueRangeChanged)
+
+	d.popup.SetContent(&d.popupContent)
+	d.popup.SetCloseByClickingOutside(true)
+
+	return nil
+}
+
+// HandleButtonInput implements [guigui.Widget.HandleButtonInput].
+func (d *DatePicker) HandleButtonInput(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
+	if d.popup.IsOpen() && guigui.IsKeyJustPressed(ebiten.KeyEscape) {
+		d.popup.SetOpen(false)
+		context.SetFocused(&d.textInput, true)
+		return guigui.HandleInputByWidget(d)
+	}
+	if !d.popup.IsOpen() && d.textInput.IsEditable() && context.IsFocusedOrHasFocusedChild(&d.textInput) &&
+		guigui.IsKeyJustPressed(ebiten.KeyDown) && guigui.IsKeyPressed(ebiten.KeyAlt) {
+		d.onButtonDown(context)
+		return guigui.HandleInputByWidget(d)
+	}
+	return guigui.HandleInputResult{}
+}
+
+func (d *DatePicker) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
+	b := widgetBounds.Bounds()
+	layouter.LayoutWidget(&d.textInput, b)
+
+	// Use only the input height (excluding support text) for button positioning.
+	inputHeight := d.textInput.measureTextInput(context, guigui.FixedWidthConstraints(b.Dx())).Y
+	buttonBounds := image.Rect(b.Max.X-UnitSize(context), b.Min.Y, b.Max.X, b.Min.Y+inputHeight)
+	layouter.LayoutWidget(&d.button, buttonBounds)
+
+	// Exclude the button bounds from close-by-clicking-outside detection,
+	// so that clicking the button while the popup is open toggles the popup.
+	d.popup.popup.Widget().setCloseByClickingOutsideExcludedRect(buttonBounds)
+
+	popupSize := d.popupContent.Measure(context, guigui.Constraints{})
+	appBounds := context.AppBounds()
+
+	// Position the popup below the text input.
+	popupPos := image.Pt(b.Min.X, b.Min.Y+inputHeight)
+
+	// If there is not enough room below, position above.
+	if popupPos.Y+popupSize.Y > appBounds.Max.Y {
+		popupPos.Y = b.Min.Y - popupSize.Y
+	}
+	if popupPos.X+popupSize.X > appBounds.Max.X {
+		popupPos.X = max(appBounds.Max.X-popupSize.X, appBounds.Min.X)
+	}
+
+	layouter.LayoutWidget(&d.popup, image.Rectangle{
+		Min: popupPos,
+		Max: popupPos.Add(popupSize),
+	})
+}
+
+func (d *DatePicker) Measure(context *guigui.Context, constraints guigui.Constraints) image.Point {
+	return d.textInput.Measure(context, constraints)
+}
+
+type datePickerPopupContent struct {
+	guigui.DefaultWidget
+
+	calendar Calendar
+}
+
+func (d *datePickerPopupContent) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
+	adder.AddWidget(&d.calendar)
+	return nil
+}
+
+func (d *datePickerPopupContent) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
+	p := UnitSize(context) / 4
+	layouter.LayoutWidget(&d.calendar, widgetBounds.Bounds().Inset(p))
+}
+
+func (d *datePickerPopupContent) Measure(context *guigui.Context, constraints guigui.Constraints) image.Point {
+	p := UnitSize(context) / 4
+	return d.calendar.Measure(context, guigui.Constraints{}).Add(image.Pt(2*p, 2*p))
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package basicwidget_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/guigui-gui/guigui/basicwidget"
+)
+
+func TestDatePickerValueRangeByCalendar(t *testing.T) {
+	date := func(day int) time.Time {
+		return time.Date(2026, time.March, day, 0, 0, 0, 0, time.UTC)
+	}
+	testCases := []struct {
+		name  string
+		dates []time.Time
+		start time.Time
+		end   time.Time
+	}{
+		{
+			name:  "start only",
+			dates: []time.Time{date(3)},
+		},
+		{
+			name:  "start and end",
+			dates: []time.Time{date(3), date(10)},
+			start: date(3),
+			end:   date(10),
+		},
+		{
+			name:  "end before start",
+			dates: []time.Time{date(10), date(3), date(5)},
+			start: date(3),
+			end:   date(5),
+		},
+		{
+			name:  "same day",
+			dates: []time.Time{date(3), date(3)},
+			start: date(3),
+			end:   date(3),
+		},
+		{
+			name:  "second range",
+			dates: []time.Time{date(3), date(10), date(12), date(20)},
+			start: date(12),
+			end:   date(20),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			start, end := basicwidget.DatePickerValueRangeByCalendar(tc.dates)
+			if !start.Equal(tc.start) || !end.Equal(tc.end) {
+				t.Errorf("got (%v, %v), want (%v, %v)", start, end, tc.start, tc.end)
+			}
+		})
+	}
+}
@@ -0,0 +1,533 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package basicwidget
+
+import (
+	"image"
+	"slices"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+
+	"github.com/guigui-gui/guigui"
+	"github.com/guigui-gui/guigui/basicwidget/basicwidgetdraw"
+	"github.com/guigui-gui/guigui/basicwidget/internal/draw"
+)
+
+var (
+	dialogEventClose guigui.EventKey = guigui.GenerateEventKey()
+)
+
+// DialogButton is a button in a [Dialog].
+type DialogButton struct {
+	// Text is the text of the button.
+	Text string
+
+	// SemanticColor is the semantic color of the button.
+	SemanticColor basicwidgetdraw.SemanticColor
+
+	// Default reports whether the button is pressed by the Enter key.
+	// A default button is shown as a primary button.
+	Default bool
+
+	// Cancel reports whether the button is pressed by the Escape key.
+	Cancel bool
+}
+
+// DialogResult is the result of a [Dialog].
+type DialogResult struct {
+	// ButtonIndex is the index of the pressed button.
+	// ButtonIndex is -1 if the dialog is closed without any button, e.g. by [Dialog.SetOpen].
+	ButtonIndex int
+
+	// Reason is the reason why the dialog is closed.
+	// Reason is [PopupCloseReasonDefault] for the default button, [PopupCloseReasonCancel] for the cancel button,
+	// and [PopupCloseReasonButton] for the other buttons.
+	Reason PopupCloseReason
+
+	// PromptValue is the value of the prompt field.
+	// PromptValue is empty if the prompt field is disabled.
+	PromptValue string
+}
+
+// Dialog is a modal popup with a title, a message, an optional prompt field and a row of buttons.
+//
+// The Enter key presses the default button, and the Escape key presses the cancel button.
+// If there is no cancel button, the Escape key closes the dialog without any button.
+//
+// A dialog can open another dialog.
+// The dialog opened later is shown in front and receives the keys first.
+type Dialog struct {
+	guigui.DefaultWidget
+
+	popup   Popup
+	content dialogContent
+
+	title         string
+	message       string
+	semanticColor basicwidgetdraw.SemanticColor
+	buttons       []DialogButton
+	promptEnabled bool
+
+	pressedButtonIndexPlus1 int
+	closing                 bool
+	focusPending            bool
+
+	onPopupClose func(context *guigui.Context, reason PopupCloseReason)
+}
+
+// OnClose sets the event handler that is called when the dialog is closed.
+func (d *Dialog) OnClose(f func(context *guigui.Context, result DialogResult)) {
+	guigui.SetEventHandler(d, dialogEventClose, f)
+}
+
+// SetTitle sets the title of the dialog.
+func (d *Dialog) SetTitle(title string) {
+	d.title = title
+}
+
+// SetMessage sets the message of the dialog.
+func (d *Dialog) SetMessage(message string) {
+	d.message = message
+}
+
+// SetSemanticColor sets the semantic color of the dialog.
+// The dialog shows an icon for the semantic color unless it is [basicwidgetdraw.SemanticColorBase].
+func (d *Dialog) SetSemanticColor(semanticColor basicwidgetdraw.SemanticColor) {
+	d.semanticColor = semanticColor
+}
+
+// SetButtons sets the buttons of the dialog.
+// A dialog has no buttons by default, as the texts of the buttons depend on the application and its locale.
+// A dialog without buttons can still be closed by the Escape key or [Dialog.SetOpen].
+func (d *Dialog) SetButtons(buttons []DialogButton) {
+	d.buttons = adjustSliceSize(d.buttons, len(buttons))
+	copy(d.buttons, buttons)
+}
+
+// SetPromptEnabled sets whether the dialog has a prompt field.
+func (d *Dialog) SetPromptEnabled(enabled bool) {
+	d.promptEnabled = enabled
+}
+
+// PromptValue returns the value of the prompt field.
+func (d *Dialog) PromptValue() string {
+	return d.content.prompt.Value()
+}
+
+// SetPromptValue sets the value of the prompt field.
+func (d *Dialog) SetPromptValue(value string) {
+	d.content.prompt.SetValue(value)
+}
+
+// IsOpen reports whether the dialog is open.
+func (d *Dialog) IsOpen() bool {
+	return d.popup.IsOpen()
+}
+
+// SetOpen opens or closes the dialog.
+// Closing the dialog by SetOpen reports -1 as the button index.
+func (d *Dialog) SetOpen(open bool) {
+	if open {
+		d.pressedButtonIndexPlus1 = 0
+		d.closing = false
+		d.focusPending = true
+	} else {
+		d.closing = true
+	}
+	d.popup.SetOpen(open)
+}
+
+func (d *Dialog) pressButton(index int) {
+	var reason PopupCloseReason
+	switch b := d.buttons[index]; {
+	case b.Default:
+		reason = PopupCloseReasonDefault
+	case b.Cancel:
+		reason = PopupCloseReasonCancel
+	default:
+		reason = PopupCloseReasonButton
+	}
+	d.close(index, reason)
+}
+
+func (d *Dialog) pressDefaultButton() bool {
+	idx := slices.IndexFunc(d.buttons, func(b DialogButton) bool {
+		return b.Default
+	})
+	if idx < 0 {
+		return false
+	}
+	d.close(idx, PopupCloseReasonDefault)
+	return true
+}
+
+func (d *Dialog) pressCancelButton() {
+	idx := slices.IndexFunc(d.buttons, func(b DialogButton) bool {
+		return b.Cancel
+	})
+	d.close(idx, PopupCloseReasonCancel)
+}
+
+func (d *Dialog) close(buttonIndex int, reason PopupCloseReason) {
+	if d.closing {
+		return
+	}
+	d.pressedButtonIndexPlus1 = buttonIndex + 1
+	d.closing = true
+	d.popup.popup.Widget().setCloseReason(reason)
+	d.popup.SetOpen(false)
+}
+
+func (d *Dialog) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
+	adder.AddWidget(&d.popup)
+
+	d.content.dialog = d
+	d.popup.SetContent(&d.content)
+	d.popup.SetModal(true)
+	d.popup.SetBackgroundDark(true)
+	d.popup.SetCloseByClickingOutside(false)
+	d.popup.SetAnimated(true)
+	if d.onPopupClose == nil {
+		d.onPopupClose = func(context *guigui.Context, reason PopupCloseReason) {
+			result := DialogResult{
+				ButtonIndex: d.pressedButtonIndexPlus1 - 1,
+				Reason:      reason,
+			}
+			if d.promptEnabled {
+				result.PromptValue = d.content.prompt.Value()
+			}
+			d.pressedButtonIndexPlus1 = 0
+			d.closing = false
+			guigui.DispatchEvent(d, dialogEventClose, result)
+		}
+	}
+	d.popup.OnClose(d.onPopupClose)
+
+	return nil
+}
+
+func (d *Dialog) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
+	u := UnitSize(context)
+	app := context.AppBounds()
+	w := min(12*u, app.Dx()-u)
+	size := d.content.Measure(context, guigui.FixedWidthConstraints(w))
+	pos := image.Pt(
+		app.Min.X+(app.Dx()-size.X)/2,
+		app.Min.Y+(app.Dy()-size.Y)/2,
+	)
+	layouter.LayoutWidget(&d.popup, image.Rectangle{
+		Min: pos,
+		Max: pos.Add(size),
+	})
+}
+
+type dialogContent struct {
+	guigui.DefaultWidget
+
+	dialog *Dialog
+
+	icon    dialogIcon
+	title   Text
+	message Text
+	prompt  TextInput
+	buttons guigui.WidgetSlice[*Button]
+
+	promptEnterPressed        bool
+	onButtonsUp               []func(context *guigui.Context)
+	onPromptHandleButtonInput func(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult
+	onPromptValueChanged      func(context *guigui.Context, text string, committed bool)
+
+	layout            guigui.LinearLayout
+	layoutItems       []guigui.LinearLayoutItem
+	headerLayout      guigui.LinearLayout
+	headerLayoutItems []guigui.LinearLayoutItem
+	buttonLayout      guigui.LinearLayout
+	buttonLayoutItems []guigui.LinearLayoutItem
+}
+
+func (d *dialogContent) WriteStateKey(w *guigui.StateKeyWriter) {
+	dialog := d.dialog
+	w.WriteString(dialog.title)
+	w.WriteString(dialog.message)
+	w.WriteInt(int(dialog.semanticColor))
+	w.WriteBool(dialog.promptEnabled)
+	w.WriteBool(dialog.closing)
+	buttons := dialog.buttons
+	w.WriteInt(len(buttons))
+	for _, b := range buttons {
+		w.WriteString(b.Text)
+		w.WriteInt(int(b.SemanticColor))
+		w.WriteBool(b.Default)
+		w.WriteBool(b.Cancel)
+	}
+}
+
+func (d *dialogContent) hasHeader() bool {
+	return d.dialog.title != "" || d.dialog.semanticColor != basicwidgetdraw.SemanticColorBase
+}
+
+func (d *dialogContent) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
+	dialog := d.dialog
+
+	// Receive keys without focus so that the Enter and the Escape keys work whichever widget is focused.
+	// The dialog in front receives the keys first.
+	context.SetButtonInputReceptive(d, !dialog.closing)
+
+	if d.hasHeader() {
+		if dialog.semanticColor != basicwidgetdraw.SemanticColorBase {
+			adder.AddWidget(&d.icon)
+			d.icon.semanticColor = dialog.semanticColor
+		}
+		adder.AddWidget(&d.title)
+		d.title.SetValue(dialog.title)
+		d.title.SetBold(true)
+		d.title.SetWrapMode(WrapModeWord)
+		d.title.SetVerticalAlign(VerticalAlignMiddle)
+	}
+
+	if dialog.message != "" {
+		adder.AddWidget(&d.message)
+		d.message.SetValue(dialog.message)
+		d.message.SetWrapMode(WrapModeWord)
+	}
+
+	if dialog.promptEnabled {
+		adder.AddWidget(&d.prompt)
+		if d.onPromptHandleButtonInput == nil {
+			d.onPromptHandleButtonInput = func(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
+				// The text input consumes the Enter key to commit the value.
+				// Remember it so that the commit presses the default button.
+				d.promptEnterPressed = guigui.IsKeyJustPressed(ebiten.KeyEnter)
+				return guigui.HandleInputResult{}
+			}
+		}
+		d.prompt.OnHandleButtonInput(d.onPromptHandleButtonInput)
+		if d.onPromptValueChanged == nil {
+			d.onPromptValueChanged = func(context *guigui.Context, text string, committed bool) {
+				if !committed || !d.promptEnterPressed {
+					return
+				}
+				d.promptEnterPressed = false
+				d.dialog.pressDefaultButton()
+			}
+		}
+		d.prompt.OnValueChanged(d.onPromptValueChanged)
+	}
+
+	buttons := dialog.buttons
+	d.buttons.SetLen(len(buttons))
+	d.onButtonsUp = adjustSliceSize(d.onButtonsUp, len(buttons))
+	for i, button := range buttons {
+		b := d.buttons.At(i)
+		adder.AddWidget(b)
+		b.SetText(button.Text)
+		b.SetSemanticColor(button.SemanticColor)
+		if button.Default {
+			b.SetType(ButtonTypePrimary)
+		} else {
+			b.SetType(ButtonTypeNormal)
+		}
+		if d.onButtonsUp[i] == nil {
+			d.onButtonsUp[i] = func(context *guigui.Context) {
+				if i >= len(d.dialog.buttons) {
+					return
+				}
+				d.dialog.pressButton(i)
+			}
+		}
+		b.OnUp(d.onButtonsUp[i])
+	}
+
+	return nil
+}
+
+// HandleButtonInput implements [guigui.Widget.HandleButtonInput].
+func (d *dialogContent) HandleButtonInput(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
+	if d.dialog.closing {
+		return guigui.HandleInputResult{}
+	}
+	if guigui.IsKeyJustPressed(ebiten.KeyEnter) {
+		if d.dialog.pressDefaultButton() {
+			return guigui.HandleInputByWidget(d)
+		}
+		return guigui.HandleInputResult{}
+	}
+	if guigui.IsKeyJustPressed(ebiten.KeyEscape) {
+		d.dialog.pressCancelButton()
+		return guigui.HandleInputByWidget(d)
+	}
+	return guigui.HandleInputResult{}
+}
+
+func (d *dialogContent) Tick(context *guigui.Context, widgetBounds *guigui.WidgetBounds) error {
+	// Focus after opening, as a widget can be focused only when it is in the widget tree.
+	if !d.dialog.focusPending {
+		return nil
+	}
+	d.dialog.focusPending = false
+	if d.dialog.promptEnabled {
+		context.SetFocused(&d.prompt, true)
+	} else {
+		context.SetFocused(d, true)
+	}
+	return nil
+}
+
+func (d *dialogContent) buildLayout(context *guigui.Context) {
+	u := UnitSize(context)
+
+	d.layoutItems = slices.Delete(d.layoutItems, 0, len(d.layoutItems))
+
+	if d.hasHeader() {
+		d.headerLayoutItems = slices.Delete(d.headerLayoutItems, 0, len(d.headerLayoutItems))
+		if d.dialog.semanticColor != basicwidgetdraw.SemanticColorBase {
+			d.headerLayoutItems = append(d.headerLayoutItems, guigui.LinearLayoutItem{
+				Widget: &d.icon,
+				Size:   guigui.FixedSize(u),
+			})
+		}
+		d.headerLayoutItems = append(d.headerLayoutItems, guigui.LinearLayoutItem{
+			Widget: &d.title,
+			Size:   guigui.FlexibleSize(1),
+		})
+		d.headerLayout = guigui.LinearLayout{
+			Direction: guigui.LayoutDirectionHorizontal,
+			Items:     d.headerLayoutItems,
+			Gap:       u / 2,
+		}
+		d.layoutItems = append(d.layoutItems, guigui.LinearLayoutItem{
+			Layout: &d.headerLayout,
+		})
+	}
+
+	if d.dialog.message != "" {
+		d.layoutItems = append(d.layoutItems, guigui.LinearLayoutItem{
+			Widget: &d.message,
+		})
+	}
+
+	if d.dialog.promptEnabled {
+		d.layoutItems = append(d.layoutItems, guigui.LinearLayoutItem{
+			Widget: &d.prompt,
+		})
+	}
+
+	if d.buttons.Len() > 0 {
+		d.buttonLayoutItems = slices.Delete(d.buttonLayoutItems, 0, len(d.buttonLayoutItems))
+		d.buttonLayoutItems = append(d.buttonLayoutItems, guigui.LinearLayoutItem{
+			Size: guigui.FlexibleSize(1),
+		})
+		for i := range d.buttons.Len() {
+			d.buttonLayoutItems = append(d.buttonLayoutItems, guigui.LinearLayoutItem{
+				Widget: d.buttons.At(i),
+			})
+		}
+		d.buttonLayout = guigui.LinearLayout{
+			Direction: guigui.LayoutDirectionHorizontal,
+			Items:     d.buttonLayoutItems,
+			Gap:       u / 4,
+		}
+		d.layoutItems = append(d.layoutItems, guigui.LinearLayoutItem{
+			Layout: &d.buttonLayout,
+		})
+	}
+
+	d.layout = guigui.LinearLayout{
+		Direction: guigui.LayoutDirectionVertical,
+		Items:     d.layoutItems,
+		Gap:       u / 2,
+		Padding: guigui.Padding{
+			Start:  u * 3 / 4,
+			Top:    u * 3 / 4,
+			End:    u * 3 / 4,
+			Bottom: u * 3 / 4,
+		},
+	}
+}
+
+func (d *dialogContent) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
+	d.buildLayout(context)
+	d.layout.LayoutWidgets(context, widgetBounds.Bounds(), layouter)
+}
+
+func (d *dialogContent) Measure(context *guigui.Context, constraints guigui.Constraints) image.Point {
+	d.buildLayout(context)
+	return d.layout.Measure(context, constraints)
+}
+
+// dialogIcon is a circle icon with a glyph for a semantic color.
+type dialogIcon struct {
+	guigui.DefaultWidget
+
+	semanticColor basicwidgetdraw.SemanticColor
+}
+
+func (d *dialogIcon) WriteStateKey(w *guigui.StateKeyWriter) {
+	w.WriteInt(int(d.semanticColor))
+}
+
+func (d *dialogIcon) Draw(context *guigui.Context, widgetBounds *guigui.WidgetBounds, dst *ebiten.Image) {
+	b := widgetBounds.Bounds()
+	size := float32(min(b.Dx(), b.Dy()))
+	cx := float32(b.Min.X+b.Max.X) / 2
+	cy := float32(b.Min.Y+b.Max.Y) / 2
+	r := size * 3 / 8
+
+	cm := context.ColorMode()
+	vector.FillCircle(dst, cx, cy, r, draw.Color(cm, draw.SemanticColor(d.semanticColor), 0.5), true)
+
+	// Glyphs are drawn in a unit square from (-1, -1) to (1, 1) scaled by s.
+	s := r / 2
+	var path vector.Path
+	var dotY float32
+	var hasDot bool
+	switch d.semanticColor {
+	case basicwidgetdraw.SemanticColorAccent, basicwidgetdraw.SemanticColorInfo:
+		// i
+		path.MoveTo(cx, cy-0.1*s)
+		path.LineTo(cx, cy+s)
+		dotY = cy - 0.8*s
+		hasDot = true
+	case basicwidgetdraw.SemanticColorSuccess:
+		// Check mark
+		path.MoveTo(cx-0.9*s, cy)
+		path.LineTo(cx-0.25*s, cy+0.65*s)
+		path.LineTo(cx+0.9*s, cy-0.6*s)
+	case basicwidgetdraw.SemanticColorWarning:
+		// !
+		path.MoveTo(cx, cy-s)
+		path.LineTo(cx, cy+0.2*s)
+		dotY = cy + 0.9*s
+		hasDot = true
+	case basicwidgetdraw.SemanticColorDanger:
+		// ×
+		o := 0.7 * s
+		path.MoveTo(cx-o, cy-o)
+		path.LineTo(cx+o, cy+o)
+		path.MoveTo(cx+o, cy-o)
+		path.LineTo(cx-o, cy+o)
+	default:
+		return
+	}
+
+	clr := draw.Color2(cm, draw.SemanticColorBase, 1, 1)
+	strokeWidth := max(s/3, float32(1*context.Scale()))
+	strokeOp := &vector.StrokeOptions{}
+	strokeOp.Width = strokeWidth
+	strokeOp.LineCap = vector.LineCapRound
+	strokeOp.LineJoin = vector.LineJoinRound
+	drawOp := &vector.DrawPathOptions{}
+	drawOp.AntiAlias = true
+	drawOp.ColorScale.ScaleWithColor(clr)
+	vector.StrokePath(dst, &path, strokeOp, drawOp)
+	if hasDot {
+		vector.FillCircle(dst, cx, dotY, strokeWidth*0.6, clr, true)
+	}
+}
+
+func (d *dialogIcon) Measure(context *guigui.Context, constraints guigui.Constraints) image.Point {
+	u := UnitSize(context)
+	return image.Pt(u, u)
+}
@@ -19,10 +19,8 @@ type Divider struct {
 
 func (d *Divider) Draw(context *guigui.Context, widgetBounds *guigui.WidgetBounds, dst *ebiten.Image) {
 	bounds := widgetBounds.Bounds()
-	strokeWidth := float32(1 * context.Scale())
-	clr := draw.Color(context.ColorMode(), draw.SemanticColorBase, 0.8)
 	y := float32(bounds.Min.Y+bounds.Max.Y) / 2
-	vector.StrokeLine(dst, float32(bounds.Min.X), y, float32(bounds.Max.X), y, strokeWidth, clr, false)
+	drawDividerLine(context, dst, float32(bounds.Min.X), y, float32(bounds.Max.X), y)
 }
 
 func (d *Divider) Measure(context *guigui.Context, constraints guigui.Constraints) image.Point {
@@ -32,3 +30,10 @@ func (d *Divider) Measure(context *guigui.Context, constraints guigui.Constraint
 	}
 	return image.Pt(w, UnitSize(context))
 }
+
+// drawDividerLine draws a divider line from (x0, y0) to (x1, y1).
+func drawDividerLine(context *guigui.Context, dst *ebiten.Image, x0, y0, x1, y1 float32) {
+	strokeWidth := float32(1 * context.Scale())
+	clr := draw.Color(context.ColorMode(), draw.SemanticColorBase, 0.8)
+	vector.StrokeLine(dst, x0, y0, x1, y1, strokeWidth, clr, false)
+}
@@ -9,7 +9,6 @@ import (
 
 	"github.com/guigui-gui/guigui"
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
 )
 
 var (
@@ -261,7 +260,7 @@ func (e *expanderHeader) Measure(context *guigui.Context, constraints guigui.Con
 
 func (e *expanderHeader) HandlePointingInput(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
 	if widgetBounds.IsHitAtCursor() {
-		if inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
+		if guigui.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
 			guigui.DispatchEvent(e, expanderHeaderEventDown)
 			return guigui.HandleInputByWidget(e)
 		}
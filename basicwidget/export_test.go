@@ -3,6 +3,15 @@
 
 package basicwidget
 
+import (
+	"io/fs"
+	"math/big"
+	"slices"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
 func ReplaceNewLinesWithSpace(text string, start, end int) (string, int, int) {
 	return replaceNewLinesWithSpace(text, start, end)
 }
@@ -15,6 +24,23 @@ func BottomFracIdx(measure func(index int) int, totalCount, viewportHeight int)
 	return bottomFracIdx(measure, totalCount, viewportHeight)
 }
 
+// VirtualScrollWheelOffsets animates the wheel deltas with smooth scrolling and returns the vertical offset at each tick.
+// deltas[i] is the wheel delta in pixels given at the tick i.
+func VirtualScrollWheelOffsets(deltas []float64, ticks int) []int {
+	p := &virtualScrollPanel{
+		onceDraw: true,
+	}
+	var offsets []int
+	for i := range ticks {
+		if i < len(deltas) && deltas[i] != 0 {
+			p.setScrollOffsetByDelta(0, deltas[i])
+		}
+		p.advanceScrollAnimation()
+		offsets = append(offsets, p.topItemOffset)
+	}
+	return offsets
+}
+
 type AbstractListValuer[T comparable] interface {
 	valuer[T]
 }
@@ -40,3 +66,246 @@ func (a AbstractListTestItem[T]) selectable() bool {
 func (a AbstractListTestItem[T]) visible() bool {
 	return a.Visible
 }
+
+// AbstractListTestItemSource provides items of the given count lazily.
+// The item at index i has the value i, and is selectable if i is even.
+type AbstractListTestItemSource struct {
+	Count int
+
+	// ItemAtCallCount is the number of the calls of itemAt.
+	ItemAtCallCount int
+}
+
+func (a *AbstractListTestItemSource) itemCount() int {
+	return a.Count
+}
+
+func (a *AbstractListTestItemSource) itemAt(index int) AbstractListTestItem[int] {
+	a.ItemAtCallCount++
+	return AbstractListTestItem[int]{
+		Value:      index,
+		Selectable: index%2 == 0,
+		Visible:    true,
+	}
+}
+
+func (a *AbstractListTestItemSource) indexByValue(value int) int {
+	if value < 0 || value >= a.Count {
+		return -1
+	}
+	return value
+}
+
+func FormatFixedPoint(value *big.Int, fractionDigits int, locale language.Tag, grouping bool) string {
+	return formatFixedPoint(value, fractionDigits, numberSymbolsForLocale(locale), grouping)
+}
+
+func ParseFixedPoint(text string, fractionDigits int, locale language.Tag) (*big.Int, bool) {
+	return parseFixedPoint(text, fractionDigits, numberSymbolsForLocale(locale))
+}
+
+func FormatDate(date time.Time, locale language.Tag) string {
+	return dateFormatForLocale(locale).format(civilDateOf(date))
+}
+
+func ParseDate(text string, locale language.Tag) (time.Time, bool) {
+	d, ok := dateFormatForLocale(locale).parse(text)
+	return d.time(), ok
+}
+
+func FirstWeekdayForLocale(locale language.Tag) time.Weekday {
+	return firstWeekdayForLocale(locale)
+}
+
+func HSVToRGB(h, s, v float64) (r, g, b float64) {
+	return hsvToRGB(h, s, v)
+}
+
+func RGBToHSV(r, g, b float64) (h, s, v float64) {
+	return rgbToHSV(r, g, b)
+}
+
+func HSVToHSL(s, v float64) (sl, l float64) {
+	return hsvToHSL(s, v)
+}
+
+func HSLToHSV(sl, l float64) (s, v float64) {
+	return hslToHSV(sl, l)
+}
+
+func FormatHexColor(r, g, b, a float64, withAlpha bool) string {
+	return formatHexColor(r, g, b, a, withAlpha)
+}
+
+func ParseHexColor(text string) (r, g, b, a float64, ok bool) {
+	return parseHexColor(text)
+}
+
+type TreeViewTestItem[T comparable] struct {
+	Value       T
+	IndentLevel int
+	Collapsed   bool
+	Placeholder bool
+}
+
+// TreeViewItems returns the flattened items of a tree view and whether some child nodes are being loaded.
+func TreeViewItems[T comparable](dataSource TreeViewDataSource[T], expanded []T) ([]TreeViewTestItem[T], bool) {
+	var t treeViewRows[T]
+	t.flatten(dataSource, func(value T) bool {
+		return slices.Contains(expanded, value)
+	})
+	var items []TreeViewTestItem[T]
+	for _, item := range t.listItems {
+		items = append(items, TreeViewTestItem[T]{
+			Value:       item.Value.value,
+			IndentLevel: item.IndentLevel,
+			Collapsed:   item.Collapsed,
+			Placeholder: item.Value.placeholder,
+		})
+	}
+	return items, len(t.loadingItemIndices) > 0
+}
+
+// TreeViewHasAnyNode reports whether any of the nodes with values is shown in a tree view.
+func TreeViewHasAnyNode[T comparable](dataSource TreeViewDataSource[T], expanded []T, values []T) bool {
+	var t treeViewRows[T]
+	t.flatten(dataSource, func(value T) bool {
+		return slices.Contains(expanded, value)
+	})
+	return t.hasAnyNode(values)
+}
+
+// SortedTableRowValues returns the values of the rows in the displayed order.
+func SortedTableRowValues[T comparable](columns []TableColumn, sortColumns []TableSortColumn, rows []TableRow[T]) []T {
+	rows = slices.Clone(rows)
+	sortTableRows(rows, func(a, b TableRow[T]) int {
+		return compareTableRows(columns, sortColumns, a, b)
+	})
+	var values []T
+	for _, row := range rows {
+		values = append(values, row.Value)
+	}
+	return values
+}
+
+// GroupedTableRows returns the rows in the displayed order including the group rows,
+// and whether each row is a group row.
+func GroupedTableRows[T comparable](columns []TableColumn, sortColumns []TableSortColumn, groupColumnIndex int, collapsedGroups []string, rows []TableRow[T]) ([]TableRow[T], []bool) {
+	collapsed := map[string]bool{}
+	for _, key := range collapsedGroups {
+		collapsed[key] = true
+	}
+	var t tableDisplayedRows[T]
+	t.update(rows, columns, sortColumns, groupColumnIndex, collapsed)
+	var groups []bool
+	for _, info := range t.tableRowInfos {
+		groups = append(groups, info.group)
+	}
+	return t.tableRows, groups
+}
+
+// TableFooterTexts returns the texts of the aggregates in the footer.
+func TableFooterTexts[T comparable](columns []TableColumn, rows []TableRow[T]) []string {
+	var t tableDisplayedRows[T]
+	t.update(rows, columns, nil, -1, nil)
+	var texts []string
+	for _, c := range t.footerCells {
+		texts = append(texts, c.Text)
+	}
+	return texts
+}
+
+// NextEditableTableCell returns the next cell to edit by Tab, or by Shift+Tab if backward is true.
+func NextEditableTableCell[T comparable](columns []TableColumn, rows []TableRow[T], rowIndex, columnIndex int, backward bool) (int, int, bool) {
+	visibleColumnIndices := make([]int, len(columns))
+	for i := range visibleColumnIndices {
+		visibleColumnIndices[i] = i
+	}
+	move := tableCellEditorMoveNext
+	if backward {
+		move = tableCellEditorMovePrev
+	}
+	return nextEditableTableCell(len(rows), visibleColumnIndices, rowIndex, columnIndex, move, func(rowIndex, columnIndex int) bool {
+		return isTableCellEditable(columns, visibleColumnIndices, rows[rowIndex], columnIndex)
+	})
+}
+
+// TableCellEditorValue returns the value of the editor of the column started with the text of a cell.
+func TableCellEditorValue(column TableColumn, text string) TableCellValue {
+	var e tableCellEditor[int]
+	e.reset(&column, text)
+	return e.value()
+}
+
+// TableColumnRangesX returns the horizontal ranges of the visible columns with the given widths,
+// where the left edge of the first column without scrolling is at 0.
+func TableColumnRangesX(widths []int, frozenLeading, frozenTrailing int, offsetX, minOffsetX float64) [][2]int {
+	leading, trailing := clampFrozenColumnCounts(len(widths), frozenLeading, frozenTrailing)
+	var ranges [][2]int
+	for pos := range widths {
+		x0, x1 := tableColumnRangeX(widths, leading, trailing, int(offsetX), pos, offsetX, minOffsetX)
+		ranges = append(ranges, [2]int{x0, x1})
+	}
+	return ranges
+}
+
+func DroppedFileTexts(files fs.FS, mode TextInputFileDropMode) []string {
+	return droppedFileTexts(files, mode)
+}
+
+// SetSliderThumbValue sets the value of the low or high thumb as dragging the thumb does.
+func SetSliderThumbValue(s *Slider, high bool, value int) {
+	thumb := sliderThumbLow
+	if high {
+		thumb = sliderThumbHigh
+	}
+	s.setThumbValue(thumb, s.abstractNumberInput.fromInt(big.NewInt(int64(value))))
+}
+
+func DistributeSplitPanelPaneSizes(mainSize int, requested, minSizes, maxSizes []int, collapsed []bool, flexIndex int) []int {
+	sizes := make([]int, len(requested))
+	distributeSplitPanelPaneSizes(sizes, mainSize, requested, minSizes, maxSizes, collapsed, flexIndex)
+	return sizes
+}
+
+func HasPrefixCollated(locale language.Tag, str, prefix string) bool {
+	return hasPrefixCollated(collatorForLocale(locale), str, prefix)
+}
+
+func IndexCollated(locale language.Tag, str, substr string) (int, int, bool) {
+	return indexCollated(collatorForLocale(locale), str, substr)
+}
+
+func NextMatchingIndex(count int, start int, match func(index int) bool) int {
+	return nextMatchingIndex(count, start, match)
+}
+
+// ListMarqueeItemIndices returns the indices of the available items between the two points.
+// A point is the index of an item and the offset from the top of the item.
+func ListMarqueeItemIndices(index0, offsetY0, index1, offsetY1 int, count int, available func(index int) bool) []int {
+	return appendListMarqueeItemIndices(nil, listMarqueePoint{index: index0, offsetY: offsetY0}, listMarqueePoint{index: index1, offsetY: offsetY1}, count, available)
+}
+
+func GridViewColumnCount(width, pitch int) int {
+	return gridViewColumnCount(width, pitch)
+}
+
+func GridViewNextIndex(current, delta, count, columnCount int, selectable func(index int) bool) int {
+	return gridViewNextIndex(current, delta, count, columnCount, selectable)
+}
+
+// DatePickerValueRangeByCalendar picks the dates in the calendar of a date picker in the range selection mode one by one,
+// and returns the range of the date picker.
+// The date picker is built before each pick.
+func DatePickerValueRangeByCalendar(dates []time.Time) (time.Time, time.Time) {
+	var d DatePicker
+	d.SetRangeSelectionEnabled(true)
+	c := &d.popupContent.calendar
+	for _, date := range dates {
+		d.updateCalendar(false)
+		c.selectDate(civilDateOf(date))
+		start, end := c.ValueRange()
+		d.handleCalendarValueRange(civilDateOf(start), civilDateOf(end))
+	}
+	return d.ValueRange()
+}
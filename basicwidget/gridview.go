@@ -0,0 +1,1118 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package basicwidget
+
+import (
+	"image"
+	"slices"
+	"unsafe"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+
+	"github.com/guigui-gui/guigui"
+	"github.com/guigui-gui/guigui/basicwidget/basicwidgetdraw"
+	"github.com/guigui-gui/guigui/basicwidget/internal/draw"
+)
+
+var (
+	gridViewEventItemSelected  guigui.EventKey = guigui.GenerateEventKey()
+	gridViewEventItemsSelected guigui.EventKey = guigui.GenerateEventKey()
+	gridViewEventItemsMoved    guigui.EventKey = guigui.GenerateEventKey()
+	gridViewEventItemsCanMove  guigui.EventKey = guigui.GenerateEventKey()
+	gridViewEventItemActivated guigui.EventKey = guigui.GenerateEventKey()
+)
+
+// GridViewItem is an item of a [GridView].
+type GridViewItem[T comparable] struct {
+	// Image is the image of the tile, e.g. a thumbnail.
+	// The image is scaled to fit a square area of the tile.
+	Image *ebiten.Image
+
+	// Text is the caption shown below the image.
+	// The caption is a single line, and is truncated with an ellipsis if it is too long.
+	Text string
+
+	// Content is the widget shown instead of Image.
+	// The height of the content is measured with the tile width.
+	Content guigui.Widget
+
+	Unselectable bool
+	Movable      bool
+	Value        T
+}
+
+// writeStateKey writes the item's state into w.
+func (g *GridViewItem[T]) writeStateKey(w *guigui.StateKeyWriter) {
+	w.WriteUint64(uint64(uintptr(unsafe.Pointer(g.Image))))
+	w.WriteString(g.Text)
+	w.WriteWidget(g.Content)
+	w.WriteBool(g.Unselectable)
+	w.WriteBool(g.Movable)
+
+	// Value is not written because it's opaque and only used for
+	// identifying the item, so it does not affect the widget state.
+}
+
+// GridView is a widget to show items as tiles wrapping into rows, e.g. for an image or icon collection.
+//
+// The tiles have a fixed width, and the number of the columns depends on the width of the grid view.
+// Only the rows around the viewport are built and laid out, so a grid view can have tens of thousands of items.
+//
+// The selection works in the same way as [List].
+// The arrow keys move the selection in two dimensions, and extend the selection with the Shift key.
+type GridView[T comparable] struct {
+	guigui.DefaultWidget
+
+	abstractItems   []gridViewAbstractItem[T]
+	itemWidgets     guigui.WidgetSlice[*gridViewItemWidget[T]]
+	dataSourceItems gridViewDataSourceItems[T]
+	content         gridViewContent[T]
+	inner           roundedCornerWidget[*gridViewInner[T]]
+}
+
+type gridViewInner[T comparable] struct {
+	guigui.DefaultWidget
+
+	background gridViewBackground
+	panel      virtualScrollPanel
+	frame      listFrame
+}
+
+func (g *gridViewInner[T]) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
+	adder.AddWidget(&g.background)
+	adder.AddWidget(&g.panel)
+	adder.AddWidget(&g.frame)
+	return nil
+}
+
+func (g *gridViewInner[T]) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
+	layouter.LayoutWidget(&g.background, widgetBounds.Bounds())
+	layouter.LayoutWidget(&g.panel, widgetBounds.Bounds())
+	layouter.LayoutWidget(&g.frame, widgetBounds.Bounds())
+}
+
+func (g *GridView[T]) SetMultiSelection(multi bool) {
+	g.content.abstractList.SetMultiSelection(multi)
+}
+
+// SetItemWidth sets the width of the tiles.
+// If width is 0 or less, the default width is used.
+func (g *GridView[T]) SetItemWidth(width int) {
+	g.content.itemWidth = width
+}
+
+// SetItemHeight sets the height of the tiles.
+// If height is 0 or less, the height of each row is the maximum measured height of the tiles in the row.
+//
+// With a data source, a fixed height is recommended, as measuring the tiles loads the items.
+func (g *GridView[T]) SetItemHeight(height int) {
+	g.content.itemHeight = height
+}
+
+func (g *GridView[T]) OnItemSelected(f func(context *guigui.Context, index int)) {
+	guigui.SetEventHandler(&g.content, gridViewEventItemSelected, f)
+}
+
+func (g *GridView[T]) OnItemsSelected(f func(context *guigui.Context, indices []int)) {
+	guigui.SetEventHandler(&g.content, gridViewEventItemsSelected, f)
+}
+
+// OnItemsMoved sets the event handler that is called when the selected movable items are dragged and dropped.
+// from and count are the range of the moved items, and to is the index where the items are inserted before moving.
+// The grid view doesn't move the items by itself. Update the items in the handler.
+func (g *GridView[T]) OnItemsMoved(f func(context *guigui.Context, from, count, to int)) {
+	guigui.SetEventHandler(&g.content, gridViewEventItemsMoved, f)
+}
+
+func (g *GridView[T]) OnItemsCanMove(f func(context *guigui.Context, from, count, to int) bool) {
+	guigui.SetEventHandler(&g.content, gridViewEventItemsCanMove, f)
+}
+
+// OnItemActivated sets the event handler that is called when an item is double-clicked.
+func (g *GridView[T]) OnItemActivated(f func(context *guigui.Context, index int)) {
+	guigui.SetEventHandler(&g.content, gridViewEventItemActivated, f)
+}
+
+// SetItems sets the items of the grid view.
+func (g *GridView[T]) SetItems(items []GridViewItem[T]) {
+	g.dataSourceItems.setDataSource(nil)
+
+	g.abstractItems = adjustSliceSize(g.abstractItems, len(items))
+	g.itemWidgets.SetLen(len(items))
+
+	for i, item := range items {
+		w := g.itemWidgets.At(i)
+		w.setItem(item)
+		w.setPlaceholder(false)
+		g.abstractItems[i] = gridViewAbstractItem[T]{
+			Content:      w,
+			Unselectable: item.Unselectable,
+			Movable:      item.Movable,
+			Value:        item.Value,
+		}
+	}
+	g.content.SetItems(g.abstractItems)
+}
+
+// SetDataSource sets the data source to provide the items lazily, instead of [GridView.SetItems].
+// The grid view queries only the items around the viewport.
+//
+// If dataSource is nil, the grid view has no items.
+func (g *GridView[T]) SetDataSource(dataSource GridViewDataSource[T]) {
+	if dataSource == nil {
+		g.SetItems(nil)
+		return
+	}
+	g.abstractItems = slices.Delete(g.abstractItems, 0, len(g.abstractItems))
+	g.itemWidgets.SetLen(0)
+	g.dataSourceItems.setDataSource(dataSource)
+	g.content.setItemSource(&g.dataSourceItems)
+}
+
+func (g *GridView[T]) ItemCount() int {
+	return g.content.abstractList.ItemCount()
+}
+
+// ItemByIndex returns the item at the given index.
+// With a data source, ItemByIndex returns false if the item is not available yet.
+func (g *GridView[T]) ItemByIndex(index int) (GridViewItem[T], bool) {
+	if index < 0 || index >= g.ItemCount() {
+		return GridViewItem[T]{}, false
+	}
+	if g.dataSourceItems.dataSource != nil {
+		return g.dataSourceItems.dataSource.ItemAt(index)
+	}
+	return g.itemWidgets.At(index).item, true
+}
+
+func (g *GridView[T]) IndexByValue(value T) int {
+	return g.content.abstractList.indexByValue(value)
+}
+
+func (g *GridView[T]) SelectedItemCount() int {
+	return g.content.abstractList.SelectedItemCount()
+}
+
+func (g *GridView[T]) SelectedItemIndex() int {
+	return g.content.abstractList.SelectedItemIndex()
+}
+
+func (g *GridView[T]) AppendSelectedItemIndices(indices []int) []int {
+	return g.content.abstractList.AppendSelectedItemIndices(indices)
+}
+
+func (g *GridView[T]) SelectedItem() (GridViewItem[T], bool) {
+	return g.ItemByIndex(g.SelectedItemIndex())
+}
+
+func (g *GridView[T]) SelectItemByIndex(index int) {
+	g.content.abstractList.SelectItemByIndex(index, false)
+}
+
+func (g *GridView[T]) SelectItemsByIndices(indices []int) {
+	g.content.abstractList.SelectItemsByIndices(indices, false)
+}
+
+func (g *GridView[T]) SelectAllItems() {
+	g.content.abstractList.SelectAllItems(false)
+}
+
+func (g *GridView[T]) SelectItemByValue(value T) {
+	g.content.abstractList.SelectItemByValue(value, false)
+}
+
+func (g *GridView[T]) SelectItemsByValues(values []T) {
+	g.content.abstractList.SelectItemsByValues(values, false)
+}
+
+func (g *GridView[T]) JumpToItemByIndex(index int) {
+	g.content.JumpToItemByIndex(index)
+}
+
+func (g *GridView[T]) EnsureItemVisibleByIndex(index int) {
+	g.content.EnsureItemVisibleByIndex(index)
+}
+
+// ItemBounds returns the bounds of the tile at the given index.
+// ItemBounds returns an empty rectangle if the tile is not laid out.
+//
+// ItemBounds is available after the layout phase.
+func (g *GridView[T]) ItemBounds(index int) image.Rectangle {
+	return g.content.itemBoundsForLayoutFromIndex[index]
+}
+
+func (g *GridView[T]) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
+	inner := g.inner.Widget()
+
+	adder.AddWidget(&g.inner)
+
+	g.content.panel = &inner.panel
+	inner.panel.setContent(&g.content)
+
+	return nil
+}
+
+func (g *GridView[T]) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
+	layouter.LayoutWidget(&g.inner, widgetBounds.Bounds())
+	g.inner.SetRenderingBounds(widgetBounds.Bounds())
+}
+
+func (g *GridView[T]) Measure(context *guigui.Context, constraints guigui.Constraints) image.Point {
+	return g.content.Measure(context, constraints)
+}
+
+func (g *GridView[T]) Tick(context *guigui.Context, widgetBounds *guigui.WidgetBounds) error {
+	// Query the items again, as the items might be loaded asynchronously.
+	if g.dataSourceItems.dataSource != nil {
+		g.dataSourceItems.refresh()
+	}
+	return nil
+}
+
+type gridViewAbstractItem[T comparable] struct {
+	Content      *gridViewItemWidget[T]
+	Unselectable bool
+	Movable      bool
+	Value        T
+}
+
+func (g gridViewAbstractItem[T]) value() T {
+	return g.Value
+}
+
+func (g gridViewAbstractItem[T]) selectable() bool {
+	return !g.Unselectable
+}
+
+func (g gridViewAbstractItem[T]) visible() bool {
+	return true
+}
+
+// defaultGridViewItemWidth returns the default width of the tiles.
+func defaultGridViewItemWidth(context *guigui.Context) int {
+	return 4 * UnitSize(context)
+}
+
+// gridViewGap returns the gap between the tiles.
+func gridViewGap(context *guigui.Context) int {
+	return UnitSize(context) / 4
+}
+
+// gridViewItemPadding returns the padding between the tile bounds and the image or the caption.
+func gridViewItemPadding(context *guigui.Context) int {
+	return UnitSize(context) / 8
+}
+
+// gridViewColumnCount returns the number of the columns of the tiles in the given width.
+// pitch is the width of a tile plus the gap.
+// There is always at least one column.
+func gridViewColumnCount(width, pitch int) int {
+	if pitch <= 0 {
+		return 1
+	}
+	return max(1, width/pitch)
+}
+
+// gridViewNextIndex returns the index of the selectable item reached by moving from current by delta repeatedly,
+// or -1 if there is no such item.
+//
+// delta is ±1 for a horizontal move and ±columnCount for a vertical move.
+// Moving down to the last row, which might have fewer items than columnCount, reaches the last item.
+func gridViewNextIndex(current, delta, count, columnCount int, selectable func(index int) bool) int {
+	if delta == 0 {
+		return -1
+	}
+	for i := current + delta; i >= 0; i += delta {
+		if i >= count {
+			if delta > 1 && i/columnCount == (count-1)/columnCount && selectable(count-1) {
+				return count - 1
+			}
+			return -1
+		}
+		if selectable(i) {
+			return i
+		}
+	}
+	return -1
+}
+
+type gridViewContent[T comparable] struct {
+	guigui.DefaultWidget
+
+	abstractList abstractList[T, gridViewAbstractItem[T]]
+	itemWidth    int
+	itemHeight   int
+
+	// columnCount is the number of the columns at the most recent layout.
+	columnCount int
+
+	// cursorIndexPlus1 is the index of the item moved to by the keyboard or clicked, plus 1.
+	// The arrow keys move the selection from this item.
+	cursorIndexPlus1 int
+
+	indexToJumpPlus1          int
+	indexToEnsureVisiblePlus1 int
+	jumpTick                  int64
+	dragSrcIndexPlus1         int
+	dragDstIndexPlus1         int
+	pressStartPlus1           image.Point
+	startPressingIndexPlus1   int
+
+	// itemBoundsForLayoutFromIndex is the bounds of the laid-out tiles keyed by the item index.
+	itemBoundsForLayoutFromIndex map[int]image.Rectangle
+
+	// itemSource provides the items lazily instead of SetItems.
+	itemSource *gridViewDataSourceItems[T]
+
+	// measuredRowHeights caches the measured tile heights per row
+	// for the duration of a single Layout call.
+	measuredRowHeights map[int]int
+
+	widgetToIndex      map[guigui.Widget]int
+	tmpSelectedIndices []int
+
+	onItemSelected  func(index int)
+	onItemsSelected func(indices []int)
+
+	// panel is a back-reference to the virtual-scroll panel.
+	// An item of the panel is a row of tiles.
+	panel *virtualScrollPanel
+}
+
+func (g *gridViewContent[T]) WriteStateKey(w *guigui.StateKeyWriter) {
+	g.abstractList.writeStateKey(w)
+	w.WriteInt(g.itemWidth)
+	w.WriteInt(g.itemHeight)
+	w.WriteInt(g.columnCount)
+	w.WriteInt(g.abstractList.ItemCount())
+}
+
+func (g *gridViewContent[T]) SetItems(items []gridViewAbstractItem[T]) {
+	g.itemSource = nil
+	g.abstractList.SetItems(items)
+}
+
+// setItemSource sets the source to provide the items lazily instead of SetItems.
+func (g *gridViewContent[T]) setItemSource(source *gridViewDataSourceItems[T]) {
+	g.itemSource = source
+	g.abstractList.SetItemSource(source)
+}
+
+func (g *gridViewContent[T]) JumpToItemByIndex(index int) {
+	g.indexToJumpPlus1 = index + 1
+	g.jumpTick = ebiten.Tick() + 1
+}
+
+func (g *gridViewContent[T]) EnsureItemVisibleByIndex(index int) {
+	g.indexToEnsureVisiblePlus1 = index + 1
+	g.jumpTick = ebiten.Tick() + 1
+}
+
+func (g *gridViewContent[T]) tileWidth(context *guigui.Context) int {
+	if g.itemWidth > 0 {
+		return g.itemWidth
+	}
+	return defaultGridViewItemWidth(context)
+}
+
+func (g *gridViewContent[T]) columns() int {
+	return max(g.columnCount, 1)
+}
+
+// itemCount returns the number of the rows.
+// Implements [virtualScrollContent.itemCount].
+func (g *gridViewContent[T]) itemCount() int {
+	n := g.abstractList.ItemCount()
+	cols := g.columns()
+	return (n + cols - 1) / cols
+}
+
+// measureItemHeight returns the height of the row including the gap, or -1 if the row is out of range.
+// Implements [virtualScrollContent.measureItemHeight].
+func (g *gridViewContent[T]) measureItemHeight(context *guigui.Context, row int) int {
+	if row < 0 || row >= g.itemCount() {
+		return -1
+	}
+	if h, ok := g.measuredRowHeights[row]; ok {
+		return h
+	}
+	cols := g.columns()
+	h := g.measureTileHeight(context, row*cols, min((row+1)*cols, g.abstractList.ItemCount())) + gridViewGap(context)
+	if g.measuredRowHeights != nil {
+		g.measuredRowHeights[row] = h
+	}
+	return h
+}
+
+// measureTileHeight returns the height of the tiles in [start, end).
+func (g *gridViewContent[T]) measureTileHeight(context *guigui.Context, start, end int) int {
+	if g.itemHeight > 0 {
+		return g.itemHeight
+	}
+	constraints := guigui.FixedWidthConstraints(g.tileWidth(context))
+	var h int
+	for i := start; i < end; i++ {
+		item, _ := g.abstractList.ItemByIndex(i)
+		h = max(h, item.Content.Measure(context, constraints).Y)
+	}
+	return h
+}
+
+// contentWidth implements [virtualScrollContent.contentWidth].
+// The tiles wrap into rows, so the content is never scrolled horizontally.
+func (g *gridViewContent[T]) contentWidth(_ *guigui.Context) int {
+	return 0
+}
+
+// viewportPaddingY implements [virtualScrollContent.viewportPaddingY].
+func (g *gridViewContent[T]) viewportPaddingY(context *guigui.Context) int {
+	return 2 * RoundedCornerRadius(context)
+}
+
+func (g *gridViewContent[T]) Env(context *guigui.Context, key guigui.EnvKey, source *guigui.EnvSource) (any, bool) {
+	switch key {
+	case EnvKeyListItemColorType:
+		child := source.Child
+		if child == nil {
+			return nil, false
+		}
+		if i, ok := g.widgetToIndex[child]; ok {
+			return g.itemColorType(context, i), true
+		}
+	}
+	return nil, false
+}
+
+func (g *gridViewContent[T]) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
+	// Only add the rows around the top row, extending downward and upward until
+	// the accumulated height in each direction reaches the app bounds height,
+	// in the same way as a list.
+	rowCount := g.itemCount()
+	topRow, topOff := g.panel.topItem()
+	topRow = min(max(topRow, 0), rowCount)
+	appBoundsHeight := context.AppBounds().Dy()
+
+	hiRow := topRow
+	var downH int
+	for row := topRow; row < rowCount; row++ {
+		h := g.measureItemHeight(context, row)
+		if row == topRow && topOff < 0 {
+			h = max(0, h+topOff)
+		}
+		downH += h
+		hiRow = row + 1
+		if downH >= appBoundsHeight {
+			break
+		}
+	}
+
+	loRow := topRow
+	var upH int
+	for row := topRow - 1; row >= 0; row-- {
+		upH += g.measureItemHeight(context, row)
+		loRow = row
+		if upH >= appBoundsHeight {
+			break
+		}
+	}
+
+	cols := g.columns()
+	lo := loRow * cols
+	hi := min(hiRow*cols, g.abstractList.ItemCount())
+
+	// Release the cached items of the data source far from the viewport.
+	if g.itemSource != nil {
+		g.itemSource.retainItems(lo, hi, g.abstractList.anchorIndex)
+	}
+
+	// Build a widget-to-index map for O(1) lookup in Env.
+	if g.widgetToIndex == nil {
+		g.widgetToIndex = map[guigui.Widget]int{}
+	}
+	clear(g.widgetToIndex)
+	for i := lo; i < hi; i++ {
+		item, _ := g.abstractList.ItemByIndex(i)
+		adder.AddWidget(item.Content)
+		g.widgetToIndex[item.Content] = i
+	}
+
+	if g.onItemSelected == nil {
+		g.onItemSelected = func(index int) {
+			guigui.DispatchEvent(g, gridViewEventItemSelected, index)
+		}
+	}
+	g.abstractList.OnItemSelected(g.onItemSelected)
+
+	if g.onItemsSelected == nil {
+		g.onItemsSelected = func(indices []int) {
+			guigui.DispatchEvent(g, gridViewEventItemsSelected, indices)
+		}
+	}
+	g.abstractList.OnItemsSelected(g.onItemsSelected)
+
+	return nil
+}
+
+func (g *gridViewContent[T]) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
+	bounds := widgetBounds.Bounds()
+
+	if g.itemBoundsForLayoutFromIndex == nil {
+		g.itemBoundsForLayoutFromIndex = map[int]image.Rectangle{}
+	}
+	clear(g.itemBoundsForLayoutFromIndex)
+	if g.measuredRowHeights == nil {
+		g.measuredRowHeights = map[int]int{}
+	}
+	clear(g.measuredRowHeights)
+
+	rcr := RoundedCornerRadius(context)
+	pitch := g.tileWidth(context) + gridViewGap(context)
+	g.setColumnCount(gridViewColumnCount(bounds.Dx()-2*rcr, pitch))
+
+	rowCount := g.itemCount()
+	if rowCount == 0 {
+		return
+	}
+
+	// Center the tiles horizontally.
+	cols := g.columns()
+	baseX := bounds.Min.X + rcr + max(0, bounds.Dx()-2*rcr-cols*pitch)/2
+
+	// Resolve the top row: clamp + normalize + bottom-clamp in one pass.
+	measure := func(row int) int {
+		return g.measureItemHeight(context, row)
+	}
+	topRow, topOff := g.panel.layoutTopItem(context, bounds.Dy()-2*rcr, measure)
+
+	// Lay out rows downward from topRow.
+	y := bounds.Min.Y + rcr + topOff
+	for row := topRow; row < rowCount; row++ {
+		if y >= bounds.Max.Y {
+			break
+		}
+		g.layoutRow(context, layouter, row, baseX, y)
+		y += measure(row)
+	}
+
+	// Lay out rows upward from topRow-1 to fill any gap above.
+	y = bounds.Min.Y + rcr + topOff
+	for row := topRow - 1; row >= 0; row-- {
+		y -= measure(row)
+		g.layoutRow(context, layouter, row, baseX, y)
+		if y <= bounds.Min.Y {
+			break
+		}
+	}
+}
+
+// setColumnCount updates the number of the columns.
+// The top row is updated so that the top-left tile stays in the viewport.
+func (g *gridViewContent[T]) setColumnCount(count int) {
+	if g.columnCount == count {
+		return
+	}
+	if g.columnCount > 0 {
+		topRow, _ := g.panel.topItem()
+		g.panel.forceSetTopItem(topRow*g.columnCount/count, 0, true)
+	}
+	g.columnCount = count
+}
+
+// layoutRow lays out the tiles in the row whose top including the gap is at y.
+func (g *gridViewContent[T]) layoutRow(context *guigui.Context, layouter *guigui.ChildLayouter, row int, baseX, y int) {
+	gap := gridViewGap(context)
+	w := g.tileWidth(context)
+	h := g.measureItemHeight(context, row) - gap
+	cols := g.columns()
+	n := g.abstractList.ItemCount()
+	for col := range cols {
+		i := row*cols + col
+		if i >= n {
+			break
+		}
+		p := image.Pt(baseX+col*(w+gap)+gap/2, y+gap/2)
+		r := image.Rectangle{
+			Min: p,
+			Max: p.Add(image.Pt(w, h)),
+		}
+		g.itemBoundsForLayoutFromIndex[i] = r
+		item, _ := g.abstractList.ItemByIndex(i)
+		layouter.LayoutWidget(item.Content, r)
+	}
+}
+
+func (g *gridViewContent[T]) Measure(context *guigui.Context, constraints guigui.Constraints) image.Point {
+	rcr := RoundedCornerRadius(context)
+	pitch := g.tileWidth(context) + gridViewGap(context)
+
+	// By default, a grid view is as wide as four tiles.
+	w := 4*pitch + 2*rcr
+	if fixedWidth, ok := constraints.FixedWidth(); ok {
+		w = fixedWidth
+	}
+
+	n := g.abstractList.ItemCount()
+	cols := gridViewColumnCount(w-2*rcr, pitch)
+	rows := (n + cols - 1) / cols
+	var h int
+	if rows > 0 {
+		// Measuring all the rows is too expensive. Estimate the height from the first row.
+		h = rows * (g.measureTileHeight(context, 0, min(cols, n)) + gridViewGap(context))
+	}
+	return image.Pt(w, h+2*rcr)
+}
+
+// itemIndexAt returns the index of the laid-out tile at the point, or -1 if there is no such tile.
+func (g *gridViewContent[T]) itemIndexAt(point image.Point) int {
+	for i, r := range g.itemBoundsForLayoutFromIndex {
+		if point.In(r) {
+			return i
+		}
+	}
+	return -1
+}
+
+// calcDropDstIndex returns the index where the dragged items are inserted.
+// The index is after all the laid-out tiles before the cursor in the reading order.
+func (g *gridViewContent[T]) calcDropDstIndex(context *guigui.Context) int {
+	if len(g.itemBoundsForLayoutFromIndex) == 0 {
+		return g.abstractList.ItemCount()
+	}
+	c := image.Pt(guigui.CursorPosition())
+	gap := gridViewGap(context)
+	first := -1
+	dst := -1
+	for i, r := range g.itemBoundsForLayoutFromIndex {
+		if first < 0 || i < first {
+			first = i
+		}
+		// The tile is in a row above the cursor, or before the cursor in the same row.
+		if c.Y >= r.Max.Y+gap/2 || c.Y >= r.Min.Y-gap/2 && c.X >= (r.Min.X+r.Max.X)/2 {
+			dst = max(dst, i+1)
+		}
+	}
+	if dst < 0 {
+		return first
+	}
+	return dst
+}
+
+func (g *gridViewContent[T]) HandleButtonInput(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
+	n := g.abstractList.ItemCount()
+	cols := g.columns()
+
+	var delta int
+	var home, end bool
+	switch {
+	case isKeyRepeating(ebiten.KeyLeft):
+		delta = -1
+	case isKeyRepeating(ebiten.KeyRight):
+		delta = 1
+	case isKeyRepeating(ebiten.KeyUp):
+		delta = -cols
+	case isKeyRepeating(ebiten.KeyDown):
+		delta = cols
+	case guigui.IsKeyJustPressed(ebiten.KeyHome):
+		home = true
+	case guigui.IsKeyJustPressed(ebiten.KeyEnd):
+		end = true
+	default:
+		return guigui.HandleInputResult{}
+	}
+
+	current := g.cursorIndexPlus1 - 1
+	if current < 0 || current >= n || !g.abstractList.IsSelectedItemIndex(current) {
+		current = g.abstractList.SelectedItemIndex()
+	}
+
+	selectable := func(index int) bool {
+		item, _ := g.abstractList.ItemByIndex(index)
+		return item.selectable()
+	}
+	var next int
+	switch {
+	case home:
+		next = gridViewNextIndex(-1, 1, n, cols, selectable)
+	case end:
+		next = gridViewNextIndex(n, -1, n, cols, selectable)
+	case current < 0 && delta > 0:
+		next = gridViewNextIndex(-1, 1, n, cols, selectable)
+	case current < 0:
+		next = gridViewNextIndex(n, -1, n, cols, selectable)
+	default:
+		next = gridViewNextIndex(current, delta, n, cols, selectable)
+	}
+	if next < 0 || next == current {
+		return guigui.HandleInputByWidget(g)
+	}
+
+	if guigui.IsKeyPressed(ebiten.KeyShift) && g.abstractList.MultiSelection() && g.abstractList.SelectedItemCount() > 0 {
+		g.abstractList.ExtendItemSelectionByIndex(next, false)
+	} else {
+		g.abstractList.SelectItemByIndex(next, false)
+	}
+	g.cursorIndexPlus1 = next + 1
+	g.EnsureItemVisibleByIndex(next)
+	if item, ok := g.abstractList.ItemByIndex(next); ok {
+		context.SetFocused(item.Content, true)
+	}
+	return guigui.HandleInputByWidget(g)
+}
+
+func (g *gridViewContent[T]) HandlePointingInput(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
+	// Reset dragging and pressing state when the grid view loses focus.
+	if !context.IsFocusedOrHasFocusedChild(g) {
+		g.dragSrcIndexPlus1 = 0
+		g.dragDstIndexPlus1 = 0
+		g.pressStartPlus1 = image.Point{}
+		g.startPressingIndexPlus1 = 0
+	}
+
+	// Process dragging.
+	if g.dragSrcIndexPlus1 > 0 {
+		if guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
+			g.panel.scrollByCursorNearEdges(context, widgetBounds.VisibleBounds())
+			if i := g.calcDropDstIndex(context); g.dragDstIndexPlus1-1 != i {
+				droppable := true
+				g.tmpSelectedIndices = g.abstractList.AppendSelectedItemIndices(g.tmpSelectedIndices[:0])
+				if len(g.tmpSelectedIndices) > 0 {
+					if result, handled := guigui.DispatchEvent(g, gridViewEventItemsCanMove, g.tmpSelectedIndices[0], len(g.tmpSelectedIndices), i); handled {
+						droppable = result[0].(bool)
+					}
+				}
+				if droppable {
+					g.dragDstIndexPlus1 = i + 1
+				} else {
+					g.dragDstIndexPlus1 = 0
+				}
+				guigui.RequestRedraw(g)
+				return guigui.HandleInputByWidget(g)
+			}
+			return guigui.AbortHandlingInputByWidget(g)
+		}
+		if g.dragDstIndexPlus1 > 0 {
+			g.tmpSelectedIndices = g.abstractList.AppendSelectedItemIndices(g.tmpSelectedIndices[:0])
+			if len(g.tmpSelectedIndices) > 0 {
+				from, count, to := g.tmpSelectedIndices[0], len(g.tmpSelectedIndices), g.dragDstIndexPlus1-1
+				canMove := true
+				if result, handled := guigui.DispatchEvent(g, gridViewEventItemsCanMove, from, count, to); handled {
+					canMove = result[0].(bool)
+				}
+				if canMove {
+					guigui.DispatchEvent(g, gridViewEventItemsMoved, from, count, to)
+				}
+			}
+			g.dragDstIndexPlus1 = 0
+		}
+		g.dragSrcIndexPlus1 = 0
+		g.pressStartPlus1 = image.Point{}
+		g.startPressingIndexPlus1 = 0
+		guigui.RequestRedraw(g)
+		return guigui.HandleInputByWidget(g)
+	}
+
+	c := image.Pt(guigui.CursorPosition())
+	shift := guigui.IsKeyPressed(ebiten.KeyShift)
+	ctrl := !isDarwin() && guigui.IsKeyPressed(ebiten.KeyControl) ||
+		isDarwin() && guigui.IsKeyPressed(ebiten.KeyMeta)
+
+	left := guigui.IsMouseButtonJustPressed(ebiten.MouseButtonLeft)
+	right := guigui.IsMouseButtonJustPressed(ebiten.MouseButtonRight)
+	switch {
+	case left || right:
+		if !widgetBounds.IsHitAtCursor() {
+			return guigui.HandleInputResult{}
+		}
+		index := g.itemIndexAt(c)
+		item, ok := g.abstractList.ItemByIndex(index)
+		if !ok {
+			// Pressing the empty area focuses the grid view for the keyboard.
+			if left {
+				context.SetFocused(g, true)
+				return guigui.HandleInputByWidget(g)
+			}
+			return guigui.HandleInputResult{}
+		}
+		if item.Unselectable {
+			g.pressStartPlus1 = image.Point{}
+			g.startPressingIndexPlus1 = 0
+			return guigui.AbortHandlingInputByWidget(g)
+		}
+
+		context.SetFocused(item.Content, true)
+
+		if g.abstractList.MultiSelection() {
+			if shift {
+				g.abstractList.ExtendItemSelectionByIndex(index, false)
+			} else if ctrl {
+				g.abstractList.ToggleItemSelectionByIndex(index, false)
+			} else if !g.abstractList.IsSelectedItemIndex(index) {
+				g.abstractList.SelectItemByIndex(index, false)
+			}
+			// If the index is already selected, don't change the selection by clicking,
+			// or the user couldn't drag multiple items.
+			// This is updated when the user releases the mouse button.
+		} else {
+			g.abstractList.SelectItemByIndex(index, false)
+		}
+		g.cursorIndexPlus1 = index + 1
+
+		if click, ok := widgetBounds.ClickAtCursor(); ok && click.Button == ebiten.MouseButtonLeft && click.Count == 2 {
+			guigui.DispatchEvent(g, gridViewEventItemActivated, index)
+		}
+
+		if left {
+			g.pressStartPlus1 = c.Add(image.Pt(1, 1))
+			g.startPressingIndexPlus1 = index + 1
+			return guigui.HandleInputByWidget(g)
+		}
+		// For the right click, give a chance to a parent widget to handle the right click e.g. to open a context menu.
+		return guigui.HandleInputResult{}
+
+	case guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft) && g.startPressingIndexPlus1 > 0:
+		if shift || ctrl {
+			return guigui.AbortHandlingInputByWidget(g)
+		}
+		start := g.pressStartPlus1.Sub(image.Pt(1, 1))
+		d := c.Sub(start)
+		if threshold := UnitSize(context) / 4; max(d.X, -d.X) < threshold && max(d.Y, -d.Y) < threshold {
+			return guigui.AbortHandlingInputByWidget(g)
+		}
+		index := g.startPressingIndexPlus1 - 1
+		if !g.abstractList.IsSelectedItemIndex(index) {
+			return guigui.AbortHandlingInputByWidget(g)
+		}
+		// Only a contiguous range of items can be moved.
+		g.abstractList.SelectGroupAt(index, false)
+		g.tmpSelectedIndices = g.abstractList.AppendSelectedItemIndices(g.tmpSelectedIndices[:0])
+		if len(g.tmpSelectedIndices) == 0 {
+			return guigui.AbortHandlingInputByWidget(g)
+		}
+		for _, index := range g.tmpSelectedIndices {
+			item, _ := g.abstractList.ItemByIndex(index)
+			if !item.Movable {
+				return guigui.AbortHandlingInputByWidget(g)
+			}
+		}
+		g.dragSrcIndexPlus1 = g.tmpSelectedIndices[0] + 1
+		return guigui.HandleInputByWidget(g)
+
+	case guigui.IsMouseButtonJustReleased(ebiten.MouseButtonLeft) && g.startPressingIndexPlus1 > 0:
+		// For the multi selection, the index is updated when the user releases the mouse button.
+		index := g.startPressingIndexPlus1 - 1
+		g.pressStartPlus1 = image.Point{}
+		g.startPressingIndexPlus1 = 0
+		if g.abstractList.MultiSelection() && !shift && !ctrl {
+			g.abstractList.SelectItemByIndex(index, false)
+			return guigui.HandleInputByWidget(g)
+		}
+		return guigui.AbortHandlingInputByWidget(g)
+	}
+
+	return guigui.HandleInputResult{}
+}
+
+func (g *gridViewContent[T]) Tick(context *guigui.Context, widgetBounds *guigui.WidgetBounds) error {
+	// Jump to the item if requested.
+	// This is done in Tick to wait for the items are updated, or an item cannot be measured correctly.
+	if g.jumpTick > 0 && ebiten.Tick() >= g.jumpTick {
+		if idx := g.indexToJumpPlus1 - 1; idx >= 0 && idx < g.abstractList.ItemCount() {
+			g.panel.setTopItem(idx/g.columns(), 0)
+			g.indexToJumpPlus1 = 0
+		}
+		if idx := g.indexToEnsureVisiblePlus1 - 1; idx >= 0 && idx < g.abstractList.ItemCount() {
+			g.scrollToEnsureItemVisible(context, widgetBounds, idx)
+			g.indexToEnsureVisiblePlus1 = 0
+		}
+		g.jumpTick = 0
+	}
+	return nil
+}
+
+// scrollToEnsureItemVisible adjusts the panel's top row to make the row of the given item visible.
+func (g *gridViewContent[T]) scrollToEnsureItemVisible(context *guigui.Context, widgetBounds *guigui.WidgetBounds, index int) {
+	row := index / g.columns()
+	topRow, topOff := g.panel.topItem()
+	if row < topRow || row == topRow && topOff < 0 {
+		g.panel.setTopItem(row, 0)
+		return
+	}
+
+	viewportHeight := widgetBounds.Bounds().Dy() - 2*RoundedCornerRadius(context)
+	y := topOff
+	for r := topRow; r < row && y <= viewportHeight; r++ {
+		y += g.measureItemHeight(context, r)
+	}
+	if h := g.measureItemHeight(context, row); y+h > viewportHeight {
+		// Align the bottom of the row with the bottom of the viewport.
+		// layoutTopItem will fix the indices during the next layout.
+		g.panel.setTopItem(row, viewportHeight-h)
+	}
+}
+
+func (g *gridViewContent[T]) itemColorType(context *guigui.Context, index int) ListItemColorType {
+	if !context.IsEnabled(g) {
+		return ListItemColorTypeListDisabled
+	}
+	if !g.abstractList.IsSelectedItemIndex(index) {
+		return ListItemColorTypeDefault
+	}
+	if context.IsFocusedOrHasFocusedChild(g) {
+		return ListItemColorTypeHighlighted
+	}
+	return ListItemColorTypeSelectedInUnfocusedList
+}
+
+func (g *gridViewContent[T]) Draw(context *guigui.Context, widgetBounds *guigui.WidgetBounds, dst *ebiten.Image) {
+	vb := widgetBounds.VisibleBounds()
+
+	// Draw the selected tile backgrounds.
+	g.tmpSelectedIndices = g.abstractList.AppendSelectedItemIndices(g.tmpSelectedIndices[:0])
+	for _, index := range g.tmpSelectedIndices {
+		bounds, ok := g.itemBoundsForLayoutFromIndex[index]
+		if !ok || !bounds.Overlaps(vb) {
+			continue
+		}
+		clr := g.itemColorType(context, index).BackgroundColor(context)
+		if clr == nil {
+			continue
+		}
+		basicwidgetdraw.DrawRoundedRect(context, dst, bounds, clr, RoundedCornerRadius(context))
+	}
+
+	// Draw a dragging guideline between the tiles.
+	if dstIdx := g.dragDstIndexPlus1 - 1; dstIdx >= 0 {
+		gap := gridViewGap(context)
+		var x float32
+		var bounds image.Rectangle
+		var ok bool
+		if bounds, ok = g.itemBoundsForLayoutFromIndex[dstIdx]; ok {
+			x = float32(bounds.Min.X) - float32(gap)/2
+		} else if bounds, ok = g.itemBoundsForLayoutFromIndex[dstIdx-1]; ok {
+			// This is needed especially when dragging to the end of a row or the grid view.
+			x = float32(bounds.Max.X) + float32(gap)/2
+		}
+		if ok {
+			vector.StrokeLine(dst, x, float32(bounds.Min.Y), x, float32(bounds.Max.Y), 2*float32(context.Scale()), draw.Color(context.ColorMode(), draw.SemanticColorAccent, 0.5), false)
+		}
+	}
+}
+
+type gridViewBackground struct {
+	guigui.DefaultWidget
+}
+
+func (g *gridViewBackground) Draw(context *guigui.Context, widgetBounds *guigui.WidgetBounds, dst *ebiten.Image) {
+	clr := basicwidgetdraw.ControlColor(context.ColorMode(), context.IsEnabled(g))
+	basicwidgetdraw.DrawRoundedRect(context, dst, widgetBounds.Bounds(), clr, RoundedCornerRadius(context))
+}
+
+type gridViewItemWidget[T comparable] struct {
+	guigui.DefaultWidget
+
+	image Image
+	text  Text
+
+	item        GridViewItem[T]
+	placeholder bool
+}
+
+func (g *gridViewItemWidget[T]) WriteStateKey(w *guigui.StateKeyWriter) {
+	g.item.writeStateKey(w)
+	w.WriteBool(g.placeholder)
+}
+
+func (g *gridViewItemWidget[T]) setItem(item GridViewItem[T]) {
+	g.item = item
+}
+
+// setPlaceholder sets whether the tile is a placeholder for an item that is not available yet.
+func (g *gridViewItemWidget[T]) setPlaceholder(placeholder bool) {
+	g.placeholder = placeholder
+}
+
+func (g *gridViewItemWidget[T]) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
+	if g.placeholder {
+		return nil
+	}
+	if g.item.Content != nil {
+		adder.AddWidget(g.item.Content)
+	} else if g.item.Image != nil {
+		g.image.SetImage(g.item.Image)
+		adder.AddWidget(&g.image)
+	}
+	if g.item.Text != "" {
+		g.text.SetValue(g.item.Text)
+		g.text.SetHorizontalAlign(HorizontalAlignCenter)
+		g.text.SetVerticalAlign(VerticalAlignMiddle)
+		g.text.SetEllipsisString("…")
+		adder.AddWidget(&g.text)
+	}
+	return nil
+}
+
+// contentBounds returns the bounds of the image or the content widget, and the bounds of the caption.
+func (g *gridViewItemWidget[T]) contentBounds(context *guigui.Context, bounds image.Rectangle) (image.Rectangle, image.Rectangle) {
+	bounds = bounds.Inset(gridViewItemPadding(context))
+	if g.item.Text == "" {
+		return bounds, image.Rectangle{}
+	}
+	textBounds := bounds
+	textBounds.Min.Y = max(bounds.Min.Y, bounds.Max.Y-LineHeight(context))
+	bounds.Max.Y = textBounds.Min.Y
+	return bounds, textBounds
+}
+
+func (g *gridViewItemWidget[T]) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
+	if g.placeholder {
+		return
+	}
+	contentBounds, textBounds := g.contentBounds(context, widgetBounds.Bounds())
+	if g.item.Content != nil {
+		layouter.LayoutWidget(g.item.Content, contentBounds)
+	} else if g.item.Image != nil {
+		layouter.LayoutWidget(&g.image, contentBounds)
+	}
+	if g.item.Text != "" {
+		layouter.LayoutWidget(&g.text, textBounds)
+
+		// Set the text color based on the item's color type.
+		clr := ListItemColorTypeDefault.TextColor(context)
+		if v, ok := context.Env(g, EnvKeyListItemColorType); ok {
+			if ct, ok := v.(ListItemColorType); ok {
+				clr = ct.TextColor(context)
+			}
+		}
+		g.text.SetColor(clr)
+	}
+}
+
+func (g *gridViewItemWidget[T]) Measure(context *guigui.Context, constraints guigui.Constraints) image.Point {
+	w := defaultGridViewItemWidth(context)
+	if fixedWidth, ok := constraints.FixedWidth(); ok {
+		w = fixedWidth
+	}
+	p := gridViewItemPadding(context)
+	innerW := max(0, w-2*p)
+
+	// An image and a placeholder fit a square area.
+	h := innerW
+	if g.item.Content != nil && !g.placeholder {
+		h = g.item.Content.Measure(context, guigui.FixedWidthConstraints(innerW)).Y
+	}
+	if g.item.Text != "" {
+		h += LineHeight(context)
+	}
+	return image.Pt(w, h+2*p)
+}
+
+func (g *gridViewItemWidget[T]) Draw(context *guigui.Context, widgetBounds *guigui.WidgetBounds, dst *ebiten.Image) {
+	if !g.placeholder {
+		return
+	}
+	// Draw a box instead of the image.
+	b := widgetBounds.Bounds().Inset(gridViewItemPadding(context))
+	basicwidgetdraw.DrawRoundedRect(context, dst, b, draw.Color(context.ColorMode(), draw.SemanticColorBase, 0.8), RoundedCornerRadius(context))
+}
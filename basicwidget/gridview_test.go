@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package basicwidget_test
+
+import (
+	"testing"
+
+	"github.com/guigui-gui/guigui/basicwidget"
+)
+
+func TestGridViewColumnCount(t *testing.T) {
+	testCases := []struct {
+		width int
+		pitch int
+		out   int
+	}{
+		{width: 400, pitch: 100, out: 4},
+		{width: 399, pitch: 100, out: 3},
+		{width: 50, pitch: 100, out: 1},
+		{width: 0, pitch: 100, out: 1},
+		{width: 400, pitch: 0, out: 1},
+	}
+	for _, tc := range testCases {
+		if got := basicwidget.GridViewColumnCount(tc.width, tc.pitch); got != tc.out {
+			t.Errorf("GridViewColumnCount(%d, %d): got: %d, want: %d", tc.width, tc.pitch, got, tc.out)
+		}
+	}
+}
+
+func TestGridViewNextIndex(t *testing.T) {
+	all := func(index int) bool {
+		return true
+	}
+	even := func(index int) bool {
+		return index%2 == 0
+	}
+	not5 := func(index int) bool {
+		return index != 5
+	}
+	// 10 items in 4 columns:
+	//
+	//	0 1 2 3
+	//	4 5 6 7
+	//	8 9
+	testCases := []struct {
+		name       string
+		current    int
+		delta      int
+		selectable func(index int) bool
+		out        int
+	}{
+		{name: "right", current: 0, delta: 1, selectable: all, out: 1},
+		{name: "right at the row end", current: 3, delta: 1, selectable: all, out: 4},
+		{name: "left at the first item", current: 0, delta: -1, selectable: all, out: -1},
+		{name: "down", current: 1, delta: 4, selectable: all, out: 5},
+		{name: "down to the last row", current: 5, delta: 4, selectable: all, out: 9},
+		{name: "down to the shorter last row", current: 6, delta: 4, selectable: all, out: 9},
+		{name: "down at the last row", current: 9, delta: 4, selectable: all, out: -1},
+		{name: "up", current: 5, delta: -4, selectable: all, out: 1},
+		{name: "up at the first row", current: 2, delta: -4, selectable: all, out: -1},
+		{name: "right over an unselectable item", current: 0, delta: 1, selectable: even, out: 2},
+		{name: "down over an unselectable item", current: 1, delta: 4, selectable: not5, out: 9},
+		{name: "first", current: -1, delta: 1, selectable: all, out: 0},
+		{name: "last", current: 10, delta: -1, selectable: all, out: 9},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := basicwidget.GridViewNextIndex(tc.current, tc.delta, 10, 4, tc.selectable); got != tc.out {
+				t.Errorf("got: %d, want: %d", got, tc.out)
+			}
+		})
+	}
+}
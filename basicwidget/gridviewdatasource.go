@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package basicwidget
+
+// GridViewDataSource provides the items of a [GridView] lazily.
+//
+// A grid view with a data source queries only the items around the viewport,
+// so a data source can have a huge number of items, e.g. thumbnails, without loading them up front.
+//
+// Movable of the items is ignored.
+type GridViewDataSource[T comparable] interface {
+	// ItemCount returns the number of the items.
+	ItemCount() int
+
+	// ItemAt returns the item at the given index.
+	//
+	// ItemAt returns false if the item is not available yet, e.g. while the thumbnail is being loaded asynchronously.
+	// Then a placeholder tile is shown instead.
+	// ItemAt is called for the items around the viewport every tick,
+	// so the placeholder tile is replaced with the item once ItemAt returns true.
+	ItemAt(index int) (GridViewItem[T], bool)
+}
+
+type gridViewDataSourceEntry[T comparable] struct {
+	widget *gridViewItemWidget[T]
+	item   gridViewAbstractItem[T]
+	loaded bool
+}
+
+// gridViewDataSourceItems adapts a [GridViewDataSource] to the items of a grid view.
+// The item widgets are created only for the items queried by the grid view, and are reused.
+type gridViewDataSourceItems[T comparable] struct {
+	dataSource  GridViewDataSource[T]
+	entries     map[int]*gridViewDataSourceEntry[T]
+	freeWidgets []*gridViewItemWidget[T]
+}
+
+func (g *gridViewDataSourceItems[T]) setDataSource(dataSource GridViewDataSource[T]) {
+	// The cached items are updated with a new data source at refresh.
+	if dataSource == nil {
+		g.releaseAll()
+	}
+	g.dataSource = dataSource
+}
+
+func (g *gridViewDataSourceItems[T]) releaseAll() {
+	for i := range g.entries {
+		g.release(i)
+	}
+}
+
+func (g *gridViewDataSourceItems[T]) release(index int) {
+	e, ok := g.entries[index]
+	if !ok {
+		return
+	}
+	delete(g.entries, index)
+	g.freeWidgets = append(g.freeWidgets, e.widget)
+}
+
+func (g *gridViewDataSourceItems[T]) itemCount() int {
+	if g.dataSource == nil {
+		return 0
+	}
+	return g.dataSource.ItemCount()
+}
+
+func (g *gridViewDataSourceItems[T]) itemAt(index int) gridViewAbstractItem[T] {
+	if e, ok := g.entries[index]; ok {
+		return e.item
+	}
+
+	e := &gridViewDataSourceEntry[T]{}
+	if n := len(g.freeWidgets); n > 0 {
+		e.widget = g.freeWidgets[n-1]
+		g.freeWidgets[n-1] = nil
+		g.freeWidgets = g.freeWidgets[:n-1]
+	} else {
+		e.widget = &gridViewItemWidget[T]{}
+	}
+	if g.entries == nil {
+		g.entries = map[int]*gridViewDataSourceEntry[T]{}
+	}
+	g.entries[index] = e
+	g.updateEntry(index, e)
+	return e.item
+}
+
+func (g *gridViewDataSourceItems[T]) updateEntry(index int, entry *gridViewDataSourceEntry[T]) {
+	item, ok := g.dataSource.ItemAt(index)
+	if !ok {
+		item = GridViewItem[T]{}
+	}
+	// Moving items is not supported.
+	item.Movable = false
+
+	entry.loaded = ok
+	w := entry.widget
+	w.setItem(item)
+	w.setPlaceholder(!ok)
+	entry.item = gridViewAbstractItem[T]{
+		Content:      w,
+		Unselectable: item.Unselectable,
+		Value:        item.Value,
+	}
+}
+
+// refresh queries the cached items again, so that the loaded items replace the placeholders.
+func (g *gridViewDataSourceItems[T]) refresh() {
+	n := g.itemCount()
+	for i, e := range g.entries {
+		if i >= n {
+			g.release(i)
+			continue
+		}
+		g.updateEntry(i, e)
+	}
+}
+
+// retainItems releases the cached items out of [lo, hi) if there are too many cached items.
+// The item at keep is not released, as its widget might have the focus.
+func (g *gridViewDataSourceItems[T]) retainItems(lo, hi int, keep int) {
+	if len(g.entries) <= max(16*(hi-lo), minListDataSourceEntryCount) {
+		return
+	}
+	for i := range g.entries {
+		if i >= lo && i < hi || i == keep {
+			continue
+		}
+		g.release(i)
+	}
+}
+
+func (g *gridViewDataSourceItems[T]) indexByValue(value T) int {
+	if g.dataSource == nil {
+		return -1
+	}
+	if p, ok := g.dataSource.(ValueIndexProvider[T]); ok {
+		return p.IndexByValue(value)
+	}
+	// Search only the cached items. Querying all the items might load every thumbnail of the data source.
+	idx := -1
+	for i, e := range g.entries {
+		if !e.loaded || e.item.Value != value {
+			continue
+		}
+		if idx < 0 || i < idx {
+			idx = i
+		}
+	}
+	return idx
+}
@@ -5,25 +5,103 @@ package basicwidget
 
 import (
 	"image"
+	"image/png"
+	"io/fs"
+	"log/slog"
 	"math"
+	"path"
+	"strings"
 	"unsafe"
 
 	"github.com/hajimehoshi/ebiten/v2"
 
 	"github.com/guigui-gui/guigui"
+	"github.com/guigui-gui/guigui/basicwidget/basicwidgetdraw"
+	"github.com/guigui-gui/guigui/basicwidget/internal/draw"
+)
+
+var (
+	imageEventImageDropped guigui.EventKey = guigui.GenerateEventKey()
 )
 
 type Image struct {
 	guigui.DefaultWidget
 
-	image *ebiten.Image
+	image           *ebiten.Image
+	fileDropEnabled bool
+
+	onFilesDropped func(context *guigui.Context, files fs.FS, position image.Point)
+}
+
+// OnImageDropped sets the event handler that is called when a PNG file is dropped onto the image and loaded.
+// The loaded image is already set to the image when the handler is called.
+func (i *Image) OnImageDropped(f func(context *guigui.Context, image *ebiten.Image)) {
+	guigui.SetEventHandler(i, imageEventImageDropped, f)
+}
+
+// SetFileDropEnabled sets whether the image accepts PNG files dropped from outside the application.
+// When a PNG file is dropped, the image is replaced with the loaded one.
+// The default is false.
+func (i *Image) SetFileDropEnabled(enabled bool) {
+	i.fileDropEnabled = enabled
+}
+
+func (i *Image) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
+	if i.fileDropEnabled {
+		if i.onFilesDropped == nil {
+			i.onFilesDropped = func(context *guigui.Context, files fs.FS, position image.Point) {
+				img, ok := loadDroppedPNG(files)
+				if !ok {
+					return
+				}
+				i.SetImage(img)
+				guigui.DispatchEvent(i, imageEventImageDropped, img)
+			}
+		}
+		guigui.OnFilesDropped(i, i.onFilesDropped)
+	}
+	return nil
+}
+
+// loadDroppedPNG loads the first PNG file at the root of files.
+func loadDroppedPNG(files fs.FS) (*ebiten.Image, bool) {
+	entries, err := fs.ReadDir(files, ".")
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, false
+	}
+	for _, e := range entries {
+		if e.IsDir() || !strings.EqualFold(path.Ext(e.Name()), ".png") {
+			continue
+		}
+		f, err := files.Open(e.Name())
+		if err != nil {
+			slog.Error(err.Error())
+			continue
+		}
+		img, err := png.Decode(f)
+		_ = f.Close()
+		if err != nil {
+			slog.Error(err.Error())
+			continue
+		}
+		return ebiten.NewImageFromImage(img), true
+	}
+	return nil, false
 }
 
 func (i *Image) Draw(context *guigui.Context, widgetBounds *guigui.WidgetBounds, dst *ebiten.Image) {
-	if i.image == nil {
-		return
+	if i.image != nil {
+		i.drawImage(context, widgetBounds, dst)
 	}
+	if context.IsFileDropHovered(i) {
+		w := textInputFocusBorderWidth(context)
+		clr := draw.Color(context.ColorMode(), draw.SemanticColorAccent, 0.8)
+		basicwidgetdraw.DrawRoundedRectBorder(context, dst, widgetBounds.Bounds(), clr, clr, RoundedCornerRadius(context), float32(w), basicwidgetdraw.RoundedRectBorderTypeRegular)
+	}
+}
 
+func (i *Image) drawImage(context *guigui.Context, widgetBounds *guigui.WidgetBounds, dst *ebiten.Image) {
 	b := widgetBounds.Bounds()
 	imgScale := min(float64(b.Dx())/float64(i.image.Bounds().Dx()), float64(b.Dy())/float64(i.image.Bounds().Dy()))
 	op := &ebiten.DrawImageOptions{}
@@ -61,6 +139,7 @@ func (i *Image) HasImage() bool {
 
 func (i *Image) WriteStateKey(w *guigui.StateKeyWriter) {
 	w.WriteUint64(uint64(uintptr(unsafe.Pointer(i.image))))
+	w.WriteBool(i.fileDropEnabled)
 }
 
 func (i *Image) SetImage(image *ebiten.Image) {
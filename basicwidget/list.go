@@ -4,19 +4,24 @@
 package basicwidget
 
 import (
+	"bytes"
+	"encoding/csv"
 	"fmt"
 	"image"
 	"image/color"
+	"log/slog"
 	"maps"
+	"math"
 	"slices"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
 	"github.com/hajimehoshi/ebiten/v2/vector"
+	"golang.org/x/text/collate"
 
 	"github.com/guigui-gui/guigui"
 	"github.com/guigui-gui/guigui/basicwidget/basicwidgetdraw"
 	"github.com/guigui-gui/guigui/basicwidget/internal/draw"
+	"github.com/guigui-gui/guigui/internal/clipboard"
 )
 
 // EnvKeyListItemColorType is the environment key for obtaining a [ListItemColorType] from a list item.
@@ -80,12 +85,21 @@ var (
 	listEventItemsMoved          guigui.EventKey = guigui.GenerateEventKey()
 	listEventItemsCanMove        guigui.EventKey = guigui.GenerateEventKey()
 	listEventItemExpanderToggled guigui.EventKey = guigui.GenerateEventKey()
+	listEventItemCheckToggled    guigui.EventKey = guigui.GenerateEventKey()
+	listEventItemClicked         guigui.EventKey = guigui.GenerateEventKey()
+	listEventItemActivated       guigui.EventKey = guigui.GenerateEventKey()
+	listEventEndReached          guigui.EventKey = guigui.GenerateEventKey()
 )
 
 type ListItem[T comparable] struct {
-	Text         string
-	TextStyle    TextStyle
-	Header       bool
+	Text      string
+	TextStyle TextStyle
+
+	// Header reports whether the item is a section header.
+	// A header item sticks to the top of the viewport while its section is scrolled.
+	// Header items don't stick with a data source.
+	Header bool
+
 	Content      guigui.Widget
 	KeyText      string
 	Unselectable bool
@@ -128,10 +142,14 @@ type List[T comparable] struct {
 
 	abstractListItems []abstractListItem[T]
 	listItemWidgets   guigui.WidgetSlice[*listItemWidget[T]]
+	dataSourceItems   listDataSourceItems[T]
 	content           listContent[T]
 	inner             roundedCornerWidget[*listInner[T]]
 
 	listItemHeightPlus1 int
+
+	// filter hides the items whose texts don't match the query.
+	filter textFilter
 }
 
 type listInner[T comparable] struct {
@@ -185,6 +203,7 @@ func (l *List[T]) WriteStateKey(w *guigui.StateKeyWriter) {
 	w.WriteInt64(int64(l.listItemHeightPlus1))
 	w.WriteInt64(int64(inner.headerHeight))
 	w.WriteInt64(int64(inner.footerHeight))
+	w.WriteInt(l.ItemCount())
 }
 
 func (l *List[T]) SetItemHeight(height int) {
@@ -211,6 +230,23 @@ func (l *List[T]) OnItemExpanderToggled(f func(context *guigui.Context, index in
 	l.content.OnItemExpanderToggled(f)
 }
 
+// OnItemClicked sets the event handler that is called when an item is clicked.
+// The handler is called when the mouse button is pressed, after the selection is updated.
+func (l *List[T]) OnItemClicked(f func(context *guigui.Context, index int, click guigui.Click)) {
+	l.content.OnItemClicked(f)
+}
+
+// OnItemActivated sets the event handler that is called when an item is double-clicked.
+func (l *List[T]) OnItemActivated(f func(context *guigui.Context, index int)) {
+	l.content.OnItemActivated(f)
+}
+
+// OnEndReached sets the event handler that is called when the list is scrolled to the end.
+// The handler is called once per item count, so appending items, e.g. for infinite scrolling, lets it be called again.
+func (l *List[T]) OnEndReached(f func(context *guigui.Context)) {
+	l.content.OnEndReached(f)
+}
+
 // SetReservesCheckmarkSpace sets whether the list reserves space for the
 // checkmark column even when no item is currently checked. This keeps item
 // widths and positions stable across check-state changes.
@@ -223,6 +259,24 @@ func (l *List[T]) SetReservesCheckmarkSpace(reserves bool) {
 	l.content.SetReservesCheckmarkSpace(reserves)
 }
 
+// SetCheckboxSelection sets whether the list shows a checkbox for each selectable item.
+// The default is false.
+//
+// Clicking a checkbox, or pressing the space key for the selected items, toggles the checkmarks
+// without changing the selection or the focus.
+// The list doesn't update [ListItem.Checked] by itself. Update it at [List.OnItemCheckToggled].
+//
+// Checkbox selection is not available with a data source.
+func (l *List[T]) SetCheckboxSelection(checkbox bool) {
+	l.content.SetCheckboxSelection(checkbox)
+}
+
+// OnItemCheckToggled sets the event handler that is called when the checkbox of an item is toggled by the user.
+// checked is the new state. Update [ListItem.Checked] by [List.SetItems] to reflect it.
+func (l *List[T]) OnItemCheckToggled(f func(context *guigui.Context, index int, checked bool)) {
+	l.content.OnItemCheckToggled(f)
+}
+
 func (l *List[T]) SetHeaderHeight(height int) {
 	inner := l.inner.Widget()
 	if inner.headerHeight == height {
@@ -287,6 +341,9 @@ func (l *List[T]) Build(context *guigui.Context, adder *guigui.ChildAdder) error
 		item := l.listItemWidgets.At(i)
 		item.text.SetBold(item.item.Header || l.content.Style() == ListStyleSidebar && l.SelectedItemIndex() == i)
 	}
+	for i, e := range l.dataSourceItems.entries {
+		e.widget.text.SetBold(e.widget.item.Header || l.content.Style() == ListStyleSidebar && l.SelectedItemIndex() == i)
+	}
 
 	return nil
 }
@@ -312,7 +369,15 @@ func (l *List[T]) SelectedItem() (ListItem[T], bool) {
 	return l.ItemByIndex(l.content.SelectedItemIndex())
 }
 
+// ItemByIndex returns the item at the given index.
+// With a data source, ItemByIndex returns false if the item is not available yet.
 func (l *List[T]) ItemByIndex(index int) (ListItem[T], bool) {
+	if l.dataSourceItems.dataSource != nil {
+		if index < 0 || index >= l.ItemCount() {
+			return ListItem[T]{}, false
+		}
+		return l.dataSourceItems.dataSource.ItemAt(index)
+	}
 	if index < 0 || index >= l.listItemWidgets.Len() {
 		return ListItem[T]{}, false
 	}
@@ -320,6 +385,9 @@ func (l *List[T]) ItemByIndex(index int) (ListItem[T], bool) {
 }
 
 func (l *List[T]) IndexByValue(value T) int {
+	if l.dataSourceItems.dataSource != nil {
+		return l.dataSourceItems.indexByValue(value)
+	}
 	for i := range l.listItemWidgets.Len() {
 		if l.listItemWidgets.At(i).item.Value == value {
 			return i
@@ -337,6 +405,8 @@ func (l *List[T]) SetItemsByStrings(strs []string) {
 }
 
 func (l *List[T]) SetItems(items []ListItem[T]) {
+	l.dataSourceItems.setDataSource(nil, l)
+
 	l.abstractListItems = adjustSliceSize(l.abstractListItems, len(items))
 	l.listItemWidgets.SetLen(len(items))
 
@@ -345,6 +415,8 @@ func (l *List[T]) SetItems(items []ListItem[T]) {
 		l.listItemWidgets.At(i).setHeight(l.listItemHeightPlus1 - 1)
 		l.listItemWidgets.At(i).setStyle(l.content.Style())
 		l.abstractListItems[i].Content = l.listItemWidgets.At(i)
+		l.abstractListItems[i].Text = item.Text
+		l.abstractListItems[i].Header = item.Header
 		l.abstractListItems[i].Unselectable = !item.selectable()
 		l.abstractListItems[i].Movable = item.Movable
 		l.abstractListItems[i].Value = item.Value
@@ -355,14 +427,109 @@ func (l *List[T]) SetItems(items []ListItem[T]) {
 		l.abstractListItems[i].index = i
 		l.abstractListItems[i].listContent = &l.content
 	}
+	l.applyFilter()
+	l.content.SetItems(l.abstractListItems)
+}
+
+// setTypeAheadDisabled sets whether the typed characters are ignored by the list.
+func (l *List[T]) setTypeAheadDisabled(disabled bool) {
+	l.content.typeAheadDisabled = disabled
+}
+
+// setCopyDisabled sets whether the list ignores the shortcut key to copy.
+// The owner might copy the items in its own way.
+func (l *List[T]) setCopyDisabled(disabled bool) {
+	l.content.copyDisabled = disabled
+}
+
+// CanCopy reports whether there is any selected item to copy.
+func (l *List[T]) CanCopy() bool {
+	return l.content.canCopy()
+}
+
+// Copy copies the texts of the selected items to the clipboard as TSV, one item per line.
+// Copy reports whether the texts are copied.
+//
+// Ctrl+C, or Cmd+C on macOS, also copies the selected items while the list is focused.
+func (l *List[T]) Copy() bool {
+	return l.content.copy()
+}
+
+// setFilterQuery sets the query to narrow the items.
+// The items whose texts don't contain the query are hidden, and the matched parts of the texts are highlighted.
+// Header items are hidden while the query is not empty.
+//
+// The filter doesn't work with a data source.
+func (l *List[T]) setFilterQuery(collator *collate.Collator, query string) {
+	if !l.filter.setQuery(collator, query) {
+		return
+	}
+	if l.dataSourceItems.dataSource != nil {
+		return
+	}
+	l.applyFilter()
 	l.content.SetItems(l.abstractListItems)
 }
 
+// filteredItemCount returns the number of the items matching the query.
+func (l *List[T]) filteredItemCount(collator *collate.Collator, query string) int {
+	var filter textFilter
+	filter.setQuery(collator, query)
+	var count int
+	for _, item := range l.abstractListItems {
+		if filter.isActive() && item.Header {
+			continue
+		}
+		if _, _, ok := filter.match(item.Text); ok {
+			count++
+		}
+	}
+	return count
+}
+
+func (l *List[T]) applyFilter() {
+	for i := range l.abstractListItems {
+		item := &l.abstractListItems[i]
+		start, end, ok := l.filter.match(item.Text)
+		if l.filter.isActive() && item.Header {
+			ok = false
+		}
+		item.Hidden = !ok
+		if ok {
+			l.listItemWidgets.At(i).setHighlightRange(start, end)
+		} else {
+			l.listItemWidgets.At(i).setHighlightRange(0, 0)
+		}
+	}
+}
+
+// SetDataSource sets the data source to provide the items lazily, instead of [List.SetItems].
+// The list queries only the items around the viewport.
+//
+// If dataSource is nil, the list has no items.
+func (l *List[T]) SetDataSource(dataSource ListDataSource[T]) {
+	if dataSource == nil {
+		l.SetItems(nil)
+		return
+	}
+	l.abstractListItems = slices.Delete(l.abstractListItems, 0, len(l.abstractListItems))
+	l.listItemWidgets.SetLen(0)
+	l.dataSourceItems.setDataSource(dataSource, l)
+	l.content.setItemSource(&l.dataSourceItems)
+}
+
 func (l *List[T]) ItemCount() int {
+	if l.dataSourceItems.dataSource != nil {
+		return l.dataSourceItems.itemCount()
+	}
 	return len(l.abstractListItems)
 }
 
 func (l *List[T]) ID(index int) any {
+	if l.dataSourceItems.dataSource != nil {
+		item, _ := l.dataSourceItems.dataSource.ItemAt(index)
+		return item.Value
+	}
 	return l.abstractListItems[index].Value
 }
 
@@ -399,10 +566,21 @@ func (l *List[T]) SetStyle(style ListStyle) {
 	l.inner.Widget().frame.SetStyle(style)
 }
 
+// SetItemString sets the text of the item at the given index.
+// SetItemString does nothing with a data source.
 func (l *List[T]) SetItemString(str string, index int) {
+	if l.dataSourceItems.dataSource != nil {
+		return
+	}
 	l.listItemWidgets.At(index).setText(str)
 }
 
+// setTreeInContent sets whether the content widgets render the indents and the expanders of the tree items by themselves.
+// The list still hides the children of collapsed items, but doesn't indent the items nor handle the expanders.
+func (l *List[T]) setTreeInContent(treeInContent bool) {
+	l.content.treeInContent = treeInContent
+}
+
 func (l *List[T]) setContentWidth(width int) {
 	l.content.SetContentWidth(width)
 }
@@ -411,6 +589,11 @@ func (l *List[T]) scrollOffset() (float64, float64) {
 	return l.inner.Widget().panel.scrollOffset()
 }
 
+// minScrollOffsetX returns the minimum horizontal scroll offset, i.e., the offset when the list is scrolled to the right end.
+func (l *List[T]) minScrollOffsetX() float64 {
+	return l.inner.Widget().panel.minOffsetX
+}
+
 func (l *List[T]) Measure(context *guigui.Context, constraints guigui.Constraints) image.Point {
 	inner := l.inner.Widget()
 	s := l.content.Measure(context, constraints)
@@ -419,6 +602,10 @@ func (l *List[T]) Measure(context *guigui.Context, constraints guigui.Constraint
 }
 
 func (l *List[T]) Tick(context *guigui.Context, widgetBounds *guigui.WidgetBounds) error {
+	// Query the items again, as the items might be loaded asynchronously.
+	if l.dataSourceItems.dataSource != nil {
+		l.dataSourceItems.refresh()
+	}
 	return nil
 }
 
@@ -431,6 +618,10 @@ type listItemWidget[T comparable] struct {
 	item        ListItem[T]
 	heightPlus1 int
 	style       ListStyle
+	placeholder bool
+
+	highlightStart int
+	highlightEnd   int
 
 	layout             guigui.LinearLayout
 	layoutItems        []guigui.LinearLayoutItem
@@ -444,6 +635,9 @@ func (l *listItemWidget[T]) WriteStateKey(w *guigui.StateKeyWriter) {
 	l.item.writeStateKey(w)
 	w.WriteInt(l.heightPlus1)
 	w.WriteUint64(uint64(l.style))
+	w.WriteBool(l.placeholder)
+	w.WriteInt(l.highlightStart)
+	w.WriteInt(l.highlightEnd)
 }
 
 func (l *listItemWidget[T]) setListItem(listItem ListItem[T]) {
@@ -462,6 +656,17 @@ func (l *listItemWidget[T]) setHeight(height int) {
 	l.resetLayout()
 }
 
+// setPlaceholder sets whether the item is a placeholder for an item that is not available yet.
+func (l *listItemWidget[T]) setPlaceholder(placeholder bool) {
+	l.placeholder = placeholder
+}
+
+// setHighlightRange sets the byte range of the text to highlight.
+func (l *listItemWidget[T]) setHighlightRange(start, end int) {
+	l.highlightStart = start
+	l.highlightEnd = end
+}
+
 func (l *listItemWidget[T]) setStyle(style ListStyle) {
 	if l.style == style {
 		return
@@ -492,6 +697,7 @@ func (l *listItemWidget[T]) Build(context *guigui.Context, adder *guigui.ChildAd
 	l.text.SetBold(l.item.TextStyle.Bold)
 	l.text.SetTabular(l.item.TextStyle.Tabular)
 	l.text.SetWrapMode(l.item.TextStyle.WrapMode)
+	l.text.setHighlightRange(l.highlightStart, l.highlightEnd)
 	l.keyText.SetOpacity(0.5)
 	l.keyText.SetValue(l.item.KeyText)
 	l.keyText.SetVerticalAlign(VerticalAlignMiddle)
@@ -609,6 +815,16 @@ func (l *listItemWidget[T]) Measure(context *guigui.Context, constraints guigui.
 }
 
 func (l *listItemWidget[T]) Draw(context *guigui.Context, widgetBounds *guigui.WidgetBounds, dst *ebiten.Image) {
+	if l.placeholder {
+		// Draw a bar instead of the text.
+		b := widgetBounds.Bounds()
+		p := ListItemTextPadding(context)
+		h := LineHeight(context) / 2
+		y := b.Min.Y + (b.Dy()-h)/2
+		bar := image.Rect(b.Min.X+p.Start, y, b.Min.X+p.Start+max(b.Dx()/2-p.Start, 0), y+h)
+		basicwidgetdraw.DrawRoundedRect(context, dst, bar, draw.Color(context.ColorMode(), draw.SemanticColorBase, 0.8), h/2)
+		return
+	}
 	if l.item.Border {
 		u := UnitSize(context)
 		b := widgetBounds.Bounds()
@@ -637,6 +853,9 @@ func ListItemTextPadding(context *guigui.Context) guigui.Padding {
 
 type abstractListItem[T comparable] struct {
 	Content      guigui.Widget
+	Text         string
+	Header       bool
+	Hidden       bool
 	Unselectable bool
 	Movable      bool
 	Value        T
@@ -680,6 +899,7 @@ type listContent[T comparable] struct {
 	unfocusedSelectionHidden  bool
 	style                     ListStyle
 	reservesCheckmarkSpace    bool
+	checkboxSelection         bool
 	hasCheckedItem            bool
 	hoveredItemIndexPlus1     int
 	lastHoveredItemIndexPlus1 int
@@ -694,13 +914,36 @@ type listContent[T comparable] struct {
 	dragDstIndexPlus1         int
 	pressStartPlus1           image.Point
 	startPressingIndexPlus1   int
+	marquee                   listMarquee
 	contentWidthPlus1         int
 	widthForCachedHeight      int
 	cachedHeight              int
 
-	itemBoundsForLayoutFromIndex []image.Rectangle
+	// itemBoundsForLayoutFromIndex is the bounds of the laid-out items keyed by the item index.
+	itemBoundsForLayoutFromIndex map[int]image.Rectangle
 	visibleBounds                image.Rectangle
 
+	// itemSource provides the items lazily instead of SetItems.
+	// With itemSource, all the items are available, and the available-item index is the same as the item index.
+	itemSource *listDataSourceItems[T]
+
+	// endReachedItemCountPlus1 is the item count when the end-reached event is dispatched, plus 1.
+	endReachedItemCountPlus1 int
+
+	// stickyHeaderBackground hides the items behind the header item sticking to the top of the viewport.
+	stickyHeaderBackground listStickyHeaderBackground[T]
+
+	// stickyHeaderIndexPlus1 is the index of the header item laid out at the top of the viewport instead of its natural position, plus 1.
+	stickyHeaderIndexPlus1 int
+
+	typeAhead         typeAhead
+	typeAheadDisabled bool
+	copyDisabled      bool
+
+	// treeInContent reports whether the content widgets render the indents and the expanders of the tree items by themselves.
+	treeInContent bool
+	tmpInputChars []rune
+
 	// tmpAvailableIndices is a scratch buffer reused by appendAvailableIndices
 	// callers to avoid allocation on each Build/Layout/Tick call.
 	tmpAvailableIndices []int
@@ -739,6 +982,9 @@ type listContent[T comparable] struct {
 }
 
 func (l *listContent[T]) itemCount() int {
+	if l.itemSource != nil {
+		return l.abstractList.ItemCount()
+	}
 	var count int
 	for i := range l.abstractList.ItemCount() {
 		if l.isItemAvailable(i) {
@@ -752,10 +998,14 @@ func (l *listContent[T]) itemCount() int {
 // available-item index, or -1 if the index is out of range. Implements
 // [virtualScrollContent.measureItemHeight].
 func (l *listContent[T]) measureItemHeight(context *guigui.Context, availableIndex int) int {
-	if availableIndex < 0 || availableIndex >= len(l.tmpAvailableIndices) {
+	if availableIndex < 0 || availableIndex >= l.availableItemCount() {
 		return -1
 	}
-	return l.measureItemHeightWithContentWidth(context, l.tmpAvailableIndices[availableIndex], l.contentWidth(context))
+	// Avoid loading the item if possible.
+	if h := l.itemHeightFromSource(context, availableIndex); h >= 0 {
+		return h
+	}
+	return l.measureItemHeightWithContentWidth(context, l.itemIndexFromAvailableIndex(availableIndex), l.contentWidth(context))
 }
 
 func (l *listContent[T]) contentWidth(_ *guigui.Context) int {
@@ -794,10 +1044,38 @@ func (l *listContent[T]) OnItemExpanderToggled(f func(context *guigui.Context, i
 	guigui.SetEventHandler(l, listEventItemExpanderToggled, f)
 }
 
+func (l *listContent[T]) OnItemCheckToggled(f func(context *guigui.Context, index int, checked bool)) {
+	guigui.SetEventHandler(l, listEventItemCheckToggled, f)
+}
+
+func (l *listContent[T]) OnItemClicked(f func(context *guigui.Context, index int, click guigui.Click)) {
+	guigui.SetEventHandler(l, listEventItemClicked, f)
+}
+
+func (l *listContent[T]) OnItemActivated(f func(context *guigui.Context, index int)) {
+	guigui.SetEventHandler(l, listEventItemActivated, f)
+}
+
+func (l *listContent[T]) OnEndReached(f func(context *guigui.Context)) {
+	guigui.SetEventHandler(l, listEventEndReached, f)
+}
+
+func (l *listContent[T]) dispatchItemClicked(widgetBounds *guigui.WidgetBounds, index int) {
+	click, ok := widgetBounds.ClickAtCursor()
+	if !ok {
+		return
+	}
+	guigui.DispatchEvent(l, listEventItemClicked, index, click)
+	if click.Button == ebiten.MouseButtonLeft && click.Count == 2 {
+		guigui.DispatchEvent(l, listEventItemActivated, index)
+	}
+}
+
 func (l *listContent[T]) WriteStateKey(w *guigui.StateKeyWriter) {
 	l.abstractList.writeStateKey(w)
 	w.WriteUint64(uint64(l.style))
 	w.WriteBool(l.reservesCheckmarkSpace)
+	w.WriteBool(l.checkboxSelection)
 	w.WriteInt(l.contentWidthPlus1)
 	w.WriteInt(l.hoveredItemIndexPlus1)
 	w.WriteInt(l.lastHoveredItemIndexPlus1)
@@ -816,11 +1094,96 @@ func (l *listContent[T]) SetReservesCheckmarkSpace(reserves bool) {
 	l.widthForCachedHeight = 0
 }
 
+func (l *listContent[T]) SetCheckboxSelection(checkbox bool) {
+	if l.checkboxSelection == checkbox {
+		return
+	}
+	l.checkboxSelection = checkbox
+	// Invalidate the cached height so Measure recalculates.
+	l.widthForCachedHeight = 0
+}
+
+// isCheckboxSelection reports whether the checkmarks are shown as checkboxes that the user can toggle.
+// Items of a data source have no checkmarks.
+func (l *listContent[T]) isCheckboxSelection() bool {
+	return l.checkboxSelection && l.itemSource == nil
+}
+
 // hasCheckmarkColumn reports whether the list reserves a column for checkmarks.
 // The column is reserved either explicitly via [SetReservesCheckmarkSpace] or
-// implicitly when at least one item is checked.
+// implicitly when at least one item is checked or the checkbox selection is enabled.
 func (l *listContent[T]) hasCheckmarkColumn() bool {
-	return l.reservesCheckmarkSpace || l.hasCheckedItem
+	return l.reservesCheckmarkSpace || l.hasCheckedItem || l.isCheckboxSelection()
+}
+
+// checkmarkBounds returns the bounds of the checkmark of the laid-out item at index.
+// In the checkbox selection, the checkmark is centered in the checkmark column to fit in the checkbox.
+func (l *listContent[T]) checkmarkBounds(context *guigui.Context, index int) image.Rectangle {
+	r, ok := l.itemBoundsForLayoutFromIndex[index]
+	if !ok {
+		return image.Rectangle{}
+	}
+	item, _ := l.abstractList.ItemByIndex(index)
+	s := listItemCheckmarkSize(context)
+	p := image.Pt(r.Min.X-item.Padding.Start-s-listItemTextAndImagePadding(context), r.Min.Y+(r.Dy()-s)/2)
+	if l.isCheckboxSelection() {
+		p.X += listItemTextAndImagePadding(context) / 2
+	} else {
+		p.X += UnitSize(context) / 4
+		p.Y += UnitSize(context) / 16
+	}
+	return image.Rectangle{
+		Min: p,
+		Max: p.Add(image.Pt(s, s)),
+	}
+}
+
+// isCheckboxHitAt reports whether the point is on the checkmark column of the laid-out item at index.
+func (l *listContent[T]) isCheckboxHitAt(context *guigui.Context, index int, point image.Point) bool {
+	if !l.isCheckboxSelection() {
+		return false
+	}
+	r, ok := l.itemBoundsForLayoutFromIndex[index]
+	if !ok {
+		return false
+	}
+	item, _ := l.abstractList.ItemByIndex(index)
+	x1 := r.Min.X - item.Padding.Start
+	x0 := x1 - listItemCheckmarkSize(context) - listItemTextAndImagePadding(context)
+	return point.X >= x0 && point.X < x1
+}
+
+// toggleItemCheckedByUser dispatches the event to toggle the checkmark of the item at index.
+func (l *listContent[T]) toggleItemCheckedByUser(index int) {
+	item, ok := l.abstractList.ItemByIndex(index)
+	if !ok || item.Unselectable {
+		return
+	}
+	guigui.DispatchEvent(l, listEventItemCheckToggled, index, !item.Checked)
+}
+
+// toggleSelectedItemsCheckedByUser checks all the selected items, or unchecks them if all of them are already checked.
+// toggleSelectedItemsCheckedByUser reports whether there is any selected item.
+func (l *listContent[T]) toggleSelectedItemsCheckedByUser() bool {
+	l.tmpSelectedIndices = l.abstractList.AppendSelectedItemIndices(l.tmpSelectedIndices[:0])
+	if len(l.tmpSelectedIndices) == 0 {
+		return false
+	}
+	checked := true
+	for _, index := range l.tmpSelectedIndices {
+		if item, ok := l.abstractList.ItemByIndex(index); ok && !item.Checked {
+			checked = false
+			break
+		}
+	}
+	for _, index := range l.tmpSelectedIndices {
+		item, ok := l.abstractList.ItemByIndex(index)
+		if !ok || item.Unselectable || item.Checked != checked {
+			continue
+		}
+		guigui.DispatchEvent(l, listEventItemCheckToggled, index, !checked)
+	}
+	return true
 }
 
 func (l *listContent[T]) SetContentWidth(width int) {
@@ -828,13 +1191,13 @@ func (l *listContent[T]) SetContentWidth(width int) {
 }
 
 func (l *listContent[T]) ItemBounds(index int) image.Rectangle {
-	if index < 0 || index >= len(l.itemBoundsForLayoutFromIndex) {
-		return image.Rectangle{}
-	}
 	return l.itemBoundsForLayoutFromIndex[index]
 }
 
 func (l *listContent[T]) isItemAvailable(index int) bool {
+	if l.itemSource != nil {
+		return index >= 0 && index < l.abstractList.ItemCount()
+	}
 	item, ok := l.abstractList.ItemByIndex(index)
 	if !ok {
 		return false
@@ -861,11 +1224,8 @@ func (l *listContent[T]) nextAvailableItem(index int) (int, bool) {
 }
 
 func (l *listContent[T]) isItemInViewport(index int) bool {
-	if index < 0 || index >= len(l.itemBoundsForLayoutFromIndex) {
-		return false
-	}
-	r := l.itemBoundsForLayoutFromIndex[index]
-	if r.Empty() {
+	r, ok := l.itemBoundsForLayoutFromIndex[index]
+	if !ok || r.Empty() {
 		return false
 	}
 	return r.Max.Y > l.visibleBounds.Min.Y && r.Min.Y < l.visibleBounds.Max.Y
@@ -890,8 +1250,13 @@ func (l *listContent[T]) Build(context *guigui.Context, adder *guigui.ChildAdder
 		adder.AddWidget(l.customBackground)
 	}
 	adder.AddWidget(&l.background2)
-	l.checkmarks.SetLen(l.abstractList.ItemCount())
-	l.expanderImages.SetLen(l.abstractList.ItemCount())
+	// Items of a data source have neither checkmarks nor expanders.
+	var imageCount int
+	if l.itemSource == nil {
+		imageCount = l.abstractList.ItemCount()
+	}
+	l.checkmarks.SetLen(imageCount)
+	l.expanderImages.SetLen(imageCount)
 
 	// Only add items around the top item, extending downward and upward until
 	// the accumulated height in each direction reaches the app bounds height.
@@ -902,14 +1267,14 @@ func (l *listContent[T]) Build(context *guigui.Context, adder *guigui.ChildAdder
 	// items above the top item must still be added to the widget tree.
 	// Item heights are measured with default constraints so each item widget
 	// determines its size freely.
-	l.tmpAvailableIndices = l.appendAvailableIndices(l.tmpAvailableIndices[:0])
-	availableIndices := l.tmpAvailableIndices
+	l.updateAvailableIndices()
+	availableCount := l.availableItemCount()
 	topIdx, _ := l.listPanel.topItem()
 	if topIdx < 0 {
 		topIdx = 0
 	}
-	if topIdx > len(availableIndices) {
-		topIdx = len(availableIndices)
+	if topIdx > availableCount {
+		topIdx = availableCount
 	}
 	appBoundsHeight := context.AppBounds().Dy()
 	_, topOff := l.listPanel.topItem()
@@ -917,10 +1282,10 @@ func (l *listContent[T]) Build(context *guigui.Context, adder *guigui.ChildAdder
 	// Find the end of the downward range [topIdx, hi).
 	hi := topIdx
 	var downH int
-	for ai := topIdx; ai < len(availableIndices); ai++ {
-		i := availableIndices[ai]
+	for ai := topIdx; ai < availableCount; ai++ {
+		i := l.itemIndexFromAvailableIndex(ai)
 		item, _ := l.abstractList.ItemByIndex(i)
-		h := item.Content.Measure(context, guigui.Constraints{}).Y
+		h := l.measureItemContentHeightForBuild(context, i, item)
 		visibleH := h + item.Padding.Top + item.Padding.Bottom
 		if ai == topIdx && topOff < 0 {
 			// The topItem may be scrolled partially (or fully) above the
@@ -941,9 +1306,9 @@ func (l *listContent[T]) Build(context *guigui.Context, adder *guigui.ChildAdder
 	lo := topIdx
 	var upH int
 	for ai := topIdx - 1; ai >= 0; ai-- {
-		i := availableIndices[ai]
+		i := l.itemIndexFromAvailableIndex(ai)
 		item, _ := l.abstractList.ItemByIndex(i)
-		h := item.Content.Measure(context, guigui.Constraints{}).Y
+		h := l.measureItemContentHeightForBuild(context, i, item)
 		upH += h + item.Padding.Top + item.Padding.Bottom
 		lo = ai
 		if upH >= appBoundsHeight {
@@ -951,9 +1316,23 @@ func (l *listContent[T]) Build(context *guigui.Context, adder *guigui.ChildAdder
 		}
 	}
 
+	// Release the cached items of the data source far from the viewport.
+	if l.itemSource != nil {
+		l.itemSource.retainItems(lo, hi, l.abstractList.anchorIndex)
+	}
+
+	// The sticky header is added after the other items so that it is rendered over them.
+	stickyIdx := -1
+	if ai := l.stickyHeaderAvailableIndex(topIdx); ai >= 0 {
+		stickyIdx = l.itemIndexFromAvailableIndex(ai)
+	}
+
 	// Add the items in forward order so the child order matches the visual order.
 	for ai := lo; ai < hi; ai++ {
-		i := availableIndices[ai]
+		i := l.itemIndexFromAvailableIndex(ai)
+		if i == stickyIdx {
+			continue
+		}
 		item, _ := l.abstractList.ItemByIndex(i)
 		if item.Checked {
 			adder.AddWidget(l.checkmarks.At(i))
@@ -963,7 +1342,7 @@ func (l *listContent[T]) Build(context *guigui.Context, adder *guigui.ChildAdder
 			hasChild = nextItem.IndentLevel > item.IndentLevel
 		}
 
-		if hasChild {
+		if hasChild && !l.treeInContent {
 			img := l.expanderImages.At(i)
 			if !item.Collapsed {
 				img.SetImage(l.treeItemExpandedImage)
@@ -974,6 +1353,10 @@ func (l *listContent[T]) Build(context *guigui.Context, adder *guigui.ChildAdder
 		}
 		adder.AddWidget(item.Content)
 	}
+	if item, ok := l.abstractList.ItemByIndex(stickyIdx); ok {
+		adder.AddWidget(&l.stickyHeaderBackground)
+		adder.AddWidget(item.Content)
+	}
 
 	if l.onItemSelected == nil {
 		l.onItemSelected = func(index int) {
@@ -990,6 +1373,7 @@ func (l *listContent[T]) Build(context *guigui.Context, adder *guigui.ChildAdder
 	l.abstractList.OnItemsSelected(l.onItemsSelected)
 
 	l.background2.setListContent(l)
+	l.stickyHeaderBackground.setListContent(l)
 
 	var err error
 	l.treeItemCollapsedImage, err = theResourceImages.Get("keyboard_arrow_right", context.ColorMode())
@@ -1007,11 +1391,14 @@ func (l *listContent[T]) Build(context *guigui.Context, adder *guigui.ChildAdder
 	}
 	clear(l.widgetToIndex)
 	for ai := lo; ai < hi; ai++ {
-		i := availableIndices[ai]
+		i := l.itemIndexFromAvailableIndex(ai)
 		if item, ok := l.abstractList.ItemByIndex(i); ok {
 			l.widgetToIndex[item.Content] = i
 		}
 	}
+	if item, ok := l.abstractList.ItemByIndex(stickyIdx); ok {
+		l.widgetToIndex[item.Content] = stickyIdx
+	}
 
 	return nil
 }
@@ -1022,8 +1409,11 @@ func (l *listContent[T]) Layout(context *guigui.Context, widgetBounds *guigui.Wi
 		cw = l.contentWidthPlus1 - 1
 	}
 
-	l.itemBoundsForLayoutFromIndex = adjustSliceSize(l.itemBoundsForLayoutFromIndex, l.abstractList.ItemCount())
+	if l.itemBoundsForLayoutFromIndex == nil {
+		l.itemBoundsForLayoutFromIndex = map[int]image.Rectangle{}
+	}
 	clear(l.itemBoundsForLayoutFromIndex)
+	l.stickyHeaderIndexPlus1 = 0
 
 	l.visibleBounds = widgetBounds.VisibleBounds()
 
@@ -1044,9 +1434,9 @@ func (l *listContent[T]) layoutItems(context *guigui.Context, widgetBounds *guig
 	clear(l.measuredContentHeights)
 
 	// Build a mapping from available-item order to real index.
-	l.tmpAvailableIndices = l.appendAvailableIndices(l.tmpAvailableIndices[:0])
-	availableIndices := l.tmpAvailableIndices
-	if len(availableIndices) == 0 {
+	l.updateAvailableIndices()
+	availableCount := l.availableItemCount()
+	if availableCount == 0 {
 		return
 	}
 
@@ -1069,24 +1459,25 @@ func (l *listContent[T]) layoutItems(context *guigui.Context, widgetBounds *guig
 	// two RoundedCornerRadius paddings, so the effective viewport for clamp
 	// purposes is viewportHeight - 2*RCR.
 	rcr := RoundedCornerRadius(context)
-	topIdx, topOff := l.listPanel.layoutTopItem(context, viewportHeight-2*rcr,
-		func(ai int) int {
-			h := l.measureItemHeightWithContentWidth(context, availableIndices[ai], cw)
-			if l.isExpandAnimating() && l.isChildOfExpandAnimatingItem(availableIndices[ai]) {
-				return int(float64(h) * animRate)
-			}
-			return h
-		})
+	apparentItemHeight := func(ai int) int {
+		i := l.itemIndexFromAvailableIndex(ai)
+		h := l.measureItemHeightWithContentWidth(context, i, cw)
+		if l.isExpandAnimating() && l.isChildOfExpandAnimatingItem(i) {
+			return int(float64(h) * animRate)
+		}
+		return h
+	}
+	topIdx, topOff := l.listPanel.layoutTopItem(context, viewportHeight-2*rcr, apparentItemHeight)
 
 	// Start Y at the top of the viewport plus the topItemOffset.
 	y := viewportTop + RoundedCornerRadius(context) + topOff
 
 	// Lay out items downward from topIdx.
-	for ai := topIdx; ai < len(availableIndices); ai++ {
+	for ai := topIdx; ai < availableCount; ai++ {
 		if y >= viewportBottom {
 			break
 		}
-		i := availableIndices[ai]
+		i := l.itemIndexFromAvailableIndex(ai)
 		itemH := l.layoutItem(context, widgetBounds, layouter, i, baseX, y, cw)
 		if l.isExpandAnimating() && l.isChildOfExpandAnimatingItem(i) {
 			y += int(float64(itemH) * animRate)
@@ -1098,7 +1489,7 @@ func (l *listContent[T]) layoutItems(context *guigui.Context, widgetBounds *guig
 	// Lay out items upward from topIdx-1 to fill any gap above.
 	y = viewportTop + RoundedCornerRadius(context) + topOff
 	for ai := topIdx - 1; ai >= 0; ai-- {
-		i := availableIndices[ai]
+		i := l.itemIndexFromAvailableIndex(ai)
 		itemH := l.measureItemHeightWithContentWidth(context, i, cw)
 		if l.isExpandAnimating() && l.isChildOfExpandAnimatingItem(i) {
 			y -= int(float64(itemH) * animRate)
@@ -1110,6 +1501,90 @@ func (l *listContent[T]) layoutItems(context *guigui.Context, widgetBounds *guig
 			break
 		}
 	}
+
+	l.layoutStickyHeader(context, widgetBounds, layouter, topIdx, topOff, baseX, cw, apparentItemHeight)
+}
+
+// stickyHeaderAvailableIndex returns the available-item index of the header item sticking to the top of the viewport,
+// i.e., the last header item at or before the top item, or -1 if there is no such item.
+func (l *listContent[T]) stickyHeaderAvailableIndex(topAvailableIndex int) int {
+	// Finding the header item might load all the items of a data source.
+	if l.itemSource != nil {
+		return -1
+	}
+	for ai := min(topAvailableIndex, l.availableItemCount()-1); ai >= 0; ai-- {
+		item, _ := l.abstractList.ItemByIndex(l.itemIndexFromAvailableIndex(ai))
+		if item.Header {
+			return ai
+		}
+	}
+	return -1
+}
+
+// layoutStickyHeader lays out the header item of the section at the top of the viewport at the top of the viewport.
+// The header item is pushed up by the next header item.
+func (l *listContent[T]) layoutStickyHeader(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter, topIdx, topOff int, baseX int, cw int, apparentItemHeight func(ai int) int) {
+	headerAI := l.stickyHeaderAvailableIndex(topIdx)
+	if headerAI < 0 {
+		return
+	}
+	headerIdx := l.itemIndexFromAvailableIndex(headerAI)
+	headerH := l.measureItemHeightWithContentWidth(context, headerIdx, cw)
+
+	contentTop := widgetBounds.Bounds().Min.Y + RoundedCornerRadius(context)
+	naturalY := math.MinInt
+	if headerAI == topIdx {
+		naturalY = contentTop + topOff
+	}
+	y := max(contentTop, naturalY)
+
+	// The next header item pushes the sticky header item up.
+	nextY := contentTop + topOff
+	for ai := topIdx; ai < l.availableItemCount() && nextY < y+headerH; ai++ {
+		if ai > headerAI {
+			if item, _ := l.abstractList.ItemByIndex(l.itemIndexFromAvailableIndex(ai)); item.Header {
+				y = min(y, nextY-headerH)
+				break
+			}
+		}
+		nextY += apparentItemHeight(ai)
+	}
+
+	if y == naturalY {
+		return
+	}
+	l.layoutItem(context, widgetBounds, layouter, headerIdx, baseX, y, cw)
+	l.stickyHeaderIndexPlus1 = headerIdx + 1
+	b := widgetBounds.Bounds()
+	layouter.LayoutWidget(&l.stickyHeaderBackground, image.Rect(b.Min.X, y, b.Max.X, y+headerH))
+}
+
+// updateAvailableIndices updates the mapping from the available-item index to the item index.
+func (l *listContent[T]) updateAvailableIndices() {
+	if l.itemSource != nil {
+		// The mapping is the identity.
+		l.tmpAvailableIndices = l.tmpAvailableIndices[:0]
+		return
+	}
+	l.tmpAvailableIndices = l.appendAvailableIndices(l.tmpAvailableIndices[:0])
+}
+
+// availableItemCount returns the number of the available items.
+// availableItemCount is valid after updateAvailableIndices is called.
+func (l *listContent[T]) availableItemCount() int {
+	if l.itemSource != nil {
+		return l.abstractList.ItemCount()
+	}
+	return len(l.tmpAvailableIndices)
+}
+
+// itemIndexFromAvailableIndex converts an available-item index to the item index.
+// itemIndexFromAvailableIndex is valid after updateAvailableIndices is called.
+func (l *listContent[T]) itemIndexFromAvailableIndex(availableIndex int) int {
+	if l.itemSource != nil {
+		return availableIndex
+	}
+	return l.tmpAvailableIndices[availableIndex]
 }
 
 // appendAvailableIndices appends the indices of available items to the slice.
@@ -1123,6 +1598,23 @@ func (l *listContent[T]) appendAvailableIndices(indices []int) []int {
 	return indices
 }
 
+// itemHeightFromSource returns the height of the item including the padding given by the data source,
+// or -1 if the height is unknown.
+func (l *listContent[T]) itemHeightFromSource(context *guigui.Context, index int) int {
+	if l.itemSource == nil {
+		return -1
+	}
+	return l.itemSource.itemHeightAt(context, index)
+}
+
+// measureItemContentHeightForBuild returns the content height of an item measured with default constraints.
+func (l *listContent[T]) measureItemContentHeightForBuild(context *guigui.Context, index int, item abstractListItem[T]) int {
+	if h := l.itemHeightFromSource(context, index); h >= 0 {
+		return max(h-item.Padding.Top-item.Padding.Bottom, 0)
+	}
+	return item.Content.Measure(context, guigui.Constraints{}).Y
+}
+
 // measureItemContentHeight returns the content height of an item, caching the
 // result for the duration of the current Layout call.
 func (l *listContent[T]) measureItemContentHeight(context *guigui.Context, index int, cw int) int {
@@ -1130,10 +1622,15 @@ func (l *listContent[T]) measureItemContentHeight(context *guigui.Context, index
 		return h
 	}
 	item, _ := l.abstractList.ItemByIndex(index)
-	itemW := cw - 2*RoundedCornerRadius(context)
-	itemW -= ListItemIndentSize(context, item.IndentLevel)
-	itemW -= item.Padding.Start + item.Padding.End
-	contentH := item.Content.Measure(context, guigui.FixedWidthConstraints(itemW)).Y
+	var contentH int
+	if h := l.itemHeightFromSource(context, index); h >= 0 {
+		contentH = max(h-item.Padding.Top-item.Padding.Bottom, 0)
+	} else {
+		itemW := cw - 2*RoundedCornerRadius(context)
+		itemW -= l.itemIndentSize(context, item.IndentLevel)
+		itemW -= item.Padding.Start + item.Padding.End
+		contentH = item.Content.Measure(context, guigui.FixedWidthConstraints(itemW)).Y
+	}
 	if l.measuredContentHeights == nil {
 		l.measuredContentHeights = map[int]int{}
 	}
@@ -1156,7 +1653,7 @@ func (l *listContent[T]) measureItemHeightWithContentWidth(context *guigui.Conte
 func (l *listContent[T]) layoutItem(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter, index int, baseX int, y int, cw int) int {
 	item, _ := l.abstractList.ItemByIndex(index)
 	itemW := cw - 2*RoundedCornerRadius(context)
-	itemW -= ListItemIndentSize(context, item.IndentLevel)
+	itemW -= l.itemIndentSize(context, item.IndentLevel)
 	itemW -= item.Padding.Start + item.Padding.End
 	contentH := l.measureItemContentHeight(context, index, cw)
 	itemH := contentH + item.Padding.Top + item.Padding.Bottom
@@ -1171,7 +1668,7 @@ func (l *listContent[T]) layoutItem(context *guigui.Context, widgetBounds *guigu
 		if hasCheckmarkColumn {
 			itemP.X += listItemCheckmarkSize(context) + listItemTextAndImagePadding(context)
 		}
-		itemP.X += ListItemIndentSize(context, item.IndentLevel)
+		itemP.X += l.itemIndentSize(context, item.IndentLevel)
 		itemP.X += item.Padding.Start
 		itemP.Y = l.adjustItemY(context, itemP.Y)
 		itemP.Y += item.Padding.Top
@@ -1189,21 +1686,10 @@ func (l *listContent[T]) layoutItem(context *guigui.Context, widgetBounds *guigu
 	}
 
 	if item.Checked {
-		imgSize := listItemCheckmarkSize(context)
-		imgP := p
-		imgP.X += ListItemIndentSize(context, item.IndentLevel)
-		imgP.X += UnitSize(context) / 4
-		imgP.Y += (contentH - imgSize) / 2
-		imgP.Y += UnitSize(context) / 16
-		imgP.Y += item.Padding.Top
-		imgP.Y = l.adjustItemY(context, imgP.Y)
-		layouter.LayoutWidget(l.checkmarks.At(index), image.Rectangle{
-			Min: imgP,
-			Max: imgP.Add(image.Pt(imgSize, imgSize)),
-		})
+		layouter.LayoutWidget(l.checkmarks.At(index), l.checkmarkBounds(context, index))
 	}
 
-	if item.IndentLevel > 0 {
+	if item.IndentLevel > 0 && !l.treeInContent {
 		var img *ebiten.Image
 		var hasChild bool
 		if nextItem, ok := l.abstractList.ItemByIndex(index + 1); ok {
@@ -1218,7 +1704,7 @@ func (l *listContent[T]) layoutItem(context *guigui.Context, widgetBounds *guigu
 		}
 		l.expanderImages.At(index).SetImage(img)
 		expanderP := p
-		expanderP.X += ListItemIndentSize(context, item.IndentLevel) - LineHeight(context)
+		expanderP.X += l.itemIndentSize(context, item.IndentLevel) - LineHeight(context)
 		expanderP.Y += UnitSize(context) / 16
 		expanderP.Y += item.Padding.Top
 		s := image.Pt(
@@ -1235,7 +1721,7 @@ func (l *listContent[T]) layoutItem(context *guigui.Context, widgetBounds *guigu
 	if hasCheckmarkColumn {
 		itemP.X += listItemCheckmarkSize(context) + listItemTextAndImagePadding(context)
 	}
-	itemP.X += ListItemIndentSize(context, item.IndentLevel)
+	itemP.X += l.itemIndentSize(context, item.IndentLevel)
 	itemP.X += item.Padding.Start
 	itemP.Y = l.adjustItemY(context, itemP.Y)
 	itemP.Y += item.Padding.Top
@@ -1267,9 +1753,16 @@ func (l *listContent[T]) Measure(context *guigui.Context, constraints guigui.Con
 	hasCheckmark := l.hasCheckmarkColumn()
 	offsetForCheckmark := listItemCheckmarkSize(context) + listItemTextAndImagePadding(context)
 
+	count := l.abstractList.ItemCount()
+	if l.itemSource != nil {
+		// Measuring all the items of a data source is too expensive.
+		// Estimate the height from the first item.
+		count = min(count, 1)
+	}
+
 	var w, h int
 	var animatingChildrenH int
-	for i := range l.abstractList.ItemCount() {
+	for i := range count {
 		if !l.isItemAvailable(i) {
 			continue
 		}
@@ -1282,12 +1775,12 @@ func (l *listContent[T]) Measure(context *guigui.Context, constraints guigui.Con
 			if hasCheckmark {
 				itemW -= offsetForCheckmark
 			}
-			itemW -= ListItemIndentSize(context, item.IndentLevel)
+			itemW -= l.itemIndentSize(context, item.IndentLevel)
 			itemW -= item.Padding.Start + item.Padding.End
 			constraint = guigui.FixedWidthConstraints(itemW)
 		}
 		s := item.Content.Measure(context, constraint)
-		w = max(w, s.X+ListItemIndentSize(context, item.IndentLevel)+item.Padding.Start+item.Padding.End)
+		w = max(w, s.X+l.itemIndentSize(context, item.IndentLevel)+item.Padding.Start+item.Padding.End)
 		itemH := s.Y + item.Padding.Top + item.Padding.Bottom
 		if l.isExpandAnimating() && l.isChildOfExpandAnimatingItem(i) {
 			animatingChildrenH += itemH
@@ -1298,6 +1791,9 @@ func (l *listContent[T]) Measure(context *guigui.Context, constraints guigui.Con
 	if l.isExpandAnimating() && animatingChildrenH > 0 {
 		h += int(float64(animatingChildrenH) * l.expandAnimationRate())
 	}
+	if l.itemSource != nil {
+		h *= l.abstractList.ItemCount()
+	}
 	w += 2 * RoundedCornerRadius(context)
 	h += 2 * RoundedCornerRadius(context)
 	if hasCheckmark {
@@ -1315,6 +1811,10 @@ func (l *listContent[T]) Measure(context *guigui.Context, constraints guigui.Con
 }
 
 func (l *listContent[T]) hasMovableItems() bool {
+	// Items of a data source are not movable.
+	if l.itemSource != nil {
+		return false
+	}
 	for i := range l.abstractList.ItemCount() {
 		if !l.isItemAvailable(i) {
 			continue
@@ -1350,7 +1850,23 @@ func (l *listContent[T]) AppendSelectedItemIndices(indices []int) []int {
 	return l.abstractList.AppendSelectedItemIndices(indices)
 }
 
+// setItemSource sets the source to provide the items lazily instead of SetItems.
+func (l *listContent[T]) setItemSource(source *listDataSourceItems[T]) {
+	l.itemSource = source
+	l.hasCheckedItem = false
+	l.expandAnimatingIndexPlus1 = 0
+	l.expandAnimatingChildrenEnd = 0
+	l.expandAnimatingCount = 0
+	clear(l.prevCollapsed)
+
+	l.abstractList.SetItemSource(source)
+	// Invalidate the cached height so that Measure recalculates with the new items.
+	l.widthForCachedHeight = 0
+}
+
 func (l *listContent[T]) SetItems(items []abstractListItem[T]) {
+	l.itemSource = nil
+
 	l.prevCollapsedGeneration++
 	gen := l.prevCollapsedGeneration
 
@@ -1368,23 +1884,27 @@ func (l *listContent[T]) SetItems(items []abstractListItem[T]) {
 		if item.Checked {
 			l.hasCheckedItem = true
 		}
-		prev, ok := l.prevCollapsed[item.Value]
-		if l.onceDraw && ok && prev.collapsed != item.Collapsed {
-			l.expandAnimatingIndexPlus1 = i + 1
-			l.expandAnimatingCount = expandCollapseMaxCount() - l.expandAnimatingCount
-			// Compute children range: all items after i with indent > item's indent,
-			// stopping at the first item with indent <= item's indent.
-			l.expandAnimatingChildrenEnd = len(items)
-			for j := i + 1; j < len(items); j++ {
-				if items[j].IndentLevel <= item.IndentLevel {
-					l.expandAnimatingChildrenEnd = j
-					break
+		// Only tree items can be collapsed.
+		// Other items might share the same values, e.g. the header rows of a table.
+		if item.IndentLevel > 0 {
+			prev, ok := l.prevCollapsed[item.Value]
+			if l.onceDraw && ok && prev.collapsed != item.Collapsed {
+				l.expandAnimatingIndexPlus1 = i + 1
+				l.expandAnimatingCount = expandCollapseMaxCount() - l.expandAnimatingCount
+				// Compute children range: all items after i with indent > item's indent,
+				// stopping at the first item with indent <= item's indent.
+				l.expandAnimatingChildrenEnd = len(items)
+				for j := i + 1; j < len(items); j++ {
+					if items[j].IndentLevel <= item.IndentLevel {
+						l.expandAnimatingChildrenEnd = j
+						break
+					}
 				}
 			}
-		}
-		l.prevCollapsed[item.Value] = collapsedEntry{
-			collapsed:  item.Collapsed,
-			generation: gen,
+			l.prevCollapsed[item.Value] = collapsedEntry{
+				collapsed:  item.Collapsed,
+				generation: gen,
+			}
 		}
 
 		if lastCollapsedIndentLevel > 0 && item.IndentLevel > lastCollapsedIndentLevel {
@@ -1403,6 +1923,9 @@ func (l *listContent[T]) SetItems(items []abstractListItem[T]) {
 				lastCollapsedIndentLevel = 0
 			}
 		}
+		if item.Hidden {
+			items[i].available = false
+		}
 	}
 
 	// Remove stale entries from prevCollapsed.
@@ -1466,6 +1989,26 @@ func (l *listContent[T]) toggleItemSelectionByIndex(index int, forceFireEvents b
 	l.abstractList.ToggleItemSelectionByIndex(index, forceFireEvents)
 }
 
+func (l *listContent[T]) canCopy() bool {
+	return l.abstractList.SelectedItemCount() > 0
+}
+
+func (l *listContent[T]) copy() bool {
+	l.tmpSelectedIndices = l.abstractList.AppendSelectedItemIndices(l.tmpSelectedIndices[:0])
+	if len(l.tmpSelectedIndices) == 0 {
+		return false
+	}
+	records := make([][]string, 0, len(l.tmpSelectedIndices))
+	for _, idx := range l.tmpSelectedIndices {
+		item, ok := l.abstractList.ItemByIndex(idx)
+		if !ok {
+			continue
+		}
+		records = append(records, []string{item.Text})
+	}
+	return copyTSVToClipboard(records)
+}
+
 func (l *listContent[T]) SelectItemByValue(value T) {
 	l.abstractList.SelectItemByValue(value, false)
 }
@@ -1522,7 +2065,7 @@ func (l *listContent[T]) SetStyle(style ListStyle) {
 }
 
 func (l *listContent[T]) calcDropDstIndex(context *guigui.Context) int {
-	_, y := ebiten.CursorPosition()
+	_, y := guigui.CursorPosition()
 	var nonEmptyBoundsFound bool
 	for i := range l.abstractList.ItemCount() {
 		if !l.isItemAvailable(i) {
@@ -1606,9 +2149,14 @@ func (l *listContent[T]) hoveredItemIndex(context *guigui.Context, widgetBounds
 	if !widgetBounds.IsHitAtCursor() {
 		return -1
 	}
-	cp := image.Pt(ebiten.CursorPosition())
+	cp := image.Pt(guigui.CursorPosition())
 	listBounds := widgetBounds.Bounds()
-	for i := range l.abstractList.ItemCount() {
+	// Only the laid-out items can be hovered. Find the first one.
+	hovered := -1
+	for i := range l.itemBoundsForLayoutFromIndex {
+		if hovered >= 0 && i > hovered {
+			continue
+		}
 		if !l.isItemAvailable(i) {
 			continue
 		}
@@ -1616,10 +2164,10 @@ func (l *listContent[T]) hoveredItemIndex(context *guigui.Context, widgetBounds
 		bounds.Min.X = listBounds.Min.X
 		bounds.Max.X = listBounds.Max.X
 		if cp.In(bounds) {
-			return i
+			hovered = i
 		}
 	}
-	return -1
+	return hovered
 }
 
 func (l *listContent[T]) nextSelectableVisibleIndex(from int, forward bool) int {
@@ -1638,10 +2186,27 @@ func (l *listContent[T]) nextSelectableVisibleIndex(from int, forward bool) int
 }
 
 func (l *listContent[T]) HandleButtonInput(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
+	if l.handleTypeAhead(context) {
+		return guigui.HandleInputByWidget(l)
+	}
+
+	if !l.copyDisabled && (!isDarwin() && guigui.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyC) ||
+		isDarwin() && guigui.IsKeyPressed(ebiten.KeyMeta) && isKeyRepeating(ebiten.KeyC)) {
+		if l.copy() {
+			return guigui.HandleInputByWidget(l)
+		}
+	}
+
+	if l.isCheckboxSelection() && guigui.IsKeyJustPressed(ebiten.KeySpace) {
+		if l.toggleSelectedItemsCheckedByUser() {
+			return guigui.HandleInputByWidget(l)
+		}
+	}
+
 	down := isKeyRepeating(ebiten.KeyDown)
 	up := isKeyRepeating(ebiten.KeyUp)
 	if !down && !up {
-		if l.isHoveringVisible() && inpututil.IsKeyJustPressed(ebiten.KeyEnter) {
+		if l.isHoveringVisible() && guigui.IsKeyJustPressed(ebiten.KeyEnter) {
 			if l.selectKeyboardHighlightedItem() {
 				return guigui.HandleInputByWidget(l)
 			}
@@ -1682,9 +2247,78 @@ func (l *listContent[T]) HandleButtonInput(context *guigui.Context, widgetBounds
 	return guigui.HandleInputByWidget(l)
 }
 
+// handleTypeAhead moves the selection, or the keyboard highlight for the menu style,
+// to the next item whose text starts with the typed characters.
+// handleTypeAhead reports whether the typed characters are handled.
+//
+// Type-ahead is not available with a data source, as it would load all the items.
+func (l *listContent[T]) handleTypeAhead(context *guigui.Context) bool {
+	if l.itemSource != nil || l.typeAheadDisabled {
+		return false
+	}
+	l.tmpInputChars = ebiten.AppendInputChars(l.tmpInputChars[:0])
+	if len(l.tmpInputChars) == 0 {
+		return false
+	}
+	continued, ok := l.typeAhead.appendChars(l.tmpInputChars, ebiten.Tick())
+	if !ok {
+		return false
+	}
+
+	var current int
+	if l.isHoveringVisible() {
+		current = l.keyboardHighlightIndexPlus1 - 1
+		if current < 0 {
+			current = l.hoveredItemIndexPlus1 - 1
+		}
+	} else {
+		current = l.abstractList.SelectedItemIndex()
+	}
+
+	query := l.typeAhead.queryString()
+	start := current + 1
+	if l.typeAhead.isRepeatedRune() {
+		// Typing the same character repeatedly cycles the items starting with the character.
+		query = string(l.typeAhead.query[0])
+	} else if continued && current >= 0 {
+		// The current item might still match the longer query.
+		start = current
+	}
+
+	collator := collatorForLocale(context.FirstLocale())
+	next := nextMatchingIndex(l.abstractList.ItemCount(), start, func(index int) bool {
+		if !l.isItemAvailable(index) {
+			return false
+		}
+		item, ok := l.abstractList.ItemByIndex(index)
+		if !ok || item.Unselectable {
+			return false
+		}
+		return hasPrefixCollated(collator, item.Text, query)
+	})
+	if next < 0 {
+		// Consume the characters anyway so that they are not handled by other widgets unexpectedly.
+		return true
+	}
+
+	if l.isHoveringVisible() {
+		l.setKeyboardHighlightIndex(next)
+		l.EnsureItemVisibleByIndex(next)
+		l.updateCheckmarkColor(context)
+		return true
+	}
+	if next != current {
+		l.selectItemByIndex(next, false)
+		l.EnsureItemVisibleByIndex(next)
+		if item, ok := l.abstractList.ItemByIndex(next); ok {
+			context.SetFocused(item.Content, true)
+		}
+	}
+	return true
+}
+
 func (l *listContent[T]) lastSelectableVisibleIndex() int {
-	result := -1
-	for i := range l.abstractList.ItemCount() {
+	for i := l.abstractList.ItemCount() - 1; i >= 0; i-- {
 		if !l.isItemAvailable(i) {
 			continue
 		}
@@ -1692,12 +2326,16 @@ func (l *listContent[T]) lastSelectableVisibleIndex() int {
 		if !ok || item.Unselectable {
 			continue
 		}
-		result = i
+		return i
 	}
-	return result
+	return -1
 }
 
 func (l *listContent[T]) updateCheckmarkColor(context *guigui.Context) {
+	// Items of a data source have no checkmarks.
+	if l.itemSource != nil {
+		return
+	}
 	defaultImg, err := theResourceImages.Get("check", context.ColorMode())
 	if err != nil {
 		panic(fmt.Sprintf("basicwidget: failed to get check image: %v", err))
@@ -1712,7 +2350,8 @@ func (l *listContent[T]) updateCheckmarkColor(context *guigui.Context) {
 			continue
 		}
 		img := defaultImg
-		if l.hoveredItemIndexPlus1 == i+1 {
+		// A checkbox is filled with the accent color when it is checked.
+		if l.hoveredItemIndexPlus1 == i+1 || l.isCheckboxSelection() {
 			img = hoveredImg
 		}
 		l.checkmarks.At(i).SetImage(img)
@@ -1728,10 +2367,11 @@ func (l *listContent[T]) HandlePointingInput(context *guigui.Context, widgetBoun
 		l.dragDstIndexPlus1 = 0
 		l.pressStartPlus1 = image.Point{}
 		l.startPressingIndexPlus1 = 0
+		l.resetMarquee()
 	}
 
 	// Reset keyboard highlight when cursor moves.
-	cursorPos := image.Pt(ebiten.CursorPosition())
+	cursorPos := image.Pt(guigui.CursorPosition())
 	if l.keyboardHighlightIndexPlus1 > 0 && cursorPos != l.lastCursorPosition {
 		l.keyboardHighlightIndexPlus1 = 0
 	}
@@ -1750,20 +2390,8 @@ func (l *listContent[T]) HandlePointingInput(context *guigui.Context, widgetBoun
 
 	// Process dragging.
 	if l.dragSrcIndexPlus1 > 0 {
-		if ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
-			_, y := ebiten.CursorPosition()
-			p := widgetBounds.VisibleBounds().Min
-			h := widgetBounds.VisibleBounds().Dy()
-			var dy float64
-			if upperY := p.Y + UnitSize(context); y < upperY {
-				dy = float64(upperY-y) / 4
-			}
-			if lowerY := p.Y + h - UnitSize(context); y >= lowerY {
-				dy = float64(lowerY-y) / 4
-			}
-			if dy != 0 {
-				l.listPanel.forceSetScrollOffsetByDelta(0, dy)
-			}
+		if guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
+			l.listPanel.scrollByCursorNearEdges(context, widgetBounds.VisibleBounds())
 			if i := l.calcDropDstIndex(context); l.dragDstIndexPlus1-1 != i {
 				droppable := true
 				l.tmpSelectedIndices = l.abstractList.AppendSelectedItemIndices(l.tmpSelectedIndices[:0])
@@ -1801,15 +2429,29 @@ func (l *listContent[T]) HandlePointingInput(context *guigui.Context, widgetBoun
 		return guigui.HandleInputByWidget(l)
 	}
 
+	// Process the marquee selection.
+	if result, ok := l.handleMarquee(context, widgetBounds); ok {
+		return result
+	}
+
 	if index := l.hoveredItemIndexPlus1 - 1; index >= 0 && index < l.abstractList.ItemCount() {
-		c := image.Pt(ebiten.CursorPosition())
+		c := image.Pt(guigui.CursorPosition())
 
-		left := inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft)
-		right := inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonRight)
+		left := guigui.IsMouseButtonJustPressed(ebiten.MouseButtonLeft)
+		right := guigui.IsMouseButtonJustPressed(ebiten.MouseButtonRight)
 		switch {
 		case (left || right):
 			item, _ := l.abstractList.ItemByIndex(index)
-			if c.X < l.itemBoundsForLayoutFromIndex[index].Min.X {
+			// Toggling a checkbox doesn't change the selection or the focus.
+			if l.isCheckboxHitAt(context, index, c) && !item.Unselectable {
+				if left {
+					l.toggleItemCheckedByUser(index)
+				}
+				l.pressStartPlus1 = image.Point{}
+				l.startPressingIndexPlus1 = 0
+				return guigui.AbortHandlingInputByWidget(l)
+			}
+			if !l.treeInContent && c.X < l.itemBoundsForLayoutFromIndex[index].Min.X {
 				if left {
 					expanded := !item.Collapsed
 					guigui.DispatchEvent(l, listEventItemExpanderToggled, index, !expanded)
@@ -1836,10 +2478,10 @@ func (l *listContent[T]) HandlePointingInput(context *guigui.Context, widgetBoun
 			}
 
 			if l.style == ListStyleNormal && l.abstractList.MultiSelection() {
-				if ebiten.IsKeyPressed(ebiten.KeyShift) {
+				if guigui.IsKeyPressed(ebiten.KeyShift) {
 					l.extendItemSelectionByIndex(index, false)
-				} else if !isDarwin() && ebiten.IsKeyPressed(ebiten.KeyControl) ||
-					isDarwin() && ebiten.IsKeyPressed(ebiten.KeyMeta) {
+				} else if !isDarwin() && guigui.IsKeyPressed(ebiten.KeyControl) ||
+					isDarwin() && guigui.IsKeyPressed(ebiten.KeyMeta) {
 					l.toggleItemSelectionByIndex(index, false)
 				} else if !l.abstractList.IsSelectedItemIndex(index) {
 					l.selectItemByIndex(index, false)
@@ -1853,21 +2495,33 @@ func (l *listContent[T]) HandlePointingInput(context *guigui.Context, widgetBoun
 				l.selectItemByIndex(index, l.style == ListStyleMenu)
 			}
 
+			l.dispatchItemClicked(widgetBounds, index)
+
 			if left {
 				l.pressStartPlus1 = c.Add(image.Pt(1, 1))
 				l.startPressingIndexPlus1 = index + 1
+				// Dragging a movable item moves the items instead of selecting items by a marquee.
+				if l.canMarqueeSelect() && !item.Movable {
+					l.startMarquee(context, widgetBounds)
+				}
 				return guigui.HandleInputByWidget(l)
 			}
 			// For the right click, give a chance to a parent widget to handle the right click e.g. to open a context menu.
 			// TODO: This behavior seems a little ad-hoc. Consider a better way.
 			return guigui.HandleInputResult{}
 
-		case ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft):
-			if ebiten.IsKeyPressed(ebiten.KeyShift) {
+		case guigui.IsMouseButtonJustPressed(ebiten.MouseButtonMiddle):
+			if item, ok := l.abstractList.ItemByIndex(index); ok && !item.Unselectable && c.X >= l.itemBoundsForLayoutFromIndex[index].Min.X {
+				l.dispatchItemClicked(widgetBounds, index)
+			}
+			return guigui.HandleInputResult{}
+
+		case guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft):
+			if guigui.IsKeyPressed(ebiten.KeyShift) {
 				return guigui.AbortHandlingInputByWidget(l)
 			}
-			if !isDarwin() && ebiten.IsKeyPressed(ebiten.KeyControl) ||
-				isDarwin() && ebiten.IsKeyPressed(ebiten.KeyMeta) {
+			if !isDarwin() && guigui.IsKeyPressed(ebiten.KeyControl) ||
+				isDarwin() && guigui.IsKeyPressed(ebiten.KeyMeta) {
 				return guigui.AbortHandlingInputByWidget(l)
 			}
 			if l.startPressingIndexPlus1 == 0 {
@@ -1903,12 +2557,12 @@ func (l *listContent[T]) HandlePointingInput(context *guigui.Context, widgetBoun
 			}
 			return guigui.AbortHandlingInputByWidget(l)
 
-		case inpututil.IsMouseButtonJustReleased(ebiten.MouseButtonLeft):
+		case guigui.IsMouseButtonJustReleased(ebiten.MouseButtonLeft):
 			// For the multi selection, the index is updated when the user releases the mouse button.
 			if l.style == ListStyleNormal && l.abstractList.MultiSelection() && l.startPressingIndexPlus1 > 0 && l.dragSrcIndexPlus1 == 0 {
-				if !ebiten.IsKeyPressed(ebiten.KeyShift) &&
-					!(!isDarwin() && ebiten.IsKeyPressed(ebiten.KeyControl)) &&
-					!(isDarwin() && ebiten.IsKeyPressed(ebiten.KeyMeta)) {
+				if !guigui.IsKeyPressed(ebiten.KeyShift) &&
+					!(!isDarwin() && guigui.IsKeyPressed(ebiten.KeyControl)) &&
+					!(isDarwin() && guigui.IsKeyPressed(ebiten.KeyMeta)) {
 					l.selectItemByIndex(l.startPressingIndexPlus1-1, false)
 					l.pressStartPlus1 = image.Point{}
 					l.startPressingIndexPlus1 = 0
@@ -1921,6 +2575,13 @@ func (l *listContent[T]) HandlePointingInput(context *guigui.Context, widgetBoun
 		}
 	}
 
+	// Pressing the empty area starts a marquee.
+	if l.canMarqueeSelect() && guigui.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) && widgetBounds.IsHitAtCursor() {
+		context.SetFocused(l, true)
+		l.startMarquee(context, widgetBounds)
+		return guigui.HandleInputByWidget(l)
+	}
+
 	l.dragSrcIndexPlus1 = 0
 	l.pressStartPlus1 = image.Point{}
 	return guigui.HandleInputResult{}
@@ -1944,6 +2605,14 @@ func (l *listContent[T]) Tick(context *guigui.Context, widgetBounds *guigui.Widg
 		l.jumpTick = 0
 	}
 
+	// Dispatch the end-reached event once per item count while the end of the list is in the viewport.
+	if !l.listPanel.reachesBottom {
+		l.endReachedItemCountPlus1 = 0
+	} else if n := l.abstractList.ItemCount(); l.endReachedItemCountPlus1 != n+1 {
+		l.endReachedItemCountPlus1 = n + 1
+		guigui.DispatchEvent(l, listEventEndReached)
+	}
+
 	// Advance expand/collapse animation.
 	if l.expandAnimatingCount > 0 {
 		l.expandAnimatingCount--
@@ -1962,6 +2631,12 @@ func (l *listContent[T]) Draw(context *guigui.Context, widgetBounds *guigui.Widg
 
 // availableIndexForItemIndex converts a raw item index to the position in the available items list.
 func (l *listContent[T]) availableIndexForItemIndex(itemIndex int) int {
+	if l.itemSource != nil {
+		if !l.isItemAvailable(itemIndex) {
+			return -1
+		}
+		return itemIndex
+	}
 	var ai int
 	for i := range l.abstractList.ItemCount() {
 		if !l.isItemAvailable(i) {
@@ -2004,11 +2679,11 @@ func (l *listContent[T]) scrollToEnsureItemVisible(context *guigui.Context, widg
 		cw = l.contentWidthPlus1 - 1
 	}
 
-	l.tmpAvailableIndices = l.appendAvailableIndices(l.tmpAvailableIndices[:0])
-	availableIndices := l.tmpAvailableIndices
+	l.updateAvailableIndices()
+	availableCount := l.availableItemCount()
 	y := topOff + RoundedCornerRadius(context)
-	for aIdx := topIdx; aIdx <= ai && aIdx < len(availableIndices); aIdx++ {
-		h := l.measureItemHeightWithContentWidth(context, availableIndices[aIdx], cw)
+	for aIdx := topIdx; aIdx <= ai && aIdx < availableCount; aIdx++ {
+		h := l.measureItemHeightWithContentWidth(context, l.itemIndexFromAvailableIndex(aIdx), cw)
 		if aIdx == ai {
 			// Check if the bottom of this item is below the viewport.
 			itemBottom := y + h
@@ -2061,10 +2736,10 @@ func (l *listContent[T]) adjustItemY(context *guigui.Context, y int) int {
 }
 
 func (l *listContent[T]) itemBounds(context *guigui.Context, index int) image.Rectangle {
-	if index < 0 || index >= len(l.itemBoundsForLayoutFromIndex) {
+	r, ok := l.itemBoundsForLayoutFromIndex[index]
+	if !ok {
 		return image.Rectangle{}
 	}
-	r := l.itemBoundsForLayoutFromIndex[index]
 	if l.hasCheckmarkColumn() {
 		r.Min.X -= listItemCheckmarkSize(context) + listItemTextAndImagePadding(context)
 	}
@@ -2139,7 +2814,21 @@ func (l *listBackground1[T]) Draw(context *guigui.Context, widgetBounds *guigui.
 		basicwidgetdraw.DrawRoundedRect(context, dst, bounds, clr, RoundedCornerRadius(context))
 	}
 
-	if l.content.stripeVisible && l.content.abstractList.ItemCount() > 0 {
+	if l.content.stripeVisible && l.content.itemSource != nil {
+		// All the items of a data source are available. Draw only the laid-out items.
+		vb := widgetBounds.VisibleBounds()
+		for i := range l.content.itemBoundsForLayoutFromIndex {
+			if i%2 == 0 {
+				continue
+			}
+			bounds := l.content.itemBounds(context, i)
+			if !bounds.Overlaps(vb) {
+				continue
+			}
+			clr := basicwidgetdraw.ControlSecondaryColor(context.ColorMode(), context.IsEnabled(l))
+			basicwidgetdraw.DrawRoundedRect(context, dst, bounds, clr, RoundedCornerRadius(context))
+		}
+	} else if l.content.stripeVisible && l.content.abstractList.ItemCount() > 0 {
 		vb := widgetBounds.VisibleBounds()
 		// Draw item stripes.
 		// TODO: Get indices of items that are visible.
@@ -2155,7 +2844,7 @@ func (l *listBackground1[T]) Draw(context *guigui.Context, widgetBounds *guigui.
 			bounds := l.content.itemBounds(context, i)
 			// Reset the X position to ignore indentation.
 			item, _ := l.content.abstractList.ItemByIndex(i)
-			bounds.Min.X -= ListItemIndentSize(context, item.IndentLevel)
+			bounds.Min.X -= l.content.itemIndentSize(context, item.IndentLevel)
 			if bounds.Min.Y > vb.Max.Y {
 				break
 			}
@@ -2168,6 +2857,33 @@ func (l *listBackground1[T]) Draw(context *guigui.Context, widgetBounds *guigui.
 	}
 }
 
+// listStickyHeaderBackground is the background of the header item sticking to the top of the viewport.
+type listStickyHeaderBackground[T comparable] struct {
+	guigui.DefaultWidget
+
+	content *listContent[T]
+}
+
+func (l *listStickyHeaderBackground[T]) setListContent(content *listContent[T]) {
+	l.content = content
+}
+
+func (l *listStickyHeaderBackground[T]) Draw(context *guigui.Context, widgetBounds *guigui.WidgetBounds, dst *ebiten.Image) {
+	var clr color.Color
+	switch l.content.style {
+	case ListStyleSidebar:
+		clr = basicwidgetdraw.BackgroundSecondaryColor(context.ColorMode())
+	case ListStyleNormal:
+		clr = basicwidgetdraw.ControlColor(context.ColorMode(), context.IsEnabled(l))
+	case ListStyleMenu:
+		clr = basicwidgetdraw.ControlSecondaryColor(context.ColorMode(), context.IsEnabled(l))
+	}
+	if clr == nil {
+		return
+	}
+	dst.Fill(clr)
+}
+
 type listBackground2[T comparable] struct {
 	guigui.DefaultWidget
 
@@ -2239,6 +2955,33 @@ func (l *listBackground2[T]) Draw(context *guigui.Context, widgetBounds *guigui.
 		}
 	}
 
+	// Draw the checkboxes.
+	if l.content.isCheckboxSelection() {
+		cm := context.ColorMode()
+		enabled := context.IsEnabled(l)
+		for index := range l.content.itemBoundsForLayoutFromIndex {
+			item, ok := l.content.abstractList.ItemByIndex(index)
+			if !ok || item.Unselectable || !l.content.isItemAvailable(index) {
+				continue
+			}
+			bounds := l.content.checkmarkBounds(context, index)
+			if !bounds.Overlaps(vb) {
+				continue
+			}
+			var clr, borderClr1, borderClr2 color.Color
+			if item.Checked && enabled {
+				clr = draw.Color(cm, draw.SemanticColorAccent, 0.5)
+				borderClr1, borderClr2 = basicwidgetdraw.BorderAccentSecondaryColors(cm, basicwidgetdraw.RoundedRectBorderTypeInset)
+			} else {
+				clr = basicwidgetdraw.ControlColor(cm, enabled)
+				borderClr1, borderClr2 = basicwidgetdraw.BorderColors(cm, basicwidgetdraw.RoundedRectBorderTypeInset)
+			}
+			r := UnitSize(context) / 8
+			basicwidgetdraw.DrawRoundedRect(context, dst, bounds, clr, r)
+			basicwidgetdraw.DrawRoundedRectBorder(context, dst, bounds, borderClr1, borderClr2, r, float32(1*context.Scale()), basicwidgetdraw.RoundedRectBorderTypeInset)
+		}
+	}
+
 	// Draw a drag indicator.
 	if context.IsEnabled(l) && l.content.dragSrcIndexPlus1 == 0 {
 		if item, ok := l.content.abstractList.ItemByIndex(hoveredItemIndex); ok && item.Movable && item.selectable() {
@@ -2277,7 +3020,7 @@ func (l *listBackground2[T]) Draw(context *guigui.Context, widgetBounds *guigui.
 		adjustY := l.content.adjustItemY(context, 0)
 		var y float32
 		var ok bool
-		if dstIdx < len(l.content.itemBoundsForLayoutFromIndex) {
+		if dstIdx < l.content.abstractList.ItemCount() {
 			if item, itemOk := l.content.abstractList.ItemByIndex(dstIdx); itemOk {
 				y = float32(l.content.itemBoundsForLayoutFromIndex[dstIdx].Min.Y - item.Padding.Top - adjustY)
 				ok = true
@@ -2293,6 +3036,13 @@ func (l *listBackground2[T]) Draw(context *guigui.Context, widgetBounds *guigui.
 			vector.StrokeLine(dst, x0, y, x1, y, 2*float32(context.Scale()), draw.Color(context.ColorMode(), draw.SemanticColorAccent, 0.5), false)
 		}
 	}
+
+	// Draw a marquee.
+	if b, ok := l.content.marqueeBounds(context, widgetBounds.Bounds()); ok && !b.Empty() {
+		clr := draw.Color(context.ColorMode(), draw.SemanticColorAccent, 0.5)
+		vector.FillRect(dst, float32(b.Min.X), float32(b.Min.Y), float32(b.Dx()), float32(b.Dy()), draw.ScaleAlpha(clr, 0.25), false)
+		vector.StrokeRect(dst, float32(b.Min.X), float32(b.Min.Y), float32(b.Dx()), float32(b.Dy()), float32(context.Scale()), clr, false)
+	}
 }
 
 type listFrame struct {
@@ -2398,6 +3148,14 @@ func listItemTextAndImagePadding(context *guigui.Context) int {
 	return UnitSize(context) / 8
 }
 
+// itemIndentSize returns the size of the indent of an item at the given level.
+func (l *listContent[T]) itemIndentSize(context *guigui.Context, level int) int {
+	if l.treeInContent {
+		return 0
+	}
+	return ListItemIndentSize(context, level)
+}
+
 func ListItemIndentSize(context *guigui.Context, level int) int {
 	if level == 0 {
 		return 0
@@ -2408,3 +3166,20 @@ func ListItemIndentSize(context *guigui.Context, level int) int {
 func listBorderWidth(context *guigui.Context) float32 {
 	return float32(1 * context.Scale())
 }
+
+// copyTSVToClipboard writes the records to the clipboard as TSV, which spreadsheets can paste.
+// The last line break is omitted so that a single value can be pasted into a text field as it is.
+func copyTSVToClipboard(records [][]string) bool {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Comma = '\t'
+	if err := w.WriteAll(records); err != nil {
+		slog.Error(err.Error())
+		return false
+	}
+	if err := clipboard.WriteAll(bytes.TrimSuffix(buf.Bytes(), []byte("\n"))); err != nil {
+		slog.Error(err.Error())
+		return false
+	}
+	return true
+}
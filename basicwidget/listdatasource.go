@@ -0,0 +1,212 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package basicwidget
+
+import (
+	"github.com/guigui-gui/guigui"
+)
+
+// ListDataSource provides the items of a [List] lazily.
+//
+// A list with a data source queries only the items around the viewport,
+// so a data source can have millions of items without materializing them up front.
+//
+// IndentLevel, Collapsed, Checked and Movable of the items are ignored.
+type ListDataSource[T comparable] interface {
+	// ItemCount returns the number of the items.
+	ItemCount() int
+
+	// ItemAt returns the item at the given index.
+	//
+	// ItemAt returns false if the item is not available yet, e.g. while the item is being loaded asynchronously.
+	// Then a placeholder row is shown instead.
+	// ItemAt is called for the items around the viewport every tick,
+	// so the placeholder row is replaced with the item once ItemAt returns true.
+	ItemAt(index int) (ListItem[T], bool)
+}
+
+// ItemHeightProvider is an optional interface for [ListDataSource] and [TableDataSource].
+//
+// If a data source implements ItemHeightProvider, the items are laid out with the given heights instead of the measured heights,
+// and the list computes the scroll bar without loading and measuring the items out of the viewport.
+type ItemHeightProvider interface {
+	// ItemHeightAt returns the height of the item at the given index in pixels, including the padding.
+	// ItemHeightAt returns a negative value if the height is unknown, and then the item is measured.
+	ItemHeightAt(context *guigui.Context, index int) int
+}
+
+// ValueIndexProvider is an optional interface for [ListDataSource], [TableDataSource] and [GridViewDataSource].
+//
+// If a data source implements ValueIndexProvider, the list uses it to look up the items by values,
+// e.g. at [List.SelectItemByValue].
+// Otherwise, only the items already queried by the list, e.g. the items around the viewport, are searched,
+// so that looking up a value doesn't load all the items.
+type ValueIndexProvider[T comparable] interface {
+	// IndexByValue returns the index of the item with the given value, or -1 if there is no such item.
+	IndexByValue(value T) int
+}
+
+// listDataSourceReleaser is implemented by internal data sources that have resources for each item.
+type listDataSourceReleaser interface {
+	releaseItem(index int)
+}
+
+// minListDataSourceEntryCount is the minimum number of the cached entries of a data source.
+const minListDataSourceEntryCount = 256
+
+type listDataSourceEntry[T comparable] struct {
+	widget *listItemWidget[T]
+	item   abstractListItem[T]
+	loaded bool
+}
+
+// listDataSourceItems adapts a [ListDataSource] to the items of a list.
+// The item widgets are created only for the items queried by the list, and are reused.
+type listDataSourceItems[T comparable] struct {
+	dataSource  ListDataSource[T]
+	entries     map[int]*listDataSourceEntry[T]
+	freeWidgets []*listItemWidget[T]
+
+	list *List[T]
+}
+
+func (l *listDataSourceItems[T]) setDataSource(dataSource ListDataSource[T], list *List[T]) {
+	// The cached items are updated with a new data source at refresh.
+	if dataSource == nil {
+		l.releaseAll()
+	}
+	l.dataSource = dataSource
+	l.list = list
+}
+
+func (l *listDataSourceItems[T]) releaseAll() {
+	for i := range l.entries {
+		l.release(i)
+	}
+}
+
+func (l *listDataSourceItems[T]) release(index int) {
+	e, ok := l.entries[index]
+	if !ok {
+		return
+	}
+	delete(l.entries, index)
+	l.freeWidgets = append(l.freeWidgets, e.widget)
+	if r, ok := l.dataSource.(listDataSourceReleaser); ok {
+		r.releaseItem(index)
+	}
+}
+
+func (l *listDataSourceItems[T]) itemCount() int {
+	if l.dataSource == nil {
+		return 0
+	}
+	return l.dataSource.ItemCount()
+}
+
+func (l *listDataSourceItems[T]) itemAt(index int) abstractListItem[T] {
+	if e, ok := l.entries[index]; ok {
+		return e.item
+	}
+
+	e := &listDataSourceEntry[T]{}
+	if n := len(l.freeWidgets); n > 0 {
+		e.widget = l.freeWidgets[n-1]
+		l.freeWidgets[n-1] = nil
+		l.freeWidgets = l.freeWidgets[:n-1]
+	} else {
+		e.widget = &listItemWidget[T]{}
+	}
+	if l.entries == nil {
+		l.entries = map[int]*listDataSourceEntry[T]{}
+	}
+	l.entries[index] = e
+	l.updateEntry(index, e)
+	return e.item
+}
+
+func (l *listDataSourceItems[T]) updateEntry(index int, entry *listDataSourceEntry[T]) {
+	item, ok := l.dataSource.ItemAt(index)
+	if !ok {
+		item = ListItem[T]{}
+	}
+	// Tree structures, checkmarks and moving items are not supported.
+	item.IndentLevel = 0
+	item.Collapsed = false
+	item.Checked = false
+	item.Movable = false
+
+	entry.loaded = ok
+	w := entry.widget
+	w.setListItem(item)
+	w.setPlaceholder(!ok)
+	w.setHeight(l.list.listItemHeightPlus1 - 1)
+	w.setStyle(l.list.content.Style())
+	entry.item = abstractListItem[T]{
+		Content:      w,
+		Text:         item.Text,
+		Header:       item.Header,
+		Unselectable: !item.selectable(),
+		Value:        item.Value,
+		Padding:      item.Padding,
+		index:        index,
+		available:    true,
+		listContent:  &l.list.content,
+	}
+}
+
+// refresh queries the cached items again, so that the loaded items replace the placeholders.
+func (l *listDataSourceItems[T]) refresh() {
+	n := l.itemCount()
+	for i, e := range l.entries {
+		if i >= n {
+			l.release(i)
+			continue
+		}
+		l.updateEntry(i, e)
+	}
+}
+
+// retainItems releases the cached items out of [lo, hi) if there are too many cached items.
+// The item at keep is not released, as its widget might have the focus.
+func (l *listDataSourceItems[T]) retainItems(lo, hi int, keep int) {
+	if len(l.entries) <= max(16*(hi-lo), minListDataSourceEntryCount) {
+		return
+	}
+	for i := range l.entries {
+		if i >= lo && i < hi || i == keep {
+			continue
+		}
+		l.release(i)
+	}
+}
+
+func (l *listDataSourceItems[T]) indexByValue(value T) int {
+	if l.dataSource == nil {
+		return -1
+	}
+	if p, ok := l.dataSource.(ValueIndexProvider[T]); ok {
+		return p.IndexByValue(value)
+	}
+	// Search only the cached items. Querying all the items might load every page of the data source.
+	idx := -1
+	for i, e := range l.entries {
+		if !e.loaded || e.item.Value != value {
+			continue
+		}
+		if idx < 0 || i < idx {
+			idx = i
+		}
+	}
+	return idx
+}
+
+// itemHeightAt returns the height of the item given by the data source, or -1 if the height is unknown.
+func (l *listDataSourceItems[T]) itemHeightAt(context *guigui.Context, index int) int {
+	p, ok := l.dataSource.(ItemHeightProvider)
+	if !ok {
+		return -1
+	}
+	return p.ItemHeightAt(context, index)
+}
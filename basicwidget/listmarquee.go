@@ -0,0 +1,203 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package basicwidget
+
+import (
+	"image"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/guigui-gui/guigui"
+)
+
+// listMarqueePoint is a vertical position in a list, which is stable while the list is scrolled.
+type listMarqueePoint struct {
+	// index is the index of the first item whose bottom is below the position, or the item count if there is no such item.
+	index int
+
+	// offsetY is the offset of the position from the top of the item at index.
+	// If index is the item count, offsetY is the offset from the bottom of the last item.
+	offsetY int
+}
+
+func (l listMarqueePoint) less(other listMarqueePoint) bool {
+	if l.index != other.index {
+		return l.index < other.index
+	}
+	return l.offsetY < other.offsetY
+}
+
+// appendListMarqueeItemIndices appends the indices of the available items between the two points to indices.
+func appendListMarqueeItemIndices(indices []int, p0, p1 listMarqueePoint, count int, available func(index int) bool) []int {
+	if p1.less(p0) {
+		p0, p1 = p1, p0
+	}
+	for i := p0.index; i <= p1.index && i < count; i++ {
+		// The last point might be above the last item, e.g. in the padding between items.
+		if i == p1.index && p1.offsetY < 0 {
+			break
+		}
+		if !available(i) {
+			continue
+		}
+		indices = append(indices, i)
+	}
+	return indices
+}
+
+// listMarquee is the state of the rubber-band selection of a list.
+type listMarquee struct {
+	// pressed reports whether the left mouse button is pressed to start a marquee.
+	pressed bool
+
+	// active reports whether the marquee is being dragged.
+	// A marquee becomes active when the cursor moves a little after pressing.
+	active bool
+
+	start  image.Point
+	anchor listMarqueePoint
+
+	// anchorX is the X position of the anchor relative to the content, which can be scrolled horizontally.
+	anchorX int
+
+	// baseIndices is the selected indices that are kept while the marquee is dragged, e.g. with the Shift key.
+	baseIndices []int
+}
+
+// canMarqueeSelect reports whether the user can select items by dragging a marquee.
+func (l *listContent[T]) canMarqueeSelect() bool {
+	return l.style == ListStyleNormal && l.abstractList.MultiSelection()
+}
+
+// startMarquee starts a marquee at the cursor position.
+// The marquee becomes active when the cursor moves.
+func (l *listContent[T]) startMarquee(context *guigui.Context, widgetBounds *guigui.WidgetBounds) {
+	c := image.Pt(guigui.CursorPosition())
+	l.marquee.pressed = true
+	l.marquee.active = false
+	l.marquee.start = c
+	l.marquee.anchor = l.marqueePointAt(context, c.Y)
+	l.marquee.anchorX = c.X - widgetBounds.Bounds().Min.X
+	l.marquee.baseIndices = l.marquee.baseIndices[:0]
+}
+
+func (l *listContent[T]) resetMarquee() {
+	l.marquee.pressed = false
+	l.marquee.active = false
+	l.marquee.baseIndices = l.marquee.baseIndices[:0]
+}
+
+// handleMarquee processes the marquee while the left mouse button is pressed.
+// handleMarquee reports false if the input should be handled in the usual way.
+func (l *listContent[T]) handleMarquee(context *guigui.Context, widgetBounds *guigui.WidgetBounds) (guigui.HandleInputResult, bool) {
+	if !l.marquee.pressed {
+		return guigui.HandleInputResult{}, false
+	}
+
+	if !guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
+		active := l.marquee.active
+		l.resetMarquee()
+		if !active {
+			return guigui.HandleInputResult{}, false
+		}
+		guigui.RequestRedraw(l)
+		return guigui.HandleInputByWidget(l), true
+	}
+
+	c := image.Pt(guigui.CursorPosition())
+	if !l.marquee.active {
+		d := c.Sub(l.marquee.start)
+		if threshold := UnitSize(context) / 4; max(d.X, -d.X) < threshold && max(d.Y, -d.Y) < threshold {
+			return guigui.HandleInputResult{}, false
+		}
+		l.marquee.active = true
+		l.pressStartPlus1 = image.Point{}
+		l.startPressingIndexPlus1 = 0
+		if guigui.IsKeyPressed(ebiten.KeyShift) ||
+			!isDarwin() && guigui.IsKeyPressed(ebiten.KeyControl) ||
+			isDarwin() && guigui.IsKeyPressed(ebiten.KeyMeta) {
+			l.marquee.baseIndices = l.abstractList.AppendSelectedItemIndices(l.marquee.baseIndices[:0])
+		}
+	}
+
+	l.listPanel.scrollByCursorNearEdges(context, widgetBounds.VisibleBounds())
+
+	vb := widgetBounds.VisibleBounds()
+	y := min(max(c.Y, vb.Min.Y), vb.Max.Y-1)
+	l.tmpSelectedIndices = append(l.tmpSelectedIndices[:0], l.marquee.baseIndices...)
+	l.tmpSelectedIndices = appendListMarqueeItemIndices(l.tmpSelectedIndices, l.marquee.anchor, l.marqueePointAt(context, y), l.abstractList.ItemCount(), l.isItemAvailable)
+	l.abstractList.SelectItemsByIndices(l.tmpSelectedIndices, false)
+	guigui.RequestRedraw(l)
+	return guigui.HandleInputByWidget(l), true
+}
+
+// marqueePointAt returns the point at the Y position in the laid-out items.
+func (l *listContent[T]) marqueePointAt(context *guigui.Context, y int) listMarqueePoint {
+	index := -1
+	var bounds image.Rectangle
+	for i := range l.itemBoundsForLayoutFromIndex {
+		if index >= 0 && i > index {
+			continue
+		}
+		if !l.isItemAvailable(i) || i == l.stickyHeaderIndexPlus1-1 {
+			continue
+		}
+		if b := l.itemBounds(context, i); y < b.Max.Y {
+			index = i
+			bounds = b
+		}
+	}
+	if index >= 0 {
+		return listMarqueePoint{
+			index:   index,
+			offsetY: y - bounds.Min.Y,
+		}
+	}
+	return listMarqueePoint{
+		index:   l.abstractList.ItemCount(),
+		offsetY: y - l.lastItemBottom(context),
+	}
+}
+
+// lastItemBottom returns the bottom of the last available item, or the top of the content if there is no item.
+// If the last item is not laid out, lastItemBottom returns the bottom of the visible bounds.
+func (l *listContent[T]) lastItemBottom(context *guigui.Context) int {
+	last, ok := l.prevAvailableItem(l.abstractList.ItemCount())
+	if !ok {
+		return l.visibleBounds.Min.Y
+	}
+	if _, ok := l.itemBoundsForLayoutFromIndex[last]; !ok {
+		return l.visibleBounds.Max.Y
+	}
+	return l.itemBounds(context, last).Max.Y
+}
+
+// marqueeY returns the current Y position of the point.
+// If the point is out of the laid-out items, marqueeY returns the top or the bottom of the visible bounds.
+func (l *listContent[T]) marqueeY(context *guigui.Context, point listMarqueePoint) int {
+	if point.index >= l.abstractList.ItemCount() {
+		return l.lastItemBottom(context) + point.offsetY
+	}
+	if _, ok := l.itemBoundsForLayoutFromIndex[point.index]; ok && point.index != l.stickyHeaderIndexPlus1-1 {
+		return l.itemBounds(context, point.index).Min.Y + point.offsetY
+	}
+	// The point is above the viewport unless it is after all the laid-out items.
+	// The sticky header is not taken into account, as it is before the other laid-out items.
+	for i := range l.itemBoundsForLayoutFromIndex {
+		if i > point.index {
+			return l.visibleBounds.Min.Y
+		}
+	}
+	return l.visibleBounds.Max.Y
+}
+
+// marqueeBounds returns the bounds of the active marquee in the visible bounds.
+func (l *listContent[T]) marqueeBounds(context *guigui.Context, bounds image.Rectangle) (image.Rectangle, bool) {
+	if !l.marquee.active {
+		return image.Rectangle{}, false
+	}
+	c := image.Pt(guigui.CursorPosition())
+	p := image.Pt(bounds.Min.X+l.marquee.anchorX, l.marqueeY(context, l.marquee.anchor))
+	return image.Rectangle{Min: p, Max: c}.Canon().Intersect(l.visibleBounds), true
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package basicwidget_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/guigui-gui/guigui/basicwidget"
+)
+
+func TestListMarqueeItemIndices(t *testing.T) {
+	all := func(index int) bool {
+		return true
+	}
+	odd := func(index int) bool {
+		return index%2 == 1
+	}
+	testCases := []struct {
+		name      string
+		index0    int
+		offsetY0  int
+		index1    int
+		offsetY1  int
+		count     int
+		available func(index int) bool
+		out       []int
+	}{
+		{name: "same item", index0: 2, offsetY0: 1, index1: 2, offsetY1: 5, count: 5, available: all, out: []int{2}},
+		{name: "downward", index0: 1, offsetY0: 3, index1: 3, offsetY1: 0, count: 5, available: all, out: []int{1, 2, 3}},
+		{name: "upward", index0: 3, offsetY0: 0, index1: 1, offsetY1: 3, count: 5, available: all, out: []int{1, 2, 3}},
+		{name: "above the last item", index0: 1, offsetY0: 3, index1: 3, offsetY1: -2, count: 5, available: all, out: []int{1, 2}},
+		{name: "in the same padding", index0: 2, offsetY0: -4, index1: 2, offsetY1: -1, count: 5, available: all, out: nil},
+		{name: "after the last item", index0: 3, offsetY0: 0, index1: 5, offsetY1: 10, count: 5, available: all, out: []int{3, 4}},
+		{name: "both after the last item", index0: 5, offsetY0: 1, index1: 5, offsetY1: 10, count: 5, available: all, out: nil},
+		{name: "unavailable items", index0: 0, offsetY0: 0, index1: 4, offsetY1: 0, count: 5, available: odd, out: []int{1, 3}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := basicwidget.ListMarqueeItemIndices(tc.index0, tc.offsetY0, tc.index1, tc.offsetY1, tc.count, tc.available)
+			if !slices.Equal(got, tc.out) {
+				t.Errorf("got: %v, want: %v", got, tc.out)
+			}
+		})
+	}
+}
@@ -8,7 +8,6 @@ import (
 	"slices"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
 
 	"github.com/guigui-gui/guigui"
 	"github.com/guigui-gui/guigui/basicwidget/basicwidgetdraw"
@@ -295,7 +294,7 @@ func (t *menubarTitle[T]) HandlePointingInput(context *guigui.Context, widgetBou
 	}
 
 	// Click: toggle this title's popup.
-	if inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
+	if guigui.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
 		if t.isOpen() {
 			t.menubar.requestOpen(-1)
 		} else {
@@ -0,0 +1,211 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package basicwidget
+
+import (
+	"math/big"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// numberSymbols is a set of separators to format a number.
+type numberSymbols struct {
+	decimal string
+	group   string
+}
+
+var defaultNumberSymbols = numberSymbols{
+	decimal: ".",
+	group:   ",",
+}
+
+var numberSymbolsCache = map[language.Tag]numberSymbols{}
+
+// numberSymbolsForLocale returns the decimal and grouping separators for the locale.
+func numberSymbolsForLocale(locale language.Tag) numberSymbols {
+	if locale == (language.Tag{}) {
+		return defaultNumberSymbols
+	}
+	if s, ok := numberSymbolsCache[locale]; ok {
+		return s
+	}
+
+	// golang.org/x/text doesn't expose the symbols directly.
+	// Format a sample number and pick the separators from it.
+	str := message.NewPrinter(locale).Sprint(number.Decimal(1234567.5, number.MinFractionDigits(1)))
+	var seps []string
+	var current strings.Builder
+	for _, r := range str {
+		if unicode.IsDigit(r) {
+			if current.Len() > 0 {
+				seps = append(seps, current.String())
+				current.Reset()
+			}
+			continue
+		}
+		current.WriteRune(r)
+	}
+
+	s := defaultNumberSymbols
+	switch len(seps) {
+	case 0:
+	case 1:
+		s.decimal = seps[0]
+		s.group = ""
+	default:
+		s.group = seps[0]
+		s.decimal = seps[len(seps)-1]
+	}
+	numberSymbolsCache[locale] = s
+	return s
+}
+
+func pow10BigInt(n int) *big.Int {
+	return (&big.Int{}).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// formatFixedPoint formats value scaled by 10^fractionDigits as a decimal number.
+func formatFixedPoint(value *big.Int, fractionDigits int, symbols numberSymbols, grouping bool) string {
+	digits := (&big.Int{}).Abs(value).Text(10)
+	if len(digits) <= fractionDigits {
+		digits = strings.Repeat("0", fractionDigits-len(digits)+1) + digits
+	}
+	intPart := digits[:len(digits)-fractionDigits]
+	fracPart := digits[len(digits)-fractionDigits:]
+
+	var sb strings.Builder
+	if value.Sign() < 0 {
+		sb.WriteByte('-')
+	}
+	if grouping && symbols.group != "" {
+		for i := 0; i < len(intPart); i++ {
+			if i > 0 && (len(intPart)-i)%3 == 0 {
+				sb.WriteString(symbols.group)
+			}
+			sb.WriteByte(intPart[i])
+		}
+	} else {
+		sb.WriteString(intPart)
+	}
+	if fractionDigits > 0 {
+		sb.WriteString(symbols.decimal)
+		sb.WriteString(fracPart)
+	}
+	return sb.String()
+}
+
+// isSpaceGroupSeparator reports whether the group separator is a kind of space.
+// Such a separator is hard to type, so any space is accepted as the separator.
+func isSpaceGroupSeparator(separator string) bool {
+	r, size := utf8.DecodeRuneInString(separator)
+	return size == len(separator) && unicode.IsSpace(r)
+}
+
+// removeGroupSeparators removes the group separators from the integer part of a number.
+// removeGroupSeparators returns false if the separators are misplaced, i.e. a group other than the first doesn't have three digits.
+func removeGroupSeparators(intPart string, symbols numberSymbols) (string, bool) {
+	if symbols.group == "" {
+		return intPart, true
+	}
+	sep := symbols.group
+	if isSpaceGroupSeparator(sep) {
+		sep = " "
+		intPart = strings.Map(func(r rune) rune {
+			if unicode.IsSpace(r) {
+				return ' '
+			}
+			return r
+		}, intPart)
+	}
+	groups := strings.Split(intPart, sep)
+	if len(groups) == 1 {
+		return intPart, true
+	}
+	for i, g := range groups {
+		if i == 0 && (len(g) == 0 || len(g) > 3) || i > 0 && len(g) != 3 {
+			return "", false
+		}
+	}
+	return strings.Join(groups, ""), true
+}
+
+// parseFixedPoint parses text as a decimal number and returns the value scaled by 10^fractionDigits.
+// Extra fraction digits are rounded half away from zero.
+// parseFixedPoint returns false if text is not a valid number.
+func parseFixedPoint(text string, fractionDigits int, symbols numberSymbols) (*big.Int, bool) {
+	text = strings.TrimSpace(text)
+
+	var neg bool
+	switch {
+	case strings.HasPrefix(text, "-"):
+		neg = true
+		text = text[1:]
+	case strings.HasPrefix(text, "+"):
+		text = text[1:]
+	}
+
+	intPart, fracPart, ok := strings.Cut(text, symbols.decimal)
+	if !ok && symbols.decimal != "." && strings.Count(text, ".") == 1 {
+		// Accept a period as a decimal separator too, as it is commonly used regardless of the locale.
+		// If a period is the group separator, e.g. "1.234" in German, the text is treated as a grouped integer.
+		i, f, _ := strings.Cut(text, ".")
+		if symbols.group != "." || len(f) != 3 {
+			intPart, fracPart = i, f
+		}
+	}
+	intPart, ok = removeGroupSeparators(intPart, symbols)
+	if !ok {
+		return nil, false
+	}
+	if intPart == "" && fracPart == "" {
+		return nil, false
+	}
+	for _, part := range []string{intPart, fracPart} {
+		for i := 0; i < len(part); i++ {
+			if part[i] < '0' || part[i] > '9' {
+				return nil, false
+			}
+		}
+	}
+
+	var round bool
+	if len(fracPart) > fractionDigits {
+		round = fracPart[fractionDigits] >= '5'
+		fracPart = fracPart[:fractionDigits]
+	} else {
+		fracPart += strings.Repeat("0", fractionDigits-len(fracPart))
+	}
+
+	var v big.Int
+	if _, ok := v.SetString("0"+intPart+fracPart, 10); !ok {
+		return nil, false
+	}
+	if round {
+		v.Add(&v, big.NewInt(1))
+	}
+	if neg {
+		v.Neg(&v)
+	}
+	return &v, true
+}
+
+// ratToFixedPoint returns r scaled by 10^fractionDigits, rounded half away from zero.
+func ratToFixedPoint(r *big.Rat, fractionDigits int) *big.Int {
+	var num big.Int
+	num.Mul(r.Num(), pow10BigInt(fractionDigits))
+	var v big.Int
+	roundDivBigInt(&v, &num, r.Denom())
+	return &v
+}
+
+// fixedPointToFloat64 returns value scaled by 10^-fractionDigits as a float64 value.
+func fixedPointToFloat64(value *big.Int, fractionDigits int) float64 {
+	f, _ := (&big.Rat{}).SetFrac(value, pow10BigInt(fractionDigits)).Float64()
+	return f
+}
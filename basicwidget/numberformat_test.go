@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package basicwidget_test
+
+import (
+	"math/big"
+	"testing"
+
+	"golang.org/x/text/language"
+
+	"github.com/guigui-gui/guigui/basicwidget"
+)
+
+func TestFormatFixedPoint(t *testing.T) {
+	testCases := []struct {
+		value          int64
+		fractionDigits int
+		locale         language.Tag
+		grouping       bool
+		out            string
+	}{
+		{value: 0, fractionDigits: 0, locale: language.English, out: "0"},
+		{value: 1234567, fractionDigits: 0, locale: language.English, out: "1234567"},
+		{value: 1234567, fractionDigits: 0, locale: language.English, grouping: true, out: "1,234,567"},
+		{value: 1234567, fractionDigits: 2, locale: language.English, grouping: true, out: "12,345.67"},
+		{value: -5, fractionDigits: 2, locale: language.English, out: "-0.05"},
+		{value: 1234567, fractionDigits: 2, locale: language.German, grouping: true, out: "12.345,67"},
+		{value: 1234567, fractionDigits: 2, locale: language.German, out: "12345,67"},
+		{value: 100, fractionDigits: 3, locale: language.Und, out: "0.100"},
+	}
+	for _, tc := range testCases {
+		got := basicwidget.FormatFixedPoint(big.NewInt(tc.value), tc.fractionDigits, tc.locale, tc.grouping)
+		if got != tc.out {
+			t.Errorf("FormatFixedPoint(%d, %d, %v, %t): got: %q, want: %q", tc.value, tc.fractionDigits, tc.locale, tc.grouping, got, tc.out)
+		}
+	}
+}
+
+func TestParseFixedPoint(t *testing.T) {
+	testCases := []struct {
+		text           string
+		fractionDigits int
+		locale         language.Tag
+		out            int64
+		ok             bool
+	}{
+		{text: "123", fractionDigits: 0, locale: language.English, out: 123, ok: true},
+		{text: "-123", fractionDigits: 0, locale: language.English, out: -123, ok: true},
+		{text: "1,234.5", fractionDigits: 2, locale: language.English, out: 123450, ok: true},
+		{text: "1.234,5", fractionDigits: 2, locale: language.German, out: 123450, ok: true},
+		{text: "1.005", fractionDigits: 2, locale: language.English, out: 101, ok: true},
+		{text: "-1.005", fractionDigits: 2, locale: language.English, out: -101, ok: true},
+		{text: "1.004", fractionDigits: 2, locale: language.English, out: 100, ok: true},
+		{text: ".5", fractionDigits: 1, locale: language.English, out: 5, ok: true},
+		{text: "1 234,5", fractionDigits: 1, locale: language.French, out: 12345, ok: true},
+		{text: "2.5", fractionDigits: 1, locale: language.French, out: 25, ok: true},
+		{text: "2.5", fractionDigits: 1, locale: language.German, out: 25, ok: true},
+		{text: "2,5", fractionDigits: 1, locale: language.German, out: 25, ok: true},
+		{text: "1.234", fractionDigits: 0, locale: language.German, out: 1234, ok: true},
+		{text: "1.234.567,5", fractionDigits: 1, locale: language.German, out: 12345675, ok: true},
+		{text: "-1.234,5", fractionDigits: 1, locale: language.German, out: -12345, ok: true},
+		{text: "12.34,5", fractionDigits: 1, locale: language.German, ok: false},
+		{text: "1.2345,5", fractionDigits: 1, locale: language.German, ok: false},
+		{text: "1,234.5", fractionDigits: 1, locale: language.German, ok: false},
+		{text: "1,234,567", fractionDigits: 0, locale: language.English, out: 1234567, ok: true},
+		{text: "12,34", fractionDigits: 0, locale: language.English, ok: false},
+		{text: "1,234,5", fractionDigits: 0, locale: language.English, ok: false},
+		{text: ",234", fractionDigits: 0, locale: language.English, ok: false},
+		{text: "1234,567.5", fractionDigits: 1, locale: language.English, ok: false},
+		{text: "1.234,5", fractionDigits: 1, locale: language.English, ok: false},
+		{text: "1 23,5", fractionDigits: 1, locale: language.French, ok: false},
+		{text: "", fractionDigits: 0, locale: language.English, ok: false},
+		{text: "-", fractionDigits: 0, locale: language.English, ok: false},
+		{text: "1e3", fractionDigits: 0, locale: language.English, ok: false},
+		{text: "1/2", fractionDigits: 0, locale: language.English, ok: false},
+	}
+	for _, tc := range testCases {
+		got, ok := basicwidget.ParseFixedPoint(tc.text, tc.fractionDigits, tc.locale)
+		if ok != tc.ok {
+			t.Errorf("ParseFixedPoint(%q, %d, %v): got ok: %t, want: %t", tc.text, tc.fractionDigits, tc.locale, ok, tc.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if got.Cmp(big.NewInt(tc.out)) != 0 {
+			t.Errorf("ParseFixedPoint(%q, %d, %v): got: %s, want: %d", tc.text, tc.fractionDigits, tc.locale, got, tc.out)
+		}
+	}
+}
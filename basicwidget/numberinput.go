@@ -14,10 +14,11 @@ import (
 )
 
 var (
-	numberInputEventValueChanged       guigui.EventKey = guigui.GenerateEventKey()
-	numberInputEventValueChangedBigInt guigui.EventKey = guigui.GenerateEventKey()
-	numberInputEventValueChangedInt64  guigui.EventKey = guigui.GenerateEventKey()
-	numberInputEventValueChangedUint64 guigui.EventKey = guigui.GenerateEventKey()
+	numberInputEventValueChanged        guigui.EventKey = guigui.GenerateEventKey()
+	numberInputEventValueChangedBigInt  guigui.EventKey = guigui.GenerateEventKey()
+	numberInputEventValueChangedInt64   guigui.EventKey = guigui.GenerateEventKey()
+	numberInputEventValueChangedUint64  guigui.EventKey = guigui.GenerateEventKey()
+	numberInputEventValueChangedFloat64 guigui.EventKey = guigui.GenerateEventKey()
 )
 
 var (
@@ -45,11 +46,12 @@ type NumberInput struct {
 
 	abstractNumberInput abstractNumberInput
 
-	onValueChanged       func(value int, committed bool)
-	onValueChangedBigInt func(value *big.Int, committed bool)
-	onValueChangedInt64  func(value int64, committed bool)
-	onValueChangedUint64 func(value uint64, committed bool)
-	onValueChangedString func(value string, force bool)
+	onValueChanged        func(value int, committed bool)
+	onValueChangedBigInt  func(value *big.Int, committed bool)
+	onValueChangedInt64   func(value int64, committed bool)
+	onValueChangedUint64  func(value uint64, committed bool)
+	onValueChangedFloat64 func(value float64, committed bool)
+	onValueChangedString  func(value string, force bool)
 
 	onTextInputValueChanged func(context *guigui.Context, value string, committed bool)
 	onUpButtonDown          func(context *guigui.Context)
@@ -102,6 +104,12 @@ func (n *NumberInput) OnValueChangedUint64(f func(context *guigui.Context, value
 	guigui.SetEventHandler(n, numberInputEventValueChangedUint64, f)
 }
 
+// OnValueChangedFloat64 sets the event handler that is called when the value is changed.
+// This is useful for a number input with fraction digits. See [NumberInput.SetFractionDigits].
+func (n *NumberInput) OnValueChangedFloat64(f func(context *guigui.Context, value float64, committed bool)) {
+	guigui.SetEventHandler(n, numberInputEventValueChangedFloat64, f)
+}
+
 func (n *NumberInput) OnHandleButtonInput(f func(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult) {
 	n.textInput.OnHandleButtonInput(f)
 }
@@ -122,6 +130,11 @@ func (n *NumberInput) ValueUint64() uint64 {
 	return n.abstractNumberInput.ValueUint64()
 }
 
+// ValueFloat64 returns the value including the fraction part.
+func (n *NumberInput) ValueFloat64() float64 {
+	return n.abstractNumberInput.ValueFloat64()
+}
+
 func (n *NumberInput) SetValueBigInt(value *big.Int) {
 	n.abstractNumberInput.SetValueBigInt(value, true)
 }
@@ -138,6 +151,12 @@ func (n *NumberInput) SetValueUint64(value uint64) {
 	n.SetValueBigInt((&big.Int{}).SetUint64(value))
 }
 
+// SetValueFloat64 sets the value.
+// The value is rounded to the fraction digits. See [NumberInput.SetFractionDigits].
+func (n *NumberInput) SetValueFloat64(value float64) {
+	n.abstractNumberInput.SetValueFloat64(value, true)
+}
+
 func (n *NumberInput) ForceSetValue(value int) {
 	n.abstractNumberInput.ForceSetValue(value, true)
 }
@@ -154,6 +173,16 @@ func (n *NumberInput) ForceSetValueUint64(value uint64) {
 	n.abstractNumberInput.ForceSetValueUint64(value, true)
 }
 
+func (n *NumberInput) ForceSetValueFloat64(value float64) {
+	n.abstractNumberInput.ForceSetValueFloat64(value, true)
+}
+
+// forceSetValueString sets the value parsed from text in the locale's format.
+// forceSetValueString returns false and doesn't change the value if text is not a valid number.
+func (n *NumberInput) forceSetValueString(text string) bool {
+	return n.abstractNumberInput.SetString(text, true, true)
+}
+
 func (n *NumberInput) MinimumValueBigInt() *big.Int {
 	return n.abstractNumberInput.MinimumValueBigInt()
 }
@@ -174,6 +203,10 @@ func (n *NumberInput) SetMinimumValueUint64(minimum uint64) {
 	n.abstractNumberInput.SetMinimumValueUint64(minimum)
 }
 
+func (n *NumberInput) SetMinimumValueFloat64(minimum float64) {
+	n.abstractNumberInput.SetMinimumValueFloat64(minimum)
+}
+
 func (n *NumberInput) MaximumValueBigInt() *big.Int {
 	return n.abstractNumberInput.MaximumValueBigInt()
 }
@@ -194,6 +227,10 @@ func (n *NumberInput) SetMaximumValueUint64(maximum uint64) {
 	n.abstractNumberInput.SetMaximumValueUint64(maximum)
 }
 
+func (n *NumberInput) SetMaximumValueFloat64(maximum float64) {
+	n.abstractNumberInput.SetMaximumValueFloat64(maximum)
+}
+
 func (n *NumberInput) SetStep(step int) {
 	n.abstractNumberInput.SetStep(step)
 }
@@ -210,6 +247,40 @@ func (n *NumberInput) SetStepUint64(step uint64) {
 	n.abstractNumberInput.SetStepUint64(step)
 }
 
+func (n *NumberInput) SetStepFloat64(step float64) {
+	n.abstractNumberInput.SetStepFloat64(step)
+}
+
+// FractionDigits returns the number of digits after the decimal point.
+func (n *NumberInput) FractionDigits() int {
+	return n.abstractNumberInput.FractionDigits()
+}
+
+// SetFractionDigits sets the number of digits after the decimal point.
+// The value is a fixed-point number with the fraction digits, and the value, minimum, maximum and step are rounded to them.
+// The integer APIs like [NumberInput.Value] truncate the fraction part.
+// The default value is 0.
+func (n *NumberInput) SetFractionDigits(digits int) {
+	n.abstractNumberInput.SetFractionDigits(digits)
+}
+
+// IsDigitGroupingEnabled reports whether the integer part is shown with grouping separators.
+func (n *NumberInput) IsDigitGroupingEnabled() bool {
+	return n.abstractNumberInput.digitGroupingEnabled
+}
+
+// SetDigitGroupingEnabled sets whether the integer part is shown with grouping separators like "1,234,567".
+// The separators depend on [guigui.Context.FirstLocale].
+// Grouping separators in an input text are ignored regardless of this setting.
+// The default value is false.
+func (n *NumberInput) SetDigitGroupingEnabled(enabled bool) {
+	n.abstractNumberInput.setDigitGroupingEnabled(enabled)
+}
+
+func (n *NumberInput) setMinimumIntegerDigits(digits int) {
+	n.abstractNumberInput.setMinimumIntegerDigits(digits)
+}
+
 func (n *NumberInput) CommitWithCurrentInputValue() {
 	n.textInput.CommitWithCurrentInputValue()
 }
@@ -259,6 +330,13 @@ func (n *NumberInput) Build(context *guigui.Context, adder *guigui.ChildAdder) e
 	}
 	n.abstractNumberInput.OnValueChangedUint64(n.onValueChangedUint64)
 
+	if n.onValueChangedFloat64 == nil {
+		n.onValueChangedFloat64 = func(value float64, committed bool) {
+			guigui.DispatchEvent(n, numberInputEventValueChangedFloat64, value, committed)
+		}
+	}
+	n.abstractNumberInput.OnValueChangedFloat64(n.onValueChangedFloat64)
+
 	if n.onValueChangedString == nil {
 		n.onValueChangedString = func(value string, force bool) {
 			if force {
@@ -270,6 +348,7 @@ func (n *NumberInput) Build(context *guigui.Context, adder *guigui.ChildAdder) e
 	}
 	n.abstractNumberInput.OnValueChangedString(n.onValueChangedString)
 
+	n.abstractNumberInput.setLocale(context.FirstLocale())
 	n.textInput.SetValue(n.abstractNumberInput.ValueString())
 	n.textInput.SetHorizontalAlign(HorizontalAlignRight)
 	n.textInput.SetTabular(true)
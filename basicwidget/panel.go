@@ -189,6 +189,13 @@ func (p *panel) scrollOffset() (float64, float64) {
 	return p.offsetX, p.offsetY
 }
 
+func (p *panel) scrollOffsetTarget() (float64, float64) {
+	if p.animCount > 0 {
+		return p.animTargetX, p.animTargetY
+	}
+	return p.offsetX, p.offsetY
+}
+
 // SetScrollOffsetByDelta animates the offset by adding dx and dy to the current
 // animation target (or the current offset if no animation is in flight).
 func (p *panel) SetScrollOffsetByDelta(dx, dy float64) {
@@ -400,11 +407,7 @@ func (p *panel) adjustOffset(context *guigui.Context, widgetBounds *guigui.Widge
 }
 
 func (p *panel) scrollRange(context *guigui.Context, panelBounds image.Rectangle) image.Rectangle {
-	cs := p.contentSizeAtLayout
-	return image.Rectangle{
-		Min: image.Pt(min(panelBounds.Dx()-cs.X, 0), min(panelBounds.Dy()-cs.Y, 0)),
-		Max: image.Pt(0, 0),
-	}
+	return contentScrollRange(panelBounds, p.contentSizeAtLayout)
 }
 
 func (p *panel) isHBarVisible(context *guigui.Context, widgetBounds *guigui.WidgetBounds) bool {
@@ -417,7 +420,7 @@ func (p *panel) isHBarVisible(context *guigui.Context, widgetBounds *guigui.Widg
 	if !widgetBounds.IsHitAtCursor() {
 		return false
 	}
-	pt := image.Pt(ebiten.CursorPosition())
+	pt := image.Pt(guigui.CursorPosition())
 	return pt.In(p.horizontalBarBounds(context, widgetBounds))
 }
 
@@ -431,7 +434,7 @@ func (p *panel) isVBarVisible(context *guigui.Context, widgetBounds *guigui.Widg
 	if !widgetBounds.IsHitAtCursor() {
 		return false
 	}
-	pt := image.Pt(ebiten.CursorPosition())
+	pt := image.Pt(guigui.CursorPosition())
 	return pt.In(p.verticalBarBounds(context, widgetBounds))
 }
 
@@ -8,7 +8,6 @@ import (
 	"slices"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
 	"github.com/hajimehoshi/ebiten/v2/vector"
 
 	"github.com/guigui-gui/guigui"
@@ -56,6 +55,16 @@ const (
 	PopupCloseReasonClickOutside
 	PopupCloseReasonReopen
 	PopupCloseReasonAuto
+
+	// PopupCloseReasonDefault means the popup is closed by the default action, e.g. the Enter key in a [Dialog].
+	PopupCloseReasonDefault
+
+	// PopupCloseReasonCancel means the popup is closed by the cancel action, e.g. the Escape key in a [Dialog].
+	PopupCloseReasonCancel
+
+	// PopupCloseReasonButton means the popup is closed by a button that is neither the default nor the cancel one,
+	// e.g. a third button in a [Dialog].
+	PopupCloseReasonButton
 )
 
 // Popup is a widget that displays its content on a separate layer.
@@ -399,14 +408,14 @@ func (p *popup) tryCloseByClickingOutside(context *guigui.Context) bool {
 	if !p.closeByClickingOutside {
 		return false
 	}
-	if inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) || inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonRight) {
-		if image.Pt(ebiten.CursorPosition()).In(p.closeByClickingOutsideExcludedRect) {
+	if guigui.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) || guigui.IsMouseButtonJustPressed(ebiten.MouseButtonRight) {
+		if image.Pt(guigui.CursorPosition()).In(p.closeByClickingOutsideExcludedRect) {
 			return false
 		}
 		p.close(context, PopupCloseReasonClickOutside)
 		// Continue handling inputs so that clicking a right button can be handled by other widgets.
 		// This is a little tricky, but this is needed to reopen context menu popups.
-		if inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonRight) {
+		if guigui.IsMouseButtonJustPressed(ebiten.MouseButtonRight) {
 			return true
 		}
 	}
@@ -419,7 +428,7 @@ func (p *popup) HandlePointingInput(context *guigui.Context, widgetBounds *guigu
 	}
 
 	bounds := p.bounds(context)
-	if !image.Pt(ebiten.CursorPosition()).In(bounds) {
+	if !image.Pt(guigui.CursorPosition()).In(bounds) {
 		return guigui.HandleInputResult{}
 	}
 
@@ -7,7 +7,7 @@ import (
 	"image"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"golang.org/x/text/collate"
 
 	"github.com/guigui-gui/guigui"
 )
@@ -33,13 +33,24 @@ type PopupMenu[T comparable] struct {
 	guigui.DefaultWidget
 
 	popup     Popup
+	content   popupMenuContent[T]
 	list      guigui.WidgetWithSize[*List[T]]
 	items     []PopupMenuItem[T]
 	listItems []ListItem[T]
 
 	minWidth int
 
-	onItemSelected func(context *guigui.Context, index int)
+	filterInput        TextInput
+	filterVisible      bool
+	filterFocusPending bool
+	typeAheadDisabled  bool
+
+	// widthWithoutFilter is the width of the menu without filtering,
+	// so that the menu width doesn't change while the items are filtered.
+	widthWithoutFilter int
+
+	onItemSelected            func(context *guigui.Context, index int)
+	onFilterInputValueChanged func(context *guigui.Context, text string, committed bool)
 }
 
 func (p *PopupMenu[T]) OnItemSelected(f func(context *guigui.Context, index int)) {
@@ -62,11 +73,62 @@ func (p *PopupMenu[T]) SetReservesCheckmarkSpace(reserves bool) {
 	p.list.Widget().SetReservesCheckmarkSpace(reserves)
 }
 
+// SetFilterVisible sets whether the popup menu shows a filter field above the items.
+// Typing in the filter field narrows the items to the ones whose texts contain the typed text,
+// and highlights the matched parts.
+// The matching is case-insensitive and based on the collation of the locale.
+//
+// The default value is false.
+func (p *PopupMenu[T]) SetFilterVisible(visible bool) {
+	if p.filterVisible == visible {
+		return
+	}
+	p.filterVisible = visible
+	if !visible {
+		p.resetFilter()
+	}
+	guigui.RequestRebuild(p)
+}
+
+// IsFilterVisible reports whether the popup menu shows a filter field.
+func (p *PopupMenu[T]) IsFilterVisible() bool {
+	return p.filterVisible
+}
+
+func (p *PopupMenu[T]) resetFilter() {
+	p.filterInput.ForceSetValue("")
+	p.setFilterQuery(nil, "")
+}
+
+// setFilterQuery narrows the items to the ones whose texts contain the query.
+func (p *PopupMenu[T]) setFilterQuery(collator *collate.Collator, query string) {
+	p.list.Widget().setFilterQuery(collator, query)
+}
+
+// filteredItemCount returns the number of the items whose texts contain the query.
+func (p *PopupMenu[T]) filteredItemCount(collator *collate.Collator, query string) int {
+	return p.list.Widget().filteredItemCount(collator, query)
+}
+
+// setTypeAheadDisabled sets whether the typed characters are ignored by the menu,
+// e.g. when the characters are typed in another text input.
+func (p *PopupMenu[T]) setTypeAheadDisabled(disabled bool) {
+	p.typeAheadDisabled = disabled
+}
+
+// highlightFirstFilteredItem moves the keyboard highlight to the first selectable item that is not filtered out.
+func (p *PopupMenu[T]) highlightFirstFilteredItem() {
+	content := &p.list.Widget().content
+	p.setKeyboardHighlightIndex(content.nextSelectableVisibleIndex(-1, true))
+}
+
 func (p *PopupMenu[T]) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
 	adder.AddWidget(&p.popup)
 
 	list := p.list.Widget()
 	list.SetStyle(ListStyleMenu)
+	// The typed characters are for the filter field if it is visible.
+	list.setTypeAheadDisabled(p.typeAheadDisabled || p.filterVisible)
 	if p.onItemSelected == nil {
 		p.onItemSelected = func(context *guigui.Context, index int) {
 			p.popup.SetOpen(false)
@@ -75,8 +137,23 @@ func (p *PopupMenu[T]) Build(context *guigui.Context, adder *guigui.ChildAdder)
 	}
 	list.OnItemSelected(p.onItemSelected)
 
+	if p.filterVisible {
+		if p.onFilterInputValueChanged == nil {
+			p.onFilterInputValueChanged = func(context *guigui.Context, text string, committed bool) {
+				p.setFilterQuery(collatorForLocale(context.FirstLocale()), text)
+				p.highlightFirstFilteredItem()
+			}
+		}
+		p.filterInput.OnValueChanged(p.onFilterInputValueChanged)
+		p.content.menu = p
+	}
+
 	p.popup.setStyle(popupStyleMenu)
-	p.popup.SetContent(&p.list)
+	if p.filterVisible {
+		p.popup.SetContent(&p.content)
+	} else {
+		p.popup.SetContent(&p.list)
+	}
 	p.popup.SetCloseByClickingOutside(true)
 
 	return nil
@@ -84,7 +161,7 @@ func (p *PopupMenu[T]) Build(context *guigui.Context, adder *guigui.ChildAdder)
 
 // HandleButtonInput implements [guigui.Widget.HandleButtonInput].
 func (p *PopupMenu[T]) HandleButtonInput(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
-	if p.popup.IsOpen() && inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+	if p.popup.IsOpen() && guigui.IsKeyJustPressed(ebiten.KeyEscape) {
 		p.popup.SetOpen(false)
 		return guigui.HandleInputByWidget(p)
 	}
@@ -93,10 +170,19 @@ func (p *PopupMenu[T]) HandleButtonInput(context *guigui.Context, widgetBounds *
 
 func (p *PopupMenu[T]) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
 	b := p.contentBounds(context, widgetBounds)
-	p.list.SetFixedSize(b.Size())
+	s := b.Size()
+	if p.filterVisible {
+		s.Y -= p.filterAreaHeight(context)
+	}
+	p.list.SetFixedSize(s)
 	layouter.LayoutWidget(&p.popup, b)
 }
 
+// filterAreaHeight returns the height of the area of the filter field.
+func (p *PopupMenu[T]) filterAreaHeight(context *guigui.Context) int {
+	return p.filterInput.Measure(context, guigui.Constraints{}).Y + UnitSize(context)/2
+}
+
 func (p *PopupMenu[T]) Measure(context *guigui.Context, constraints guigui.Constraints) image.Point {
 	// Ignore the constraints.
 	return p.measure(context)
@@ -107,6 +193,13 @@ func (p *PopupMenu[T]) measure(context *guigui.Context) image.Point {
 	s := p.list.Widget().Measure(context, guigui.Constraints{})
 	s.X = max(s.X, p.minWidth)
 	s.Y = min(s.Y, 24*UnitSize(context))
+	if p.filterVisible {
+		if p.filterInput.Value() == "" {
+			p.widthWithoutFilter = s.X
+		}
+		s.X = max(s.X, p.widthWithoutFilter, 6*UnitSize(context))
+		s.Y += p.filterAreaHeight(context)
+	}
 	return s
 }
 
@@ -160,6 +253,10 @@ func (p *PopupMenu[T]) SetOpen(open bool) {
 	// TODO: Fix this. This is tricky.
 	if !p.popup.IsOpen() && open {
 		p.list.Widget().resetHoveredItemIndex()
+		if p.filterVisible {
+			p.resetFilter()
+			p.filterFocusPending = true
+		}
 	}
 	p.popup.SetOpen(open)
 }
@@ -236,3 +333,36 @@ func (p *PopupMenu[T]) setKeyboardHighlightIndex(index int) {
 func (p *PopupMenu[T]) itemYFromIndexForMenu(context *guigui.Context, index int) (int, bool) {
 	return p.list.Widget().itemYFromIndexForMenu(context, index)
 }
+
+// popupMenuContent is the content of a popup menu with a filter field.
+type popupMenuContent[T comparable] struct {
+	guigui.DefaultWidget
+
+	menu *PopupMenu[T]
+}
+
+func (p *popupMenuContent[T]) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
+	// The list is added after the filter field so that the list handles the arrow keys and the Enter key first.
+	adder.AddWidget(&p.menu.filterInput)
+	adder.AddWidget(&p.menu.list)
+	// The filter field has the focus, but the list needs to handle the keys.
+	context.SetButtonInputReceptive(p, true)
+	return nil
+}
+
+func (p *popupMenuContent[T]) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
+	bounds := widgetBounds.Bounds()
+	u := UnitSize(context)
+	h := p.menu.filterAreaHeight(context)
+	layouter.LayoutWidget(&p.menu.filterInput, image.Rect(bounds.Min.X+u/4, bounds.Min.Y+u/4, bounds.Max.X-u/4, bounds.Min.Y+h-u/4))
+	layouter.LayoutWidget(&p.menu.list, image.Rect(bounds.Min.X, bounds.Min.Y+h, bounds.Max.X, bounds.Max.Y))
+}
+
+func (p *popupMenuContent[T]) Tick(context *guigui.Context, widgetBounds *guigui.WidgetBounds) error {
+	// Focus after opening, as a widget can be focused only when it is in the widget tree.
+	if p.menu.filterFocusPending {
+		p.menu.filterFocusPending = false
+		context.SetFocused(&p.menu.filterInput, true)
+	}
+	return nil
+}
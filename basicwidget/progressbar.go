@@ -0,0 +1,229 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package basicwidget
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+
+	"github.com/guigui-gui/guigui"
+	"github.com/guigui-gui/guigui/basicwidget/basicwidgetdraw"
+	"github.com/guigui-gui/guigui/basicwidget/internal/draw"
+)
+
+// ProgressBarStyle is the style of a [ProgressBar].
+type ProgressBarStyle int
+
+const (
+	// ProgressBarStyleLinear shows the progress as a horizontal bar.
+	ProgressBarStyleLinear ProgressBarStyle = iota
+
+	// ProgressBarStyleCircular shows the progress as a ring.
+	ProgressBarStyleCircular
+)
+
+// ProgressBar is a widget to show the progress of a long operation.
+//
+// A ProgressBar is determinate by default, and shows the value between 0 and 1.
+// An indeterminate ProgressBar shows an animation instead.
+// The animation is advanced only while the widget is in the widget tree and visible.
+type ProgressBar struct {
+	guigui.DefaultWidget
+
+	value         float64
+	indeterminate bool
+	style         ProgressBarStyle
+	semanticColor basicwidgetdraw.SemanticColor
+
+	animationCount int
+}
+
+// Value returns the progress value between 0 and 1.
+func (p *ProgressBar) Value() float64 {
+	return p.value
+}
+
+// SetValue sets the progress value.
+// The value is clamped between 0 and 1.
+func (p *ProgressBar) SetValue(value float64) {
+	if math.IsNaN(value) {
+		value = 0
+	}
+	value = min(max(value, 0), 1)
+	if p.value == value {
+		return
+	}
+	p.value = value
+	guigui.RequestRedraw(p)
+}
+
+// IsIndeterminate reports whether the progress bar is indeterminate.
+func (p *ProgressBar) IsIndeterminate() bool {
+	return p.indeterminate
+}
+
+// SetIndeterminate sets whether the progress bar is indeterminate.
+// An indeterminate progress bar ignores the value and shows an animation.
+func (p *ProgressBar) SetIndeterminate(indeterminate bool) {
+	if p.indeterminate == indeterminate {
+		return
+	}
+	p.indeterminate = indeterminate
+	p.animationCount = 0
+	guigui.RequestRedraw(p)
+}
+
+// Style returns the style of the progress bar.
+func (p *ProgressBar) Style() ProgressBarStyle {
+	return p.style
+}
+
+// SetStyle sets the style of the progress bar.
+func (p *ProgressBar) SetStyle(style ProgressBarStyle) {
+	if p.style == style {
+		return
+	}
+	p.style = style
+	guigui.RequestRedraw(p)
+}
+
+// SetSemanticColor sets the color of the progress.
+// [basicwidgetdraw.SemanticColorBase] means the accent color.
+func (p *ProgressBar) SetSemanticColor(semanticColor basicwidgetdraw.SemanticColor) {
+	if p.semanticColor == semanticColor {
+		return
+	}
+	p.semanticColor = semanticColor
+	guigui.RequestRedraw(p)
+}
+
+func (p *ProgressBar) WriteStateKey(w *guigui.StateKeyWriter) {
+	w.WriteFloat64(p.value)
+	w.WriteBool(p.indeterminate)
+	w.WriteInt(int(p.style))
+	w.WriteInt(int(p.semanticColor))
+}
+
+func (p *ProgressBar) Tick(context *guigui.Context, widgetBounds *guigui.WidgetBounds) error {
+	if !p.indeterminate {
+		return nil
+	}
+	// Tick is not called when the widget is not in the tree, so the animation stops automatically.
+	// Don't advance the animation while the widget is not visible to avoid unnecessary redraws.
+	if !context.IsVisible(p) || widgetBounds.VisibleBounds().Empty() {
+		return nil
+	}
+	p.animationCount = (p.animationCount + 1) % progressBarAnimationMaxCount()
+	guigui.RequestRedraw(p)
+	return nil
+}
+
+func progressBarAnimationMaxCount() int {
+	return ebiten.TPS() * 3 / 2
+}
+
+func (p *ProgressBar) Draw(context *guigui.Context, widgetBounds *guigui.WidgetBounds, dst *ebiten.Image) {
+	clr := progressColor(context.ColorMode(), p.semanticColor, context.IsEnabled(p))
+	rate := float64(p.animationCount) / float64(progressBarAnimationMaxCount())
+	switch p.style {
+	case ProgressBarStyleLinear:
+		p.drawLinear(context, widgetBounds.Bounds(), dst, clr, rate)
+	case ProgressBarStyleCircular:
+		p.drawCircular(context, widgetBounds.Bounds(), dst, clr, rate)
+	}
+}
+
+func (p *ProgressBar) drawLinear(context *guigui.Context, bounds image.Rectangle, dst *ebiten.Image, clr color.Color, rate float64) {
+	u := UnitSize(context)
+	h := u / 4
+	b := image.Rect(bounds.Min.X, bounds.Min.Y+(bounds.Dy()-h)/2, bounds.Max.X, bounds.Min.Y+(bounds.Dy()-h)/2+h)
+	r := h / 2
+
+	cm := context.ColorMode()
+	basicwidgetdraw.DrawRoundedRect(context, dst, b, draw.Color(cm, draw.SemanticColorBase, 0.8), r)
+
+	var x0, x1 int
+	if p.indeterminate {
+		// A segment slides from the start to the end.
+		w := b.Dx() * 2 / 5
+		x := b.Min.X - w + int(float64(b.Dx()+w)*rate)
+		x0, x1 = max(x, b.Min.X), min(x+w, b.Max.X)
+	} else {
+		x0, x1 = b.Min.X, b.Min.X+int(float64(b.Dx())*p.value)
+	}
+	if x1 > x0 {
+		basicwidgetdraw.DrawRoundedRect(context, dst, image.Rect(x0, b.Min.Y, x1, b.Max.Y), clr, r)
+	}
+
+	borderClr1, borderClr2 := basicwidgetdraw.BorderColors(cm, basicwidgetdraw.RoundedRectBorderTypeInset)
+	basicwidgetdraw.DrawRoundedRectBorder(context, dst, b, borderClr1, borderClr2, r, float32(1*context.Scale()), basicwidgetdraw.RoundedRectBorderTypeInset)
+}
+
+func (p *ProgressBar) drawCircular(context *guigui.Context, bounds image.Rectangle, dst *ebiten.Image, clr color.Color, rate float64) {
+	cx, cy, radius, strokeWidth := circularProgressGeometry(context, bounds)
+	cm := context.ColorMode()
+	vector.StrokeCircle(dst, cx, cy, radius, strokeWidth, draw.Color(cm, draw.SemanticColorBase, 0.8), true)
+
+	// Start from the top.
+	start := -math.Pi / 2
+	if p.indeterminate {
+		// A quarter arc rotates once per period.
+		start += 2 * math.Pi * rate
+		drawArc(dst, cx, cy, radius, float32(start), float32(start+math.Pi/2), strokeWidth, clr)
+		return
+	}
+	if p.value > 0 {
+		drawArc(dst, cx, cy, radius, float32(start), float32(start+2*math.Pi*p.value), strokeWidth, clr)
+	}
+}
+
+func (p *ProgressBar) Measure(context *guigui.Context, constraints guigui.Constraints) image.Point {
+	u := UnitSize(context)
+	if p.style == ProgressBarStyleCircular {
+		return image.Pt(u, u)
+	}
+	w, ok := constraints.FixedWidth()
+	if !ok {
+		w = 6 * u
+	}
+	return image.Pt(w, u)
+}
+
+// progressColor returns the color of the progress for the semantic color.
+// [basicwidgetdraw.SemanticColorBase] means the accent color.
+func progressColor(colorMode ebiten.ColorMode, semanticColor basicwidgetdraw.SemanticColor, enabled bool) color.Color {
+	if !enabled {
+		return draw.Color(colorMode, draw.SemanticColorBase, 0.6)
+	}
+	if semanticColor == basicwidgetdraw.SemanticColorBase {
+		semanticColor = basicwidgetdraw.SemanticColorAccent
+	}
+	return draw.Color(colorMode, draw.SemanticColor(semanticColor), 0.5)
+}
+
+// circularProgressGeometry returns the center, the radius and the stroke width of a ring in bounds.
+func circularProgressGeometry(context *guigui.Context, bounds image.Rectangle) (cx, cy, radius, strokeWidth float32) {
+	size := min(bounds.Dx(), bounds.Dy())
+	strokeWidth = max(float32(size)/8, float32(1*context.Scale()))
+	cx = float32(bounds.Min.X+bounds.Max.X) / 2
+	cy = float32(bounds.Min.Y+bounds.Max.Y) / 2
+	radius = (float32(size) - strokeWidth) / 2
+	return
+}
+
+func drawArc(dst *ebiten.Image, cx, cy, radius, startAngle, endAngle float32, strokeWidth float32, clr color.Color) {
+	var path vector.Path
+	path.Arc(cx, cy, radius, startAngle, endAngle, vector.Clockwise)
+	strokeOp := &vector.StrokeOptions{}
+	strokeOp.Width = strokeWidth
+	strokeOp.LineCap = vector.LineCapRound
+	drawOp := &vector.DrawPathOptions{}
+	drawOp.AntiAlias = true
+	drawOp.ColorScale.ScaleWithColor(clr)
+	vector.StrokePath(dst, &path, strokeOp, drawOp)
+}
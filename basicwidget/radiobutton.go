@@ -11,7 +11,6 @@ import (
 	"strings"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
 
 	"github.com/guigui-gui/guigui"
 	"github.com/guigui-gui/guigui/basicwidget/basicwidgetdraw"
@@ -140,18 +139,18 @@ func (r *RadioButton[T]) setPressed(pressed bool) {
 
 func (r *RadioButton[T]) HandlePointingInput(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
 	if context.IsEnabled(r) && widgetBounds.IsHitAtCursor() {
-		if inpututil.IsMouseButtonJustReleased(ebiten.MouseButtonLeft) {
+		if guigui.IsMouseButtonJustReleased(ebiten.MouseButtonLeft) {
 			r.group.SelectItemByIndex(r.index)
 			r.setPressed(false)
 			return guigui.HandleInputByWidget(r)
 		}
-		if ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
+		if guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
 			context.SetFocused(r, true)
 			r.setPressed(true)
 			return guigui.HandleInputByWidget(r)
 		}
 	}
-	if !context.IsEnabled(r) || !ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
+	if !context.IsEnabled(r) || !guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
 		r.setPressed(false)
 	}
 	return guigui.HandleInputResult{}
@@ -173,11 +172,11 @@ func (r *RadioButton[T]) CursorShape(context *guigui.Context, widgetBounds *guig
 }
 
 func (r *RadioButton[T]) canPress(context *guigui.Context, widgetBounds *guigui.WidgetBounds) bool {
-	return context.IsEnabled(r) && widgetBounds.IsHitAtCursor() && !ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft)
+	return context.IsEnabled(r) && widgetBounds.IsHitAtCursor() && !guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft)
 }
 
 func (r *RadioButton[T]) isActive(context *guigui.Context, widgetBounds *guigui.WidgetBounds) bool {
-	return context.IsEnabled(r) && widgetBounds.IsHitAtCursor() && ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft) && r.pressed
+	return context.IsEnabled(r) && widgetBounds.IsHitAtCursor() && guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft) && r.pressed
 }
 
 func (r *RadioButton[T]) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
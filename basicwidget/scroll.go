@@ -5,29 +5,14 @@ package basicwidget
 
 import (
 	"image"
-	"runtime"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
 
 	"github.com/guigui-gui/guigui"
 	"github.com/guigui-gui/guigui/basicwidget/basicwidgetdraw"
 	"github.com/guigui-gui/guigui/basicwidget/internal/draw"
 )
 
-func adjustedWheel() (float64, float64) {
-	x, y := ebiten.Wheel()
-	switch runtime.GOOS {
-	case "darwin":
-		x *= 2
-		y *= 2
-	case "windows":
-		x *= 4
-		y *= 4
-	}
-	return x, y
-}
-
 func scrollBarFadingInTime() int {
 	return ebiten.TPS() / 20
 }
@@ -45,7 +30,8 @@ func scrollBarMaxCount() int {
 }
 
 // scrollAnimMaxCount returns the duration in ticks of the scroll-offset
-// animation triggered by API calls like SetScrollOffset and setTopItem.
+// animation triggered by API calls like SetScrollOffset and setTopItem,
+// and by the wheel when smooth scrolling is enabled.
 func scrollAnimMaxCount() int {
 	return ebiten.TPS() / 10
 }
@@ -126,6 +112,25 @@ type scrollOffsetGetSetter interface {
 	forceSetScrollOffset(x, y float64)
 }
 
+// scrollOffsetAnimator is implemented by a [scrollOffsetGetSetter] that can animate the scroll offset.
+// scrollWheel uses this for smooth scrolling.
+type scrollOffsetAnimator interface {
+	// scrollOffsetTarget returns the offset that the in-flight animation is heading to,
+	// or the current offset if no animation is in flight.
+	scrollOffsetTarget() (float64, float64)
+
+	// SetScrollOffset animates the offset to (x, y).
+	SetScrollOffset(x, y float64)
+}
+
+// contentScrollRange returns the range of the scroll offset for the given viewport bounds and content size.
+func contentScrollRange(bounds image.Rectangle, contentSize image.Point) image.Rectangle {
+	return image.Rectangle{
+		Min: image.Pt(min(bounds.Dx()-contentSize.X, 0), min(bounds.Dy()-contentSize.Y, 0)),
+		Max: image.Pt(0, 0),
+	}
+}
+
 type scrollWheel struct {
 	guigui.DefaultWidget
 
@@ -160,26 +165,43 @@ func (s *scrollWheel) HandlePointingInput(context *guigui.Context, widgetBounds
 		return guigui.HandleInputResult{}
 	}
 
+	s.lastWheelX = 0
+	s.lastWheelY = 0
+
 	if !widgetBounds.IsHitAtCursor() {
-		s.lastWheelX = 0
-		s.lastWheelY = 0
 		return guigui.HandleInputResult{}
 	}
 
-	wheelX, wheelY := adjustedWheel()
-	s.lastWheelX = wheelX
-	s.lastWheelY = wheelY
+	wheelX, wheelY := context.Wheel()
+	if wheelX == 0 && wheelY == 0 {
+		return guigui.HandleInputResult{}
+	}
 
-	if wheelX != 0 || wheelY != 0 {
-		offsetX, offsetY := s.offsetGetSetter.scrollOffset()
-		offsetX += wheelX * scrollWheelSpeed(context)
-		offsetY += wheelY * scrollWheelSpeed(context)
-		s.offsetGetSetter.forceSetScrollOffset(offsetX, offsetY)
-		// TODO: If the actual offset is not changed, this should not return HandleInputByWidget (#204).
-		return guigui.HandleInputByWidget(s)
+	animator, smooth := s.offsetGetSetter.(scrollOffsetAnimator)
+	smooth = smooth && context.IsSmoothScrollingEnabled()
+
+	var offsetX, offsetY float64
+	if smooth {
+		offsetX, offsetY = animator.scrollOffsetTarget()
+	} else {
+		offsetX, offsetY = s.offsetGetSetter.scrollOffset()
+	}
+	r := contentScrollRange(widgetBounds.Bounds(), s.contentSize)
+	newOffsetX := min(max(offsetX+wheelX*scrollWheelSpeed(context), float64(r.Min.X)), float64(r.Max.X))
+	newOffsetY := min(max(offsetY+wheelY*scrollWheelSpeed(context), float64(r.Min.Y)), float64(r.Max.Y))
+	if newOffsetX == offsetX && newOffsetY == offsetY {
+		// The offset reaches the edge. Give a chance to an outer panel to scroll (scroll chaining).
+		return guigui.HandleInputResult{}
 	}
 
-	return guigui.HandleInputResult{}
+	s.lastWheelX = wheelX
+	s.lastWheelY = wheelY
+	if smooth {
+		animator.SetScrollOffset(newOffsetX, newOffsetY)
+	} else {
+		s.offsetGetSetter.forceSetScrollOffset(newOffsetX, newOffsetY)
+	}
+	return guigui.HandleInputByWidget(s)
 }
 
 type scrollBar struct {
@@ -245,9 +267,9 @@ func (s *scrollBar) HandlePointingInput(context *guigui.Context, widgetBounds *g
 		return guigui.HandleInputResult{}
 	}
 
-	if !s.dragging && widgetBounds.IsHitAtCursor() && inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
+	if !s.dragging && widgetBounds.IsHitAtCursor() && guigui.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
 		if tb := s.thumbBounds; !tb.Empty() {
-			x, y := ebiten.CursorPosition()
+			x, y := guigui.CursorPosition()
 			offsetX, offsetY := s.offsetGetSetter.scrollOffset()
 
 			var pos, thumbMin, thumbMax int
@@ -295,14 +317,14 @@ func (s *scrollBar) HandlePointingInput(context *guigui.Context, widgetBounds *g
 		}
 	}
 
-	if wheelX, wheelY := adjustedWheel(); wheelX != 0 || wheelY != 0 {
+	if wheelX, wheelY := context.Wheel(); wheelX != 0 || wheelY != 0 {
 		s.dragging = false
 	}
 
-	if s.dragging && ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
+	if s.dragging && guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
 		var dx, dy float64
 		if s.dragging {
-			x, y := ebiten.CursorPosition()
+			x, y := guigui.CursorPosition()
 			if s.horizontal {
 				dx = float64(x - s.draggingStartPosition)
 			} else {
@@ -330,7 +352,7 @@ func (s *scrollBar) HandlePointingInput(context *guigui.Context, widgetBounds *g
 		return guigui.HandleInputByWidget(s)
 	}
 
-	if s.dragging && !ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
+	if s.dragging && !guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
 		s.dragging = false
 	}
 	return guigui.HandleInputResult{}
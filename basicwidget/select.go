@@ -7,6 +7,8 @@ import (
 	"image"
 	"slices"
 
+	"github.com/hajimehoshi/ebiten/v2"
+
 	"github.com/guigui-gui/guigui"
 )
 
@@ -38,14 +40,26 @@ type Select[T comparable] struct {
 
 	indexAtOpen int
 
+	typeAhead     typeAhead
+	tmpInputChars []rune
+
 	onDown                  func(context *guigui.Context)
 	onPopupMenuItemSelected func(context *guigui.Context, index int)
+	onPopupMenuClose        func(context *guigui.Context, reason PopupCloseReason)
 }
 
 func (s *Select[T]) OnItemSelected(f func(context *guigui.Context, index int)) {
 	guigui.SetEventHandler(s, selectEventItemSelected, f)
 }
 
+// SetFilterVisible sets whether the popup menu shows a filter field above the items.
+// See [PopupMenu.SetFilterVisible].
+//
+// The default value is false.
+func (s *Select[T]) SetFilterVisible(visible bool) {
+	s.popupMenu.SetFilterVisible(visible)
+}
+
 func (s *Select[T]) updatePopupMenuItems() {
 	s.popupMenuItems = adjustSliceSize(s.popupMenuItems, len(s.items))
 	s.popupMenuItemContents.SetLen(len(s.items))
@@ -103,8 +117,7 @@ func (s *Select[T]) Build(context *guigui.Context, adder *guigui.ChildAdder) err
 
 	if s.onDown == nil {
 		s.onDown = func(context *guigui.Context) {
-			s.popupMenu.SetOpen(true)
-			s.indexAtOpen = s.popupMenu.SelectedItemIndex()
+			s.openPopupMenu()
 		}
 	}
 	s.button.OnDown(s.onDown)
@@ -119,9 +132,71 @@ func (s *Select[T]) Build(context *guigui.Context, adder *guigui.ChildAdder) err
 	s.popupMenu.OnItemSelected(s.onPopupMenuItemSelected)
 	s.popupMenu.SetReservesCheckmarkSpace(true)
 
+	if s.onPopupMenuClose == nil {
+		s.onPopupMenuClose = func(context *guigui.Context, reason PopupCloseReason) {
+			// The filter field had the focus. Give the focus back to the button.
+			if s.popupMenu.IsFilterVisible() {
+				context.SetFocused(&s.button, true)
+			}
+		}
+	}
+	s.popupMenu.OnClose(s.onPopupMenuClose)
+
 	return nil
 }
 
+// HandleButtonInput implements [guigui.Widget.HandleButtonInput].
+func (s *Select[T]) HandleButtonInput(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
+	// While the popup menu is open, the menu handles the typed characters.
+	if s.popupMenu.IsOpen() {
+		return guigui.HandleInputResult{}
+	}
+	if s.handleTypeAhead(context) {
+		return guigui.HandleInputByWidget(s)
+	}
+	return guigui.HandleInputResult{}
+}
+
+// handleTypeAhead selects the next item whose text starts with the typed characters.
+func (s *Select[T]) handleTypeAhead(context *guigui.Context) bool {
+	s.tmpInputChars = ebiten.AppendInputChars(s.tmpInputChars[:0])
+	if len(s.tmpInputChars) == 0 {
+		return false
+	}
+	continued, ok := s.typeAhead.appendChars(s.tmpInputChars, ebiten.Tick())
+	if !ok {
+		return false
+	}
+
+	current := s.popupMenu.SelectedItemIndex()
+	query := s.typeAhead.queryString()
+	start := current + 1
+	if s.typeAhead.isRepeatedRune() {
+		query = string(s.typeAhead.query[0])
+	} else if continued && current >= 0 {
+		start = current
+	}
+
+	collator := collatorForLocale(context.FirstLocale())
+	next := nextMatchingIndex(len(s.items), start, func(index int) bool {
+		item := s.items[index]
+		if item.Header || item.Unselectable || item.Border || item.Disabled {
+			return false
+		}
+		return hasPrefixCollated(collator, item.Text, query)
+	})
+	if next >= 0 && next != current {
+		s.popupMenu.SelectItemByIndex(next)
+		guigui.DispatchEvent(s, selectEventItemSelected, next)
+	}
+	return true
+}
+
+func (s *Select[T]) openPopupMenu() {
+	s.popupMenu.SetOpen(true)
+	s.indexAtOpen = s.popupMenu.SelectedItemIndex()
+}
+
 func (s *Select[T]) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
 	layouter.LayoutWidget(&s.button, widgetBounds.Bounds())
 
@@ -129,7 +204,10 @@ func (s *Select[T]) Layout(context *guigui.Context, widgetBounds *guigui.WidgetB
 	p.X -= listItemCheckmarkSize(context) + listItemTextAndImagePadding(context)
 	p.X = max(p.X, 0)
 	// TODO: The item content in a button and a select might have different heights. Handle this case properly.
-	if y, ok := s.popupMenu.itemYFromIndexForMenu(context, max(0, s.popupMenu.SelectedItemIndex())); ok {
+	// With the filter field, the popup menu doesn't follow the selected item, as the items move while being filtered.
+	if s.popupMenu.IsFilterVisible() {
+		p.X = widgetBounds.Bounds().Min.X
+	} else if y, ok := s.popupMenu.itemYFromIndexForMenu(context, max(0, s.popupMenu.SelectedItemIndex())); ok {
 		p.Y -= y
 	}
 	p.Y = max(p.Y, 0)
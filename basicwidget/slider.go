@@ -9,7 +9,6 @@ import (
 	"math/big"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
 	"github.com/hajimehoshi/ebiten/v2/vector"
 
 	"github.com/guigui-gui/guigui"
@@ -18,10 +17,21 @@ import (
 )
 
 var (
-	sliderEventValueChanged       guigui.EventKey = guigui.GenerateEventKey()
-	sliderEventValueChangedBigInt guigui.EventKey = guigui.GenerateEventKey()
-	sliderEventValueChangedInt64  guigui.EventKey = guigui.GenerateEventKey()
-	sliderEventValueChangedUint64 guigui.EventKey = guigui.GenerateEventKey()
+	sliderEventValueChanged        guigui.EventKey = guigui.GenerateEventKey()
+	sliderEventValueChangedBigInt  guigui.EventKey = guigui.GenerateEventKey()
+	sliderEventValueChangedInt64   guigui.EventKey = guigui.GenerateEventKey()
+	sliderEventValueChangedUint64  guigui.EventKey = guigui.GenerateEventKey()
+	sliderEventValueChangedFloat64 guigui.EventKey = guigui.GenerateEventKey()
+
+	sliderEventValueRangeChanged        guigui.EventKey = guigui.GenerateEventKey()
+	sliderEventValueRangeChangedFloat64 guigui.EventKey = guigui.GenerateEventKey()
+)
+
+type sliderThumb int
+
+const (
+	sliderThumbLow sliderThumb = iota
+	sliderThumbHigh
 )
 
 type Slider struct {
@@ -29,18 +39,30 @@ type Slider struct {
 
 	abstractNumberInput abstractNumberInput
 
-	snapOnly bool
+	direction    SliderDirection
+	rangeEnabled bool
+	highValue    big.Int
+	highValueSet bool
+	snapOnly     bool
 
-	dragging           bool
-	draggingStartValue big.Int
-	draggingStartX     int
+	tickLabelsVisible bool
+	tickLabels        guigui.WidgetSlice[*Text]
+	tickLabelRates    []float64
+
+	// activeThumb is the thumb moved by dragging or the keyboard.
+	activeThumb sliderThumb
+
+	dragging              bool
+	draggingStartValue    big.Int
+	draggingStartPosition int
 
 	prevThumbHovered bool
 
-	onValueChanged       func(value int, committed bool)
-	onValueChangedBigInt func(value *big.Int, committed bool)
-	onValueChangedInt64  func(value int64, committed bool)
-	onValueChangedUint64 func(value uint64, committed bool)
+	onValueChanged        func(value int, committed bool)
+	onValueChangedBigInt  func(value *big.Int, committed bool)
+	onValueChangedInt64   func(value int64, committed bool)
+	onValueChangedUint64  func(value uint64, committed bool)
+	onValueChangedFloat64 func(value float64, committed bool)
 }
 
 func (s *Slider) OnValueChanged(f func(context *guigui.Context, value int)) {
@@ -67,6 +89,14 @@ func (s *Slider) OnValueChangedUint64(f func(context *guigui.Context, value uint
 	})
 }
 
+// OnValueChangedFloat64 sets the event handler that is called when the value is changed.
+// This is useful for a slider with fraction digits. See [Slider.SetFractionDigits].
+func (s *Slider) OnValueChangedFloat64(f func(context *guigui.Context, value float64)) {
+	guigui.SetEventHandler(s, sliderEventValueChangedFloat64, func(context *guigui.Context, value float64, committed bool) {
+		f(context, value)
+	})
+}
+
 func (s *Slider) Value() int {
 	return s.abstractNumberInput.Value()
 }
@@ -83,6 +113,11 @@ func (s *Slider) ValueUint64() uint64 {
 	return s.abstractNumberInput.ValueUint64()
 }
 
+// ValueFloat64 returns the value including the fraction part.
+func (s *Slider) ValueFloat64() float64 {
+	return s.abstractNumberInput.ValueFloat64()
+}
+
 func (s *Slider) SetValue(value int) {
 	s.abstractNumberInput.SetValue(value, true)
 }
@@ -99,6 +134,12 @@ func (s *Slider) SetValueUint64(value uint64) {
 	s.abstractNumberInput.SetValueUint64(value, true)
 }
 
+// SetValueFloat64 sets the value.
+// The value is rounded to the fraction digits. See [Slider.SetFractionDigits].
+func (s *Slider) SetValueFloat64(value float64) {
+	s.abstractNumberInput.SetValueFloat64(value, true)
+}
+
 func (s *Slider) MinimumValueBigInt() *big.Int {
 	return s.abstractNumberInput.MinimumValueBigInt()
 }
@@ -119,6 +160,10 @@ func (s *Slider) SetMinimumValueUint64(minimum uint64) {
 	s.abstractNumberInput.SetMinimumValueUint64(minimum)
 }
 
+func (s *Slider) SetMinimumValueFloat64(minimum float64) {
+	s.abstractNumberInput.SetMinimumValueFloat64(minimum)
+}
+
 func (s *Slider) MaximumValueBigInt() *big.Int {
 	return s.abstractNumberInput.MaximumValueBigInt()
 }
@@ -139,6 +184,10 @@ func (s *Slider) SetMaximumValueUint64(maximum uint64) {
 	s.abstractNumberInput.SetMaximumValueUint64(maximum)
 }
 
+func (s *Slider) SetMaximumValueFloat64(maximum float64) {
+	s.abstractNumberInput.SetMaximumValueFloat64(maximum)
+}
+
 func (s *Slider) SetStep(step int) {
 	s.abstractNumberInput.SetStep(step)
 }
@@ -155,11 +204,125 @@ func (s *Slider) SetStepUint64(step uint64) {
 	s.abstractNumberInput.SetStepUint64(step)
 }
 
+func (s *Slider) SetStepFloat64(step float64) {
+	s.abstractNumberInput.SetStepFloat64(step)
+}
+
+// FractionDigits returns the number of digits after the decimal point.
+func (s *Slider) FractionDigits() int {
+	return s.abstractNumberInput.FractionDigits()
+}
+
+// SetFractionDigits sets the number of digits after the decimal point.
+// The value is a fixed-point number with the fraction digits, and the value, minimum, maximum and step are rounded to them.
+// The integer APIs like [Slider.Value] truncate the fraction part.
+// The default value is 0.
+func (s *Slider) SetFractionDigits(digits int) {
+	s.abstractNumberInput.SetFractionDigits(digits)
+}
+
+// SliderDirection represents the direction of a slider.
+type SliderDirection int
+
+const (
+	// SliderDirectionHorizontal indicates a slider whose minimum value is at the left.
+	SliderDirectionHorizontal SliderDirection = iota
+
+	// SliderDirectionVertical indicates a slider whose minimum value is at the bottom.
+	SliderDirectionVertical
+)
+
+// Direction returns the direction of the slider.
+// The default direction is [SliderDirectionHorizontal].
+func (s *Slider) Direction() SliderDirection {
+	return s.direction
+}
+
+// SetDirection sets the direction of the slider.
+// A vertical slider has the minimum value at the bottom.
+func (s *Slider) SetDirection(direction SliderDirection) {
+	s.direction = direction
+}
+
+// IsRangeEnabled reports whether the slider has two thumbs for a range.
+func (s *Slider) IsRangeEnabled() bool {
+	return s.rangeEnabled
+}
+
+// SetRangeEnabled sets whether the slider has two thumbs for a range.
+//
+// In the range mode, the low thumb represents the value like [Slider.Value],
+// and the high thumb represents the high value of the range. The thumbs cannot cross each other.
+// The high value is the maximum value until it is set by [Slider.SetValueRange].
+// The range mode requires both the minimum and the maximum values.
+func (s *Slider) SetRangeEnabled(enabled bool) {
+	s.rangeEnabled = enabled
+}
+
+// OnValueRangeChanged sets the event handler that is called when the value range is changed in the range mode.
+func (s *Slider) OnValueRangeChanged(f func(context *guigui.Context, low, high int)) {
+	guigui.SetEventHandler(s, sliderEventValueRangeChanged, f)
+}
+
+// OnValueRangeChangedFloat64 sets the event handler that is called when the value range is changed in the range mode.
+func (s *Slider) OnValueRangeChangedFloat64(f func(context *guigui.Context, low, high float64)) {
+	guigui.SetEventHandler(s, sliderEventValueRangeChangedFloat64, f)
+}
+
+// ValueRange returns the low and high values in the range mode.
+func (s *Slider) ValueRange() (low, high int) {
+	a := &s.abstractNumberInput
+	return a.Value(), bigIntToInt(a.toInt(s.highValueFixedPoint()))
+}
+
+// ValueRangeFloat64 returns the low and high values including the fraction parts in the range mode.
+func (s *Slider) ValueRangeFloat64() (low, high float64) {
+	a := &s.abstractNumberInput
+	return a.ValueFloat64(), fixedPointToFloat64(s.highValueFixedPoint(), a.fractionDigits)
+}
+
+// SetValueRange sets the low and high values in the range mode.
+// If high is less than low, high is adjusted to low.
+func (s *Slider) SetValueRange(low, high int) {
+	a := &s.abstractNumberInput
+	s.setValueRange(a.fromInt(big.NewInt(int64(low))), a.fromInt(big.NewInt(int64(high))))
+}
+
+// SetValueRangeFloat64 sets the low and high values in the range mode.
+// If high is less than low, high is adjusted to low.
+func (s *Slider) SetValueRangeFloat64(low, high float64) {
+	a := &s.abstractNumberInput
+	l := a.fromFloat64(low)
+	h := a.fromFloat64(high)
+	if l == nil || h == nil {
+		return
+	}
+	s.setValueRange(l, h)
+}
+
+// IsTickLabelsVisible reports whether labels are shown at the tick marks.
+func (s *Slider) IsTickLabelsVisible() bool {
+	return s.tickLabelsVisible
+}
+
+// SetTickLabelsVisible sets whether labels are shown at the tick marks.
+// Tick marks are shown at the step intervals when the step is set.
+// If there are too many tick marks, only some of them have labels.
+func (s *Slider) SetTickLabelsVisible(visible bool) {
+	s.tickLabelsVisible = visible
+}
+
 func (s *Slider) WriteStateKey(w *guigui.StateKeyWriter) {
 	s.abstractNumberInput.writeStateKey(w)
 	w.WriteBool(s.snapOnly)
 	w.WriteBool(s.dragging)
 	w.WriteBool(s.prevThumbHovered)
+	w.WriteUint64(uint64(s.direction))
+	w.WriteBool(s.rangeEnabled)
+	w.WriteString(s.highValue.Text(10))
+	w.WriteBool(s.highValueSet)
+	w.WriteBool(s.tickLabelsVisible)
+	w.WriteUint64(uint64(s.activeThumb))
 }
 
 func (s *Slider) SetSnapOnly(snapOnly bool) {
@@ -170,6 +333,92 @@ func (s *Slider) hasSnaps() bool {
 	return s.abstractNumberInput.stepSet
 }
 
+func (s *Slider) isRangeMode() bool {
+	return s.rangeEnabled && s.abstractNumberInput.minSet && s.abstractNumberInput.maxSet
+}
+
+// highValueFixedPoint returns the high value as a fixed-point number.
+// The high value is kept in [value, max].
+func (s *Slider) highValueFixedPoint() *big.Int {
+	a := &s.abstractNumberInput
+	if !a.maxSet {
+		return (&big.Int{}).Set(&a.value)
+	}
+	var v big.Int
+	if s.highValueSet {
+		v.Set(&s.highValue)
+	} else {
+		v.Set(&a.max)
+	}
+	if v.Cmp(&a.max) > 0 {
+		v.Set(&a.max)
+	}
+	if v.Cmp(&a.value) < 0 {
+		v.Set(&a.value)
+	}
+	return &v
+}
+
+func (s *Slider) setValueRange(low, high *big.Int) {
+	a := &s.abstractNumberInput
+	if high.Cmp(low) < 0 {
+		high = low
+	}
+	oldLow := (&big.Int{}).Set(&a.value)
+	oldHigh := s.highValueFixedPoint()
+	s.highValue.Set(high)
+	s.highValueSet = true
+	// Setting the low value dispatches the range events if the low value is changed.
+	a.setValue(low, false, true)
+	if a.value.Cmp(oldLow) == 0 && s.highValueFixedPoint().Cmp(oldHigh) != 0 {
+		s.dispatchValueRangeEvents()
+	}
+}
+
+func (s *Slider) dispatchValueRangeEvents() {
+	low, high := s.ValueRange()
+	guigui.DispatchEvent(s, sliderEventValueRangeChanged, low, high)
+	lowF, highF := s.ValueRangeFloat64()
+	guigui.DispatchEvent(s, sliderEventValueRangeChangedFloat64, lowF, highF)
+}
+
+// thumbValue returns the value of the thumb as a fixed-point number.
+func (s *Slider) thumbValue(thumb sliderThumb) *big.Int {
+	if thumb == sliderThumbHigh {
+		return s.highValueFixedPoint()
+	}
+	return (&big.Int{}).Set(&s.abstractNumberInput.value)
+}
+
+// setThumbValue sets the value of the thumb as a fixed-point number.
+// The thumbs cannot cross each other.
+func (s *Slider) setThumbValue(thumb sliderThumb, value *big.Int) {
+	a := &s.abstractNumberInput
+	if !s.isRangeMode() {
+		a.setValue(value, false, true)
+		return
+	}
+	switch thumb {
+	case sliderThumbLow:
+		if high := s.highValueFixedPoint(); value.Cmp(high) > 0 {
+			value = high
+		}
+		a.setValue(value, false, true)
+	case sliderThumbHigh:
+		v := (&big.Int{}).Set(value)
+		if v.Cmp(&a.value) < 0 {
+			v.Set(&a.value)
+		}
+		a.clamp(v)
+		old := s.highValueFixedPoint()
+		s.highValue.Set(v)
+		s.highValueSet = true
+		if s.highValueFixedPoint().Cmp(old) != 0 {
+			s.dispatchValueRangeEvents()
+		}
+	}
+}
+
 // roundDivBigInt sets z = round(x / y) with half-away-from-zero rounding.
 func roundDivBigInt(z, x, y *big.Int) {
 	var rem big.Int
@@ -188,10 +437,16 @@ func roundDivBigInt(z, x, y *big.Int) {
 	}
 }
 
+// sliderMaxTickLabelCount is the maximum number of tick labels.
+const sliderMaxTickLabelCount = 11
+
 func (s *Slider) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
 	if s.onValueChanged == nil {
 		s.onValueChanged = func(value int, committed bool) {
 			guigui.DispatchEvent(s, sliderEventValueChanged, value, committed)
+			if s.isRangeMode() {
+				s.dispatchValueRangeEvents()
+			}
 		}
 	}
 	s.abstractNumberInput.OnValueChanged(s.onValueChanged)
@@ -217,41 +472,122 @@ func (s *Slider) Build(context *guigui.Context, adder *guigui.ChildAdder) error
 	}
 	s.abstractNumberInput.OnValueChangedUint64(s.onValueChangedUint64)
 
+	if s.onValueChangedFloat64 == nil {
+		s.onValueChangedFloat64 = func(value float64, committed bool) {
+			guigui.DispatchEvent(s, sliderEventValueChangedFloat64, value, committed)
+		}
+	}
+	s.abstractNumberInput.OnValueChangedFloat64(s.onValueChangedFloat64)
+
+	s.buildTickLabels(context)
+	for i := range s.tickLabels.Len() {
+		adder.AddWidget(s.tickLabels.At(i))
+	}
+
 	return nil
 }
 
+func (s *Slider) buildTickLabels(context *guigui.Context) {
+	s.tickLabelRates = s.tickLabelRates[:0]
+
+	a := &s.abstractNumberInput
+	if !s.tickLabelsVisible || !s.hasSnaps() || a.step.Sign() <= 0 || !a.minSet || !a.maxSet || a.max.Cmp(&a.min) <= 0 {
+		s.tickLabels.SetLen(0)
+		return
+	}
+
+	// Label every stride-th tick so that the number of labels doesn't exceed sliderMaxTickLabelCount.
+	var tickCount big.Int
+	tickCount.Sub(&a.max, &a.min)
+	tickCount.Quo(&tickCount, &a.step)
+	var stride big.Int
+	stride.Add(&tickCount, big.NewInt(sliderMaxTickLabelCount-2))
+	stride.Quo(&stride, big.NewInt(sliderMaxTickLabelCount-1))
+	if stride.Sign() <= 0 {
+		stride.SetInt64(1)
+	}
+	var delta big.Int
+	delta.Mul(&stride, &a.step)
+
+	symbols := numberSymbolsForLocale(context.FirstLocale())
+	var values []string
+	var v big.Int
+	for v.Set(&a.min); v.Cmp(&a.max) <= 0; v.Add(&v, &delta) {
+		values = append(values, formatFixedPoint(&v, a.fractionDigits, symbols, false))
+		s.tickLabelRates = append(s.tickLabelRates, a.rateOf(&v))
+	}
+
+	s.tickLabels.SetLen(len(values))
+	for i, value := range values {
+		t := s.tickLabels.At(i)
+		t.SetValue(value)
+		t.SetScale(0.85)
+		t.SetTabular(true)
+		t.SetColor(basicwidgetdraw.TextColor(context.ColorMode(), context.IsEnabled(s)))
+	}
+}
+
+func (s *Slider) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
+	if s.tickLabels.Len() == 0 {
+		return
+	}
+
+	b := widgetBounds.Bounds()
+	tb := s.trackBounds(context, b)
+	var prev image.Rectangle
+	for i := range s.tickLabels.Len() {
+		t := s.tickLabels.At(i)
+		size := t.Measure(context, guigui.Constraints{})
+		p := int(s.pointAt(context, b, s.tickLabelRates[i]))
+		var r image.Rectangle
+		switch s.direction {
+		case SliderDirectionHorizontal:
+			x := min(max(p-size.X/2, b.Min.X), b.Max.X-size.X)
+			r = image.Rect(x, tb.Max.Y, x+size.X, tb.Max.Y+size.Y)
+		case SliderDirectionVertical:
+			y := min(max(p-size.Y/2, b.Min.Y), b.Max.Y-size.Y)
+			r = image.Rect(tb.Max.X, y, tb.Max.X+size.X, y+size.Y)
+		}
+		// Hide a label overlapping with the previous label.
+		if !prev.Empty() && r.Overlaps(prev) {
+			layouter.LayoutWidget(t, image.Rectangle{})
+			continue
+		}
+		layouter.LayoutWidget(t, r)
+		prev = r
+	}
+}
+
 func (s *Slider) Tick(context *guigui.Context, widgetBounds *guigui.WidgetBounds) error {
 	s.prevThumbHovered = s.isThumbHovered(context, widgetBounds)
 	return nil
 }
 
 func (s *Slider) HandlePointingInput(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
-	max := s.abstractNumberInput.MaximumValueBigInt()
-	min := s.abstractNumberInput.MinimumValueBigInt()
-	if max == nil || min == nil {
+	if !s.abstractNumberInput.minSet || !s.abstractNumberInput.maxSet {
 		return guigui.HandleInputResult{}
 	}
 
-	if context.IsEnabled(s) && widgetBounds.IsHitAtCursor() && inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) && !s.dragging {
+	if context.IsEnabled(s) && widgetBounds.IsHitAtCursor() && guigui.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) && !s.dragging {
 		context.SetFocused(s, true)
-		if !s.isThumbHovered(context, widgetBounds) {
+		s.activeThumb = s.thumbAtCursor(context, widgetBounds)
+		if !s.isThumbHoveredAt(context, widgetBounds, s.activeThumb) {
 			s.setValueFromCursor(context, widgetBounds)
 		}
 		s.dragging = true
-		x, _ := ebiten.CursorPosition()
-		s.draggingStartX = x
-		s.draggingStartValue.Set(s.abstractNumberInput.ValueBigInt())
+		s.draggingStartPosition = s.cursorPositionOnBar(context, widgetBounds.Bounds())
+		s.draggingStartValue.Set(s.thumbValue(s.activeThumb))
 		return guigui.HandleInputByWidget(s)
 	}
 
-	if !context.IsEnabled(s) || !ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
+	if !context.IsEnabled(s) || !guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
 		s.dragging = false
-		s.draggingStartX = 0
+		s.draggingStartPosition = 0
 		s.draggingStartValue = big.Int{}
 		return guigui.HandleInputResult{}
 	}
 
-	if context.IsEnabled(s) && s.dragging && ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
+	if context.IsEnabled(s) && s.dragging && guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
 		s.setValueFromCursorDelta(context, widgetBounds)
 		return guigui.HandleInputByWidget(s)
 	}
@@ -259,33 +595,66 @@ func (s *Slider) HandlePointingInput(context *guigui.Context, widgetBounds *guig
 	return guigui.HandleInputResult{}
 }
 
+// HandleButtonInput implements [guigui.Widget.HandleButtonInput].
+func (s *Slider) HandleButtonInput(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
+	a := &s.abstractNumberInput
+	if !context.IsEnabled(s) || !context.IsFocused(s) || !a.minSet || !a.maxSet {
+		return guigui.HandleInputResult{}
+	}
+
+	var step big.Int
+	if a.stepSet && a.step.Sign() > 0 {
+		step.Set(&a.step)
+	} else {
+		step.Set(a.fromInt(big.NewInt(1)))
+	}
+
+	switch {
+	case isKeyRepeating(ebiten.KeyRight), isKeyRepeating(ebiten.KeyUp):
+		s.setThumbValue(s.activeThumb, step.Add(s.thumbValue(s.activeThumb), &step))
+	case isKeyRepeating(ebiten.KeyLeft), isKeyRepeating(ebiten.KeyDown):
+		s.setThumbValue(s.activeThumb, step.Sub(s.thumbValue(s.activeThumb), &step))
+	case guigui.IsKeyJustPressed(ebiten.KeyHome):
+		s.setThumbValue(s.activeThumb, &a.min)
+	case guigui.IsKeyJustPressed(ebiten.KeyEnd):
+		s.setThumbValue(s.activeThumb, &a.max)
+	case s.isRangeMode() && guigui.IsKeyJustPressed(ebiten.KeyTab) && !guigui.IsKeyPressed(ebiten.KeyShift) && s.activeThumb == sliderThumbLow:
+		// Move the focus from the low thumb to the high thumb before moving to the next widget.
+		s.activeThumb = sliderThumbHigh
+	case s.isRangeMode() && guigui.IsKeyJustPressed(ebiten.KeyTab) && guigui.IsKeyPressed(ebiten.KeyShift) && s.activeThumb == sliderThumbHigh:
+		s.activeThumb = sliderThumbLow
+	default:
+		return guigui.HandleInputResult{}
+	}
+	return guigui.HandleInputByWidget(s)
+}
+
 func (s *Slider) setValueFromCursorDelta(context *guigui.Context, widgetBounds *guigui.WidgetBounds) {
-	s.setValue(context, widgetBounds, &s.draggingStartValue, s.draggingStartX)
+	s.setValue(context, widgetBounds, &s.draggingStartValue, s.draggingStartPosition)
 }
 
 func (s *Slider) setValueFromCursor(context *guigui.Context, widgetBounds *guigui.WidgetBounds) {
-	min := s.abstractNumberInput.MinimumValueBigInt()
-	if min == nil {
+	if !s.abstractNumberInput.minSet {
 		return
 	}
-
-	b := widgetBounds.Bounds()
-	minX := b.Min.X + (b.Dx()-s.barWidth(context, widgetBounds))/2
-	s.setValue(context, widgetBounds, min, minX)
+	s.setValue(context, widgetBounds, &s.abstractNumberInput.min, 0)
 }
 
-func (s *Slider) setValue(context *guigui.Context, widgetBounds *guigui.WidgetBounds, originValue *big.Int, originX int) {
-	max := s.abstractNumberInput.MaximumValueBigInt()
-	min := s.abstractNumberInput.MinimumValueBigInt()
-	if max == nil || min == nil {
+// setValue sets the active thumb's value from the cursor position.
+// originValue is the value at originPosition on the bar.
+func (s *Slider) setValue(context *guigui.Context, widgetBounds *guigui.WidgetBounds, originValue *big.Int, originPosition int) {
+	if !s.abstractNumberInput.minSet || !s.abstractNumberInput.maxSet {
 		return
 	}
+	max := &s.abstractNumberInput.max
+	min := &s.abstractNumberInput.min
 
-	barWidth := int64(s.barWidth(context, widgetBounds))
-	if barWidth <= 0 {
+	b := widgetBounds.Bounds()
+	barLength := int64(s.barLength(context, b))
+	if barLength <= 0 {
 		return
 	}
-	c := image.Pt(ebiten.CursorPosition())
+	c := s.cursorPositionOnBar(context, b)
 
 	var v big.Int
 	if s.snapOnly && s.hasSnaps() && s.abstractNumberInput.step.Sign() > 0 {
@@ -293,13 +662,13 @@ func (s *Slider) setValue(context *guigui.Context, widgetBounds *guigui.WidgetBo
 		step := &s.abstractNumberInput.step
 		var num, tmp big.Int
 		num.Sub(max, min)
-		num.Mul(&num, big.NewInt(int64(c.X-originX)))
+		num.Mul(&num, big.NewInt(int64(c-originPosition)))
 		tmp.Sub(originValue, min)
-		tmp.Mul(&tmp, big.NewInt(barWidth))
+		tmp.Mul(&tmp, big.NewInt(barLength))
 		num.Add(&num, &tmp)
 
 		var den big.Int
-		den.Mul(big.NewInt(barWidth), step)
+		den.Mul(big.NewInt(barLength), step)
 
 		var snapIndex big.Int
 		roundDivBigInt(&snapIndex, &num, &den)
@@ -312,43 +681,119 @@ func (s *Slider) setValue(context *guigui.Context, widgetBounds *guigui.WidgetBo
 		// shrinking the rightmost value's zone to a sliver at the bar end.
 		var num big.Int
 		num.Sub(max, min)
-		num.Mul(&num, big.NewInt(int64(c.X-originX)))
-		roundDivBigInt(&v, &num, big.NewInt(barWidth))
+		num.Mul(&num, big.NewInt(int64(c-originPosition)))
+		roundDivBigInt(&v, &num, big.NewInt(barLength))
 		v.Add(&v, originValue)
 	}
-	s.abstractNumberInput.SetValueBigInt(&v, true)
+	s.setThumbValue(s.activeThumb, &v)
+}
+
+// trackBounds returns the bounds for the bar and the thumbs, excluding the tick labels.
+func (s *Slider) trackBounds(context *guigui.Context, bounds image.Rectangle) image.Rectangle {
+	if s.tickLabels.Len() == 0 {
+		return bounds
+	}
+	u := UnitSize(context)
+	switch s.direction {
+	case SliderDirectionHorizontal:
+		bounds.Max.Y = min(bounds.Max.Y, bounds.Min.Y+u)
+	case SliderDirectionVertical:
+		bounds.Max.X = min(bounds.Max.X, bounds.Min.X+u)
+	}
+	return bounds
 }
 
-func (s *Slider) barWidth(context *guigui.Context, widgetBounds *guigui.WidgetBounds) int {
-	w := widgetBounds.Bounds().Dx()
-	return w - 2*sliderThumbRadius(context)
+// barLength returns the length of the bar where the thumbs' centers can move.
+func (s *Slider) barLength(context *guigui.Context, bounds image.Rectangle) int {
+	switch s.direction {
+	case SliderDirectionVertical:
+		return bounds.Dy() - 2*sliderThumbRadius(context)
+	default:
+		return bounds.Dx() - 2*sliderThumbRadius(context)
+	}
+}
+
+// pointAt returns the coordinate along the bar for the rate.
+// The coordinate is X for a horizontal slider, and Y for a vertical slider.
+func (s *Slider) pointAt(context *guigui.Context, bounds image.Rectangle, rate float64) float64 {
+	l := float64(s.barLength(context, bounds)) * rate
+	radius := float64(sliderThumbRadius(context))
+	switch s.direction {
+	case SliderDirectionVertical:
+		return float64(bounds.Max.Y) - radius - l
+	default:
+		return float64(bounds.Min.X) + radius + l
+	}
+}
+
+// cursorPositionOnBar returns the cursor position along the bar from the minimum value's point.
+func (s *Slider) cursorPositionOnBar(context *guigui.Context, bounds image.Rectangle) int {
+	x, y := guigui.CursorPosition()
+	radius := sliderThumbRadius(context)
+	switch s.direction {
+	case SliderDirectionVertical:
+		return bounds.Max.Y - radius - y
+	default:
+		return x - bounds.Min.X - radius
+	}
+}
+
+// thumbAtCursor returns the thumb to be moved by the cursor.
+func (s *Slider) thumbAtCursor(context *guigui.Context, widgetBounds *guigui.WidgetBounds) sliderThumb {
+	if !s.isRangeMode() {
+		return sliderThumbLow
+	}
+	b := widgetBounds.Bounds()
+	c := float64(s.cursorPositionOnBar(context, b))
+	l := float64(s.barLength(context, b))
+	lowPos := s.abstractNumberInput.Rate() * l
+	highPos := s.abstractNumberInput.rateOf(s.highValueFixedPoint()) * l
+	if lowPos == highPos {
+		// The thumbs overlap. Pick the one that can move toward the cursor.
+		if c > highPos || (c == highPos && highPos < l) {
+			return sliderThumbHigh
+		}
+		return sliderThumbLow
+	}
+	if math.Abs(c-lowPos) <= math.Abs(c-highPos) {
+		return sliderThumbLow
+	}
+	return sliderThumbHigh
 }
 
 func sliderThumbRadius(context *guigui.Context) int {
 	return int(UnitSize(context) * 7 / 16)
 }
 
-func (s *Slider) thumbBounds(context *guigui.Context, widgetBounds *guigui.WidgetBounds) image.Rectangle {
-	rate := s.abstractNumberInput.Rate()
+func (s *Slider) thumbBounds(context *guigui.Context, widgetBounds *guigui.WidgetBounds, thumb sliderThumb) image.Rectangle {
+	rate := s.abstractNumberInput.rateOf(s.thumbValue(thumb))
 	if math.IsNaN(rate) {
 		return image.Rectangle{}
 	}
-	bounds := widgetBounds.Bounds()
+	tb := s.trackBounds(context, widgetBounds.Bounds())
 	radius := sliderThumbRadius(context)
+	p := int(s.pointAt(context, tb, rate))
 
+	// A thumb is a circle, or a narrow pill when the slider has snaps.
+	w, h := 2*radius, 2*radius
 	if s.hasSnaps() {
-		w := radius
-		h := 2 * radius
-		x := bounds.Min.X + int(rate*float64(s.barWidth(context, widgetBounds))) + radius - w/2
-		y := bounds.Min.Y + (bounds.Dy()-h)/2
+		switch s.direction {
+		case SliderDirectionHorizontal:
+			w = radius
+		case SliderDirectionVertical:
+			h = radius
+		}
+	}
+	switch s.direction {
+	case SliderDirectionVertical:
+		x := tb.Min.X + (tb.Dx()-w)/2
+		y := p - h/2
+		return image.Rect(x, y, x+w, y+h)
+	default:
+		x := p - w/2
+		y := tb.Min.Y + (tb.Dy()-h)/2
 		return image.Rect(x, y, x+w, y+h)
 	}
-
-	x := bounds.Min.X + int(rate*float64(s.barWidth(context, widgetBounds)))
-	y := bounds.Min.Y + (bounds.Dy()-2*radius)/2
-	w := 2 * radius
-	h := 2 * radius
-	return image.Rect(x, y, x+w, y+h)
 }
 
 func (s *Slider) CursorShape(context *guigui.Context, widgetBounds *guigui.WidgetBounds) (ebiten.CursorShapeType, bool) {
@@ -358,119 +803,182 @@ func (s *Slider) CursorShape(context *guigui.Context, widgetBounds *guigui.Widge
 	return 0, true
 }
 
+// barSegmentBounds returns the bounds of the bar between the coordinates p0 and p1 along the bar.
+func (s *Slider) barSegmentBounds(trackBounds image.Rectangle, p0, p1 int, halfWidth int) image.Rectangle {
+	if p0 > p1 {
+		p0, p1 = p1, p0
+	}
+	switch s.direction {
+	case SliderDirectionVertical:
+		cx := (trackBounds.Min.X + trackBounds.Max.X) / 2
+		return image.Rect(cx-halfWidth, p0, cx+halfWidth, p1)
+	default:
+		cy := (trackBounds.Min.Y + trackBounds.Max.Y) / 2
+		return image.Rect(p0, cy-halfWidth, p1, cy+halfWidth)
+	}
+}
+
 func (s *Slider) Draw(context *guigui.Context, widgetBounds *guigui.WidgetBounds, dst *ebiten.Image) {
 	rate := s.abstractNumberInput.Rate()
 
-	b := widgetBounds.Bounds()
+	tb := s.trackBounds(context, widgetBounds.Bounds())
 	strokeWidth := int(5 * context.Scale())
 	r := strokeWidth / 2
-	barY0 := (b.Min.Y+b.Max.Y)/2 - r
-	barY1 := (b.Min.Y+b.Max.Y)/2 + r
+	radius := sliderThumbRadius(context)
+
+	// The bar slightly extends beyond the thumbs' movable range.
+	start := int(s.pointAt(context, tb, 0))
+	end := int(s.pointAt(context, tb, 1))
+	switch s.direction {
+	case SliderDirectionVertical:
+		start += radius / 4
+		end -= radius / 4
+	default:
+		start -= radius / 4
+		end += radius / 4
+	}
 
-	barX0 := b.Min.X + sliderThumbRadius(context)*3/4
-	barX1 := barX0
+	// The bar is split into three segments: [start, on0), [on0, on1) and [on1, end).
+	// The middle segment is drawn in the accent color.
+	on0, on1 := start, start
 	if !math.IsNaN(rate) {
-		barX1 = b.Min.X + sliderThumbRadius(context) + int(float64(s.barWidth(context, widgetBounds))*float64(rate))
+		on1 = int(s.pointAt(context, tb, rate))
+		if s.isRangeMode() {
+			on0 = on1
+			on1 = int(s.pointAt(context, tb, s.abstractNumberInput.rateOf(s.highValueFixedPoint())))
+		}
 	}
-	barX2 := b.Max.X - sliderThumbRadius(context)*3/4
 
 	bgColorOn := draw.Color(context.ColorMode(), draw.SemanticColorAccent, 0.5)
 	bgColorOff := draw.Color(context.ColorMode(), draw.SemanticColorBase, 0.8)
 	if !context.IsEnabled(s) {
 		bgColorOn = bgColorOff
 	}
+	borderClr1, borderClr2 := basicwidgetdraw.BorderColors(context.ColorMode(), basicwidgetdraw.RoundedRectBorderTypeInset)
 
-	if barX0 < barX1 {
-		b := image.Rect(barX0, barY0, barX1, barY1)
+	if start != on0 {
+		b := s.barSegmentBounds(tb, start, on0, r)
+		basicwidgetdraw.DrawRoundedRect(context, dst, b, bgColorOff, r)
+		basicwidgetdraw.DrawRoundedRectBorder(context, dst, b, borderClr1, borderClr2, r, float32(1*context.Scale()), basicwidgetdraw.RoundedRectBorderTypeInset)
+	}
+
+	if on0 != on1 {
+		b := s.barSegmentBounds(tb, on0, on1, r)
 		basicwidgetdraw.DrawRoundedRect(context, dst, b, bgColorOn, r)
 
 		if !context.IsEnabled(s) {
-			borderClr1, borderClr2 := basicwidgetdraw.BorderColors(context.ColorMode(), basicwidgetdraw.RoundedRectBorderTypeInset)
 			basicwidgetdraw.DrawRoundedRectBorder(context, dst, b, borderClr1, borderClr2, r, float32(1*context.Scale()), basicwidgetdraw.RoundedRectBorderTypeInset)
 		}
 	}
 
-	if barX1 < barX2 {
-		b := image.Rect(barX1, barY0, barX2, barY1)
+	if on1 != end {
+		b := s.barSegmentBounds(tb, on1, end, r)
 		basicwidgetdraw.DrawRoundedRect(context, dst, b, bgColorOff, r)
-
-		borderClr1, borderClr2 := basicwidgetdraw.BorderColors(context.ColorMode(), basicwidgetdraw.RoundedRectBorderTypeInset)
 		basicwidgetdraw.DrawRoundedRectBorder(context, dst, b, borderClr1, borderClr2, r, float32(1*context.Scale()), basicwidgetdraw.RoundedRectBorderTypeInset)
 	}
 
 	// Draw gauge marks at snap positions.
-	if s.hasSnaps() && s.abstractNumberInput.minSet && s.abstractNumberInput.maxSet {
+	if s.hasSnaps() && s.abstractNumberInput.minSet && s.abstractNumberInput.maxSet && s.abstractNumberInput.step.Sign() > 0 {
 		step := &s.abstractNumberInput.step
 		min := &s.abstractNumberInput.min
 		max := &s.abstractNumberInput.max
 
-		barW := s.barWidth(context, widgetBounds)
-		barStartX := b.Min.X + sliderThumbRadius(context)
-		radius := sliderThumbRadius(context)
 		gap := float32(2 * context.Scale())
-
-		barTop := float32(barY0)
-		barBottom := float32(barY1)
+		barBounds := s.barSegmentBounds(tb, start, end, r)
 
 		tickColor := draw.Color(context.ColorMode(), draw.SemanticColorBase, 0.7)
 		tickWidth := float32(2 * context.Scale())
 		tickHeight := float32(radius) / 2
 
-		var denom big.Int
-		denom.Sub(max, min)
-		if denom.Sign() > 0 {
+		if max.Cmp(min) > 0 {
 			var pos big.Int
 			for pos.Set(min); pos.Cmp(max) <= 0; pos.Add(&pos, step) {
-				var numer big.Int
-				numer.Sub(&pos, min)
-				tickRate, _ := (&big.Rat{}).Quo(
-					(&big.Rat{}).SetInt(&numer),
-					(&big.Rat{}).SetInt(&denom),
-				).Float64()
-
-				tx := float32(barStartX) + float32(float64(barW)*tickRate)
-				vector.StrokeLine(dst,
-					tx, barTop-tickHeight,
-					tx, barTop-gap,
-					tickWidth, tickColor, true)
-				vector.StrokeLine(dst,
-					tx, barBottom+gap,
-					tx, barBottom+tickHeight,
-					tickWidth, tickColor, true)
+				p := float32(s.pointAt(context, tb, s.abstractNumberInput.rateOf(&pos)))
+				switch s.direction {
+				case SliderDirectionVertical:
+					left := float32(barBounds.Min.X)
+					right := float32(barBounds.Max.X)
+					vector.StrokeLine(dst,
+						left-tickHeight, p,
+						left-gap, p,
+						tickWidth, tickColor, true)
+					vector.StrokeLine(dst,
+						right+gap, p,
+						right+tickHeight, p,
+						tickWidth, tickColor, true)
+				default:
+					top := float32(barBounds.Min.Y)
+					bottom := float32(barBounds.Max.Y)
+					vector.StrokeLine(dst,
+						p, top-tickHeight,
+						p, top-gap,
+						tickWidth, tickColor, true)
+					vector.StrokeLine(dst,
+						p, bottom+gap,
+						p, bottom+tickHeight,
+						tickWidth, tickColor, true)
+				}
 			}
 		}
 	}
 
-	if thumbBounds := s.thumbBounds(context, widgetBounds); !thumbBounds.Empty() {
-		cm := context.ColorMode()
-		thumbColor := basicwidgetdraw.ThumbColor(context.ColorMode(), context.IsEnabled(s))
+	s.drawThumb(context, widgetBounds, dst, sliderThumbLow)
+	if s.isRangeMode() {
+		s.drawThumb(context, widgetBounds, dst, sliderThumbHigh)
+	}
+}
+
+func (s *Slider) drawThumb(context *guigui.Context, widgetBounds *guigui.WidgetBounds, dst *ebiten.Image, thumb sliderThumb) {
+	thumbBounds := s.thumbBounds(context, widgetBounds, thumb)
+	if thumbBounds.Empty() {
+		return
+	}
+	cm := context.ColorMode()
+	thumbColor := basicwidgetdraw.ThumbColor(context.ColorMode(), context.IsEnabled(s))
+	if s.activeThumb == thumb {
 		if s.isActive(context, widgetBounds) {
 			thumbColor = draw.Color2(cm, draw.SemanticColorBase, 0.95, 0.55)
 		} else if s.canPress(context, widgetBounds) {
 			thumbColor = draw.Color2(cm, draw.SemanticColorBase, 0.975, 0.575)
 		}
-		thumbClr1, thumbClr2 := basicwidgetdraw.BorderColors(context.ColorMode(), basicwidgetdraw.RoundedRectBorderTypeOutset)
-		r := thumbBounds.Dy() / 2
-		if s.hasSnaps() {
-			r = thumbBounds.Dx() / 2
-		}
-		basicwidgetdraw.DrawRoundedRect(context, dst, thumbBounds, thumbColor, r)
-		basicwidgetdraw.DrawRoundedRectBorder(context, dst, thumbBounds, thumbClr1, thumbClr2, r, float32(1*context.Scale()), basicwidgetdraw.RoundedRectBorderTypeOutset)
 	}
+	thumbClr1, thumbClr2 := basicwidgetdraw.BorderColors(context.ColorMode(), basicwidgetdraw.RoundedRectBorderTypeOutset)
+	r := min(thumbBounds.Dx(), thumbBounds.Dy()) / 2
+	basicwidgetdraw.DrawRoundedRect(context, dst, thumbBounds, thumbColor, r)
+	basicwidgetdraw.DrawRoundedRectBorder(context, dst, thumbBounds, thumbClr1, thumbClr2, r, float32(1*context.Scale()), basicwidgetdraw.RoundedRectBorderTypeOutset)
 }
 
 func (s *Slider) canPress(context *guigui.Context, widgetBounds *guigui.WidgetBounds) bool {
-	return context.IsEnabled(s) && s.isThumbHovered(context, widgetBounds) && !ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft) && !s.dragging
+	return context.IsEnabled(s) && s.isThumbHovered(context, widgetBounds) && !guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft) && !s.dragging
 }
 
 func (s *Slider) isThumbHovered(context *guigui.Context, widgetBounds *guigui.WidgetBounds) bool {
-	return widgetBounds.IsHitAtCursor() && image.Pt(ebiten.CursorPosition()).In(s.thumbBounds(context, widgetBounds))
+	if s.isThumbHoveredAt(context, widgetBounds, sliderThumbLow) {
+		return true
+	}
+	return s.isRangeMode() && s.isThumbHoveredAt(context, widgetBounds, sliderThumbHigh)
+}
+
+func (s *Slider) isThumbHoveredAt(context *guigui.Context, widgetBounds *guigui.WidgetBounds, thumb sliderThumb) bool {
+	return widgetBounds.IsHitAtCursor() && image.Pt(guigui.CursorPosition()).In(s.thumbBounds(context, widgetBounds, thumb))
 }
 
 func (s *Slider) isActive(context *guigui.Context, widgetBounds *guigui.WidgetBounds) bool {
-	return context.IsEnabled(s) && s.isThumbHovered(context, widgetBounds) && ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft) && s.dragging
+	return context.IsEnabled(s) && s.isThumbHovered(context, widgetBounds) && guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft) && s.dragging
 }
 
 func (s *Slider) Measure(context *guigui.Context, constraints guigui.Constraints) image.Point {
-	return image.Pt(6*UnitSize(context), UnitSize(context))
+	u := UnitSize(context)
+	var labelSize image.Point
+	for i := range s.tickLabels.Len() {
+		size := s.tickLabels.At(i).Measure(context, guigui.Constraints{})
+		labelSize.X = max(labelSize.X, size.X)
+		labelSize.Y = max(labelSize.Y, size.Y)
+	}
+	switch s.direction {
+	case SliderDirectionVertical:
+		return image.Pt(u+labelSize.X, 6*u)
+	default:
+		return image.Pt(6*u, u+labelSize.Y)
+	}
 }
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package basicwidget_test
+
+import (
+	"testing"
+
+	"github.com/guigui-gui/guigui/basicwidget"
+)
+
+func TestSliderValueRange(t *testing.T) {
+	type thumbMove struct {
+		high  bool
+		value int
+	}
+	testCases := []struct {
+		name     string
+		setRange bool
+		low      int
+		high     int
+		moves    []thumbMove
+		maximum  int
+		wantLow  int
+		wantHigh int
+	}{
+		{
+			name:     "default",
+			wantLow:  0,
+			wantHigh: 100,
+		},
+		{
+			name:     "set",
+			setRange: true,
+			low:      20,
+			high:     80,
+			wantLow:  20,
+			wantHigh: 80,
+		},
+		{
+			name:     "reversed",
+			setRange: true,
+			low:      80,
+			high:     20,
+			wantLow:  80,
+			wantHigh: 80,
+		},
+		{
+			name:     "out of range",
+			setRange: true,
+			low:      -10,
+			high:     200,
+			wantLow:  0,
+			wantHigh: 100,
+		},
+		{
+			name:     "low thumb cannot pass high thumb",
+			setRange: true,
+			low:      20,
+			high:     80,
+			moves:    []thumbMove{{high: false, value: 90}},
+			wantLow:  80,
+			wantHigh: 80,
+		},
+		{
+			name:     "high thumb cannot pass low thumb",
+			setRange: true,
+			low:      20,
+			high:     80,
+			moves:    []thumbMove{{high: true, value: 10}},
+			wantLow:  20,
+			wantHigh: 20,
+		},
+		{
+			name:     "thumbs clamped",
+			setRange: true,
+			low:      20,
+			high:     80,
+			moves:    []thumbMove{{high: false, value: -50}, {high: true, value: 150}},
+			wantLow:  0,
+			wantHigh: 100,
+		},
+		{
+			name:     "maximum below high",
+			setRange: true,
+			low:      20,
+			high:     80,
+			maximum:  50,
+			wantLow:  20,
+			wantHigh: 50,
+		},
+		{
+			name:     "maximum below low",
+			setRange: true,
+			low:      20,
+			high:     80,
+			maximum:  10,
+			wantLow:  10,
+			wantHigh: 10,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var s basicwidget.Slider
+			s.SetMinimumValue(0)
+			s.SetMaximumValue(100)
+			s.SetRangeEnabled(true)
+			if tc.setRange {
+				s.SetValueRange(tc.low, tc.high)
+			}
+			for _, m := range tc.moves {
+				basicwidget.SetSliderThumbValue(&s, m.high, m.value)
+			}
+			if tc.maximum != 0 {
+				s.SetMaximumValue(tc.maximum)
+			}
+			if low, high := s.ValueRange(); low != tc.wantLow || high != tc.wantHigh {
+				t.Errorf("got: (%d, %d), want: (%d, %d)", low, high, tc.wantLow, tc.wantHigh)
+			}
+		})
+	}
+}
+
+func TestSliderValueRangeFloat64(t *testing.T) {
+	var s basicwidget.Slider
+	s.SetFractionDigits(1)
+	s.SetMinimumValue(0)
+	s.SetMaximumValue(10)
+	s.SetRangeEnabled(true)
+	s.SetValueRangeFloat64(1.25, 3.5)
+	if low, high := s.ValueRangeFloat64(); low != 1.3 || high != 3.5 {
+		t.Errorf("got: (%v, %v), want: (1.3, 3.5)", low, high)
+	}
+	if low, high := s.ValueRange(); low != 1 || high != 3 {
+		t.Errorf("got: (%d, %d), want: (1, 3)", low, high)
+	}
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package basicwidget
+
+import (
+	"image"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+
+	"github.com/guigui-gui/guigui"
+	"github.com/guigui-gui/guigui/basicwidget/basicwidgetdraw"
+	"github.com/guigui-gui/guigui/basicwidget/internal/draw"
+)
+
+const spinnerSpokeCount = 12
+
+// Spinner is a busy indicator that shows that an operation of unknown length is in progress.
+//
+// The animation is advanced only while the widget is in the widget tree and visible.
+// To stop the animation, remove the widget from the tree.
+type Spinner struct {
+	guigui.DefaultWidget
+
+	semanticColor basicwidgetdraw.SemanticColor
+
+	count int
+}
+
+// SetSemanticColor sets the color of the spinner.
+// [basicwidgetdraw.SemanticColorBase] means the text color.
+func (s *Spinner) SetSemanticColor(semanticColor basicwidgetdraw.SemanticColor) {
+	if s.semanticColor == semanticColor {
+		return
+	}
+	s.semanticColor = semanticColor
+	guigui.RequestRedraw(s)
+}
+
+func (s *Spinner) WriteStateKey(w *guigui.StateKeyWriter) {
+	w.WriteInt(int(s.semanticColor))
+}
+
+func (s *Spinner) Tick(context *guigui.Context, widgetBounds *guigui.WidgetBounds) error {
+	if !context.IsVisible(s) || widgetBounds.VisibleBounds().Empty() {
+		return nil
+	}
+	prevStep := s.step()
+	s.count = (s.count + 1) % spinnerMaxCount()
+	// The spinner moves step by step, so redraw it only when the step changes.
+	if s.step() != prevStep {
+		guigui.RequestRedraw(s)
+	}
+	return nil
+}
+
+func spinnerMaxCount() int {
+	return ebiten.TPS()
+}
+
+func (s *Spinner) step() int {
+	return s.count * spinnerSpokeCount / spinnerMaxCount()
+}
+
+func (s *Spinner) Draw(context *guigui.Context, widgetBounds *guigui.WidgetBounds, dst *ebiten.Image) {
+	bounds := widgetBounds.Bounds()
+	size := float32(min(bounds.Dx(), bounds.Dy()))
+	cx := float32(bounds.Min.X+bounds.Max.X) / 2
+	cy := float32(bounds.Min.Y+bounds.Max.Y) / 2
+	strokeWidth := size / 10
+	outer := size/2 - strokeWidth/2
+	inner := outer / 2
+
+	cm := context.ColorMode()
+	clr := basicwidgetdraw.TextColorFromSemanticColor(cm, s.semanticColor)
+	if !context.IsEnabled(s) {
+		clr = basicwidgetdraw.TextColor(cm, false)
+	}
+
+	step := s.step()
+	var path vector.Path
+	for i := range spinnerSpokeCount {
+		path.Reset()
+		// The spoke at the current step is the most opaque, and the preceding spokes fade out.
+		alpha := 1 - float64((step-i+spinnerSpokeCount)%spinnerSpokeCount)/spinnerSpokeCount
+		angle := 2*math.Pi*float64(i)/spinnerSpokeCount - math.Pi/2
+		sin, cos := math.Sincos(angle)
+		path.MoveTo(cx+inner*float32(cos), cy+inner*float32(sin))
+		path.LineTo(cx+outer*float32(cos), cy+outer*float32(sin))
+		strokeOp := &vector.StrokeOptions{}
+		strokeOp.Width = strokeWidth
+		strokeOp.LineCap = vector.LineCapRound
+		drawOp := &vector.DrawPathOptions{}
+		drawOp.AntiAlias = true
+		drawOp.ColorScale.ScaleWithColor(draw.ScaleAlpha(clr, alpha))
+		vector.StrokePath(dst, &path, strokeOp, drawOp)
+	}
+}
+
+func (s *Spinner) Measure(context *guigui.Context, constraints guigui.Constraints) image.Point {
+	u := UnitSize(context)
+	return image.Pt(u, u)
+}
@@ -0,0 +1,628 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package basicwidget
+
+import (
+	"image"
+	"slices"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+
+	"github.com/guigui-gui/guigui"
+	"github.com/guigui-gui/guigui/basicwidget/internal/draw"
+)
+
+var (
+	splitPanelEventPaneSizesChanged     guigui.EventKey = guigui.GenerateEventKey()
+	splitPanelEventPaneCollapsedChanged guigui.EventKey = guigui.GenerateEventKey()
+)
+
+// SplitPanelPane is a pane of a [SplitPanel].
+type SplitPanelPane struct {
+	// Widget is the content of the pane.
+	Widget guigui.Widget
+
+	// MaxSize is the maximum size of the pane along the split direction in device-independent pixels.
+	// If MaxSize is 0, the size is not limited.
+	//
+	// The minimum size of the pane is the size measured by the widget's Measure.
+	MaxSize int
+
+	// Flexible reports whether the pane takes the remaining space.
+	// If no pane is flexible, the last pane that is not collapsed takes the remaining space.
+	Flexible bool
+
+	// Collapsible reports whether the pane can be collapsed by double-clicking an adjacent divider.
+	Collapsible bool
+}
+
+// SplitPanel is a container that arranges panes in a row or a column with draggable dividers between them.
+type SplitPanel struct {
+	guigui.DefaultWidget
+
+	panes     []SplitPanelPane
+	dividers  guigui.WidgetSlice[*splitPanelDivider]
+	direction guigui.LayoutDirection
+
+	// sizes are the requested sizes of the panes in device-independent pixels. 0 means the measured size.
+	// The size of the flexible pane is determined by the other panes.
+	sizes     []int
+	collapsed []bool
+
+	// actualSizes are the sizes of the panes in pixels at the last layout.
+	actualSizes      []int
+	dividerPositions []int
+	bounds           image.Rectangle
+	scale            float64
+
+	draggingDividerIndexPlus1 int
+	draggingStartPosition     int
+	draggingStartSizes        []int
+
+	tmpRequestedSizes []int
+	tmpMinSizes       []int
+	tmpMaxSizes       []int
+}
+
+// OnPaneSizesChanged sets the event handler that is called when the pane sizes are changed by dragging a divider.
+// sizes are in device-independent pixels, like the result of [SplitPanel.PaneSizes].
+func (s *SplitPanel) OnPaneSizesChanged(f func(context *guigui.Context, sizes []int)) {
+	guigui.SetEventHandler(s, splitPanelEventPaneSizesChanged, f)
+}
+
+// OnPaneCollapsedChanged sets the event handler that is called when a pane is collapsed or restored by double-clicking a divider.
+func (s *SplitPanel) OnPaneCollapsedChanged(f func(context *guigui.Context, index int, collapsed bool)) {
+	guigui.SetEventHandler(s, splitPanelEventPaneCollapsedChanged, f)
+}
+
+// Direction returns the direction in which the panes are arranged.
+func (s *SplitPanel) Direction() guigui.LayoutDirection {
+	return s.direction
+}
+
+// SetDirection sets the direction in which the panes are arranged.
+// The default direction is [guigui.LayoutDirectionHorizontal].
+func (s *SplitPanel) SetDirection(direction guigui.LayoutDirection) {
+	if s.direction == direction {
+		return
+	}
+	s.direction = direction
+	s.endDragging()
+	guigui.RequestRebuild(s)
+}
+
+// SetPanes sets the panes.
+func (s *SplitPanel) SetPanes(panes []SplitPanelPane) {
+	if len(s.panes) != len(panes) {
+		s.endDragging()
+	}
+	s.panes = adjustSliceSize(s.panes, len(panes))
+	copy(s.panes, panes)
+	s.sizes = adjustSliceSize(s.sizes, len(panes))
+	s.collapsed = adjustSliceSize(s.collapsed, len(panes))
+}
+
+// PaneSizes returns the sizes of the panes along the split direction in device-independent pixels.
+//
+// For a collapsed pane, PaneSizes returns the size the pane is restored to.
+// The result can be passed to [SplitPanel.SetPaneSizes] to restore the sizes later,
+// even after the scale of the app or the monitor is changed.
+func (s *SplitPanel) PaneSizes() []int {
+	sizes := make([]int, len(s.panes))
+	for i := range sizes {
+		if !s.collapsed[i] && i < len(s.actualSizes) {
+			sizes[i] = s.sizeInDeviceIndependentPixels(s.actualSizes[i])
+			continue
+		}
+		sizes[i] = s.sizes[i]
+	}
+	return sizes
+}
+
+// SetPaneSizes sets the sizes of the panes along the split direction in device-independent pixels.
+//
+// A size of 0 means the size measured by the pane's widget.
+// Sizes are clamped to the minimum and maximum sizes of the panes.
+// The size of the flexible pane is ignored, as the flexible pane takes the remaining space.
+func (s *SplitPanel) SetPaneSizes(sizes []int) {
+	var changed bool
+	for i := range s.sizes {
+		var size int
+		if i < len(sizes) {
+			size = max(sizes[i], 0)
+		}
+		if s.sizes[i] != size {
+			s.sizes[i] = size
+			changed = true
+		}
+	}
+	if !changed {
+		return
+	}
+	guigui.RequestRebuild(s)
+}
+
+// IsPaneCollapsed reports whether the pane at index is collapsed.
+func (s *SplitPanel) IsPaneCollapsed(index int) bool {
+	if index < 0 || index >= len(s.collapsed) {
+		return false
+	}
+	return s.collapsed[index]
+}
+
+// SetPaneCollapsed collapses or restores the pane at index.
+//
+// At least one pane is always kept visible, so SetPaneCollapsed does nothing if the other panes are all collapsed.
+func (s *SplitPanel) SetPaneCollapsed(index int, collapsed bool) {
+	if index < 0 || index >= len(s.collapsed) {
+		return
+	}
+	if s.collapsed[index] == collapsed {
+		return
+	}
+	if collapsed {
+		var visibleCount int
+		for _, c := range s.collapsed {
+			if !c {
+				visibleCount++
+			}
+		}
+		if visibleCount <= 1 {
+			return
+		}
+		// Remember the current size so that the pane is restored to it.
+		if index < len(s.actualSizes) && s.actualSizes[index] > 0 {
+			s.sizes[index] = s.sizeInDeviceIndependentPixels(s.actualSizes[index])
+		}
+	}
+	s.collapsed[index] = collapsed
+	s.endDragging()
+	guigui.RequestRebuild(s)
+}
+
+func (s *SplitPanel) WriteStateKey(w *guigui.StateKeyWriter) {
+	w.WriteInt(int(s.direction))
+	w.WriteInt(len(s.panes))
+	for i, pane := range s.panes {
+		if pane.Widget != nil {
+			w.WriteWidget(pane.Widget)
+		}
+		w.WriteInt(pane.MaxSize)
+		w.WriteBool(pane.Flexible)
+		w.WriteBool(pane.Collapsible)
+		w.WriteInt(s.sizes[i])
+		w.WriteBool(s.collapsed[i])
+	}
+	w.WriteInt(s.draggingDividerIndexPlus1)
+}
+
+func (s *SplitPanel) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
+	for i, pane := range s.panes {
+		if pane.Widget == nil || s.collapsed[i] {
+			continue
+		}
+		adder.AddWidget(pane.Widget)
+	}
+
+	// Add the dividers after the panes so that the dividers are on top of the panes.
+	s.dividers.SetLen(max(len(s.panes)-1, 0))
+	for i := range s.dividers.Len() {
+		d := s.dividers.At(i)
+		d.splitPanel = s
+		d.index = i
+		adder.AddWidget(d)
+	}
+
+	return nil
+}
+
+func (s *SplitPanel) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
+	bounds := widgetBounds.Bounds()
+	s.bounds = bounds
+	s.scale = context.Scale()
+	s.actualSizes = s.appendPaneSizes(s.actualSizes[:0], context, bounds)
+	s.dividerPositions = s.dividerPositions[:0]
+
+	dividerThickness := splitPanelDividerThickness(context)
+	pos := s.mainMin(bounds)
+	for i, pane := range s.panes {
+		size := s.actualSizes[i]
+		if pane.Widget != nil && !s.collapsed[i] {
+			layouter.LayoutWidget(pane.Widget, s.rect(bounds, pos, pos+size))
+		}
+		pos += size
+		if i < s.dividers.Len() {
+			s.dividerPositions = append(s.dividerPositions, pos)
+			// A divider overlaps the edges of the adjacent panes so that it is easy to grab.
+			b := s.rect(bounds, pos-dividerThickness/2, pos-dividerThickness/2+dividerThickness)
+			layouter.LayoutWidget(s.dividers.At(i), b.Intersect(bounds))
+		}
+	}
+}
+
+func (s *SplitPanel) mainMin(bounds image.Rectangle) int {
+	if s.direction == guigui.LayoutDirectionVertical {
+		return bounds.Min.Y
+	}
+	return bounds.Min.X
+}
+
+func (s *SplitPanel) mainSize(bounds image.Rectangle) int {
+	if s.direction == guigui.LayoutDirectionVertical {
+		return bounds.Dy()
+	}
+	return bounds.Dx()
+}
+
+// rect returns the rectangle in bounds whose range along the split direction is [start, end).
+func (s *SplitPanel) rect(bounds image.Rectangle, start, end int) image.Rectangle {
+	if s.direction == guigui.LayoutDirectionVertical {
+		return image.Rect(bounds.Min.X, start, bounds.Max.X, end)
+	}
+	return image.Rect(start, bounds.Min.Y, end, bounds.Max.Y)
+}
+
+func (s *SplitPanel) cursorPosition() int {
+	x, y := guigui.CursorPosition()
+	if s.direction == guigui.LayoutDirectionVertical {
+		return y
+	}
+	return x
+}
+
+// sizeInDeviceIndependentPixels converts a size in pixels at the last layout to device-independent pixels.
+func (s *SplitPanel) sizeInDeviceIndependentPixels(size int) int {
+	if s.scale <= 0 {
+		return size
+	}
+	return int(float64(size)/s.scale + 0.5)
+}
+
+// splitPanelSizeInPixels converts a size in device-independent pixels to pixels.
+func splitPanelSizeInPixels(context *guigui.Context, size int) int {
+	return int(float64(size) * context.Scale())
+}
+
+// paneMinSize returns the minimum size of the pane at index, which is the measured size of the pane's widget.
+func (s *SplitPanel) paneMinSize(context *guigui.Context, index int, bounds image.Rectangle) int {
+	w := s.panes[index].Widget
+	if w == nil {
+		return 0
+	}
+	if s.direction == guigui.LayoutDirectionVertical {
+		return w.Measure(context, guigui.FixedWidthConstraints(bounds.Dx())).Y
+	}
+	return w.Measure(context, guigui.FixedHeightConstraints(bounds.Dy())).X
+}
+
+// flexiblePaneIndex returns the index of the pane that takes the remaining space, or -1 if all the panes are collapsed.
+func (s *SplitPanel) flexiblePaneIndex() int {
+	for i, pane := range s.panes {
+		if pane.Flexible && !s.collapsed[i] {
+			return i
+		}
+	}
+	for i := len(s.panes) - 1; i >= 0; i-- {
+		if !s.collapsed[i] {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s *SplitPanel) appendPaneSizes(sizes []int, context *guigui.Context, bounds image.Rectangle) []int {
+	start := len(sizes)
+	sizes = slices.Grow(sizes, len(s.panes))[:start+len(s.panes)]
+
+	s.tmpRequestedSizes = adjustSliceSize(s.tmpRequestedSizes, len(s.panes))
+	s.tmpMinSizes = adjustSliceSize(s.tmpMinSizes, len(s.panes))
+	s.tmpMaxSizes = adjustSliceSize(s.tmpMaxSizes, len(s.panes))
+	for i, pane := range s.panes {
+		s.tmpRequestedSizes[i] = splitPanelSizeInPixels(context, s.sizes[i])
+		if s.collapsed[i] {
+			s.tmpMinSizes[i] = 0
+		} else {
+			s.tmpMinSizes[i] = s.paneMinSize(context, i, bounds)
+		}
+		s.tmpMaxSizes[i] = splitPanelSizeInPixels(context, pane.MaxSize)
+	}
+	distributeSplitPanelPaneSizes(sizes[start:], s.mainSize(bounds), s.tmpRequestedSizes, s.tmpMinSizes, s.tmpMaxSizes, s.collapsed, s.flexiblePaneIndex())
+	return sizes
+}
+
+// distributeSplitPanelPaneSizes sets the sizes of the panes sharing mainSize to paneSizes.
+// All the sizes are in pixels.
+//
+// requested is the requested sizes of the panes, which are clamped to minSizes and maxSizes.
+// A max size of 0 means no limit. The collapsed panes have the size 0.
+// The pane at flexIndex takes the remaining space. If flexIndex is negative, the remaining space is left.
+func distributeSplitPanelPaneSizes(paneSizes []int, mainSize int, requested, minSizes, maxSizes []int, collapsed []bool, flexIndex int) {
+	rest := mainSize
+	for i := range paneSizes {
+		if collapsed[i] || i == flexIndex {
+			paneSizes[i] = 0
+			continue
+		}
+		size := max(requested[i], minSizes[i])
+		if maxSizes[i] > 0 {
+			size = min(size, maxSizes[i])
+		}
+		paneSizes[i] = size
+		rest -= size
+	}
+	if flexIndex < 0 {
+		return
+	}
+
+	// If the flexible pane doesn't have enough space, shrink the other panes, starting from the farthest ones.
+	if flexMin := minSizes[flexIndex]; rest < flexMin {
+		for _, i := range splitPanelPaneIndicesByDistance(collapsed, flexIndex) {
+			d := min(flexMin-rest, paneSizes[i]-minSizes[i])
+			if d <= 0 {
+				continue
+			}
+			paneSizes[i] -= d
+			rest += d
+			if rest >= flexMin {
+				break
+			}
+		}
+	}
+
+	// If the flexible pane has too much space, enlarge the other panes, starting from the nearest ones.
+	if flexMax := maxSizes[flexIndex]; flexMax > 0 && rest > flexMax {
+		indices := splitPanelPaneIndicesByDistance(collapsed, flexIndex)
+		for _, i := range slices.Backward(indices) {
+			d := rest - flexMax
+			if maxSize := maxSizes[i]; maxSize > 0 {
+				d = min(d, maxSize-paneSizes[i])
+			}
+			if d <= 0 {
+				continue
+			}
+			paneSizes[i] += d
+			rest -= d
+			if rest <= flexMax {
+				break
+			}
+		}
+	}
+
+	paneSizes[flexIndex] = max(rest, 0)
+}
+
+// splitPanelPaneIndicesByDistance returns the indices of the panes that are not collapsed except for index,
+// ordered from the farthest to the nearest to index.
+func splitPanelPaneIndicesByDistance(collapsed []bool, index int) []int {
+	var indices []int
+	for i := range collapsed {
+		if i == index || collapsed[i] {
+			continue
+		}
+		indices = append(indices, i)
+	}
+	slices.SortStableFunc(indices, func(a, b int) int {
+		return abs(b-index) - abs(a-index)
+	})
+	return indices
+}
+
+func (s *SplitPanel) isDividerDraggable(index int) bool {
+	if index < 0 || index+1 >= len(s.panes) {
+		return false
+	}
+	// A divider next to a collapsed pane can't be dragged. Double-click it to restore the pane instead.
+	return !s.collapsed[index] && !s.collapsed[index+1]
+}
+
+func (s *SplitPanel) startDragging(index int) {
+	if !s.isDividerDraggable(index) {
+		return
+	}
+	s.draggingDividerIndexPlus1 = index + 1
+	s.draggingStartPosition = s.cursorPosition()
+	s.draggingStartSizes = append(s.draggingStartSizes[:0], s.actualSizes...)
+}
+
+func (s *SplitPanel) endDragging() {
+	s.draggingDividerIndexPlus1 = 0
+	s.draggingStartPosition = 0
+	s.draggingStartSizes = s.draggingStartSizes[:0]
+}
+
+func (s *SplitPanel) isDragging(index int) bool {
+	return s.draggingDividerIndexPlus1 == index+1
+}
+
+func (s *SplitPanel) dragDivider(context *guigui.Context) {
+	bounds := s.bounds
+	index := s.draggingDividerIndexPlus1 - 1
+	if !s.isDividerDraggable(index) || len(s.draggingStartSizes) != len(s.panes) {
+		return
+	}
+
+	// Move the space between the two panes adjacent to the divider.
+	a, b := index, index+1
+	startA, startB := s.draggingStartSizes[a], s.draggingStartSizes[b]
+	delta := s.cursorPosition() - s.draggingStartPosition
+	delta = min(delta, startB-s.paneMinSize(context, b, bounds))
+	if maxA := splitPanelSizeInPixels(context, s.panes[a].MaxSize); maxA > 0 {
+		delta = min(delta, maxA-startA)
+	}
+	delta = max(delta, s.paneMinSize(context, a, bounds)-startA)
+	if maxB := splitPanelSizeInPixels(context, s.panes[b].MaxSize); maxB > 0 {
+		delta = max(delta, startB-maxB)
+	}
+
+	var changed bool
+	for i, size := range s.draggingStartSizes {
+		if s.collapsed[i] {
+			continue
+		}
+		switch i {
+		case a:
+			size += delta
+		case b:
+			size -= delta
+		}
+		size = int(float64(size)/context.Scale() + 0.5)
+		if s.sizes[i] != size {
+			s.sizes[i] = size
+			changed = true
+		}
+	}
+	if !changed {
+		return
+	}
+	guigui.RequestRebuild(s)
+	// The layout is not updated yet, so dispatch the requested sizes instead of PaneSizes.
+	guigui.DispatchEvent(s, splitPanelEventPaneSizesChanged, slices.Clone(s.sizes))
+}
+
+// toggleCollapsedByDivider collapses or restores a pane adjacent to the divider at index.
+func (s *SplitPanel) toggleCollapsedByDivider(index int) {
+	if index < 0 || index+1 >= len(s.panes) {
+		return
+	}
+	for _, i := range []int{index, index + 1} {
+		if s.collapsed[i] {
+			s.SetPaneCollapsed(i, false)
+			guigui.DispatchEvent(s, splitPanelEventPaneCollapsedChanged, i, false)
+			return
+		}
+	}
+
+	// Prefer collapsing a pane that is not flexible.
+	flexIndex := s.flexiblePaneIndex()
+	for _, i := range []int{index, index + 1} {
+		if !s.panes[i].Collapsible || i == flexIndex {
+			continue
+		}
+		s.SetPaneCollapsed(i, true)
+		if s.collapsed[i] {
+			guigui.DispatchEvent(s, splitPanelEventPaneCollapsedChanged, i, true)
+		}
+		return
+	}
+	for _, i := range []int{index, index + 1} {
+		if !s.panes[i].Collapsible {
+			continue
+		}
+		s.SetPaneCollapsed(i, true)
+		if s.collapsed[i] {
+			guigui.DispatchEvent(s, splitPanelEventPaneCollapsedChanged, i, true)
+		}
+		return
+	}
+}
+
+func (s *SplitPanel) Measure(context *guigui.Context, constraints guigui.Constraints) image.Point {
+	var mainSize, crossSize int
+	for i, pane := range s.panes {
+		if pane.Widget == nil || s.collapsed[i] {
+			continue
+		}
+		size := pane.Widget.Measure(context, guigui.Constraints{})
+		if s.direction == guigui.LayoutDirectionVertical {
+			mainSize += max(size.Y, splitPanelSizeInPixels(context, s.sizes[i]))
+			crossSize = max(crossSize, size.X)
+		} else {
+			mainSize += max(size.X, splitPanelSizeInPixels(context, s.sizes[i]))
+			crossSize = max(crossSize, size.Y)
+		}
+	}
+	p := image.Pt(mainSize, crossSize)
+	if s.direction == guigui.LayoutDirectionVertical {
+		p = image.Pt(crossSize, mainSize)
+	}
+	if w, ok := constraints.FixedWidth(); ok {
+		p.X = w
+	}
+	if h, ok := constraints.FixedHeight(); ok {
+		p.Y = h
+	}
+	return p
+}
+
+func splitPanelDividerThickness(context *guigui.Context) int {
+	return UnitSize(context) / 4
+}
+
+type splitPanelDivider struct {
+	guigui.DefaultWidget
+
+	splitPanel *SplitPanel
+	index      int
+}
+
+func (d *splitPanelDivider) WriteStateKey(w *guigui.StateKeyWriter) {
+	w.WriteInt(d.index)
+	w.WriteBool(d.splitPanel.isDragging(d.index))
+}
+
+func (d *splitPanelDivider) HandlePointingInput(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
+	s := d.splitPanel
+	if !context.IsEnabled(d) {
+		if s.isDragging(d.index) {
+			s.endDragging()
+		}
+		return guigui.HandleInputResult{}
+	}
+
+	if s.isDragging(d.index) {
+		if !guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
+			s.endDragging()
+			return guigui.HandleInputByWidget(d)
+		}
+		s.dragDivider(context)
+		return guigui.HandleInputByWidget(d)
+	}
+
+	if click, ok := widgetBounds.ClickAtCursor(); ok && click.Button == ebiten.MouseButtonLeft {
+		if click.Count == 2 {
+			s.toggleCollapsedByDivider(d.index)
+			return guigui.HandleInputByWidget(d)
+		}
+		s.startDragging(d.index)
+		return guigui.HandleInputByWidget(d)
+	}
+
+	return guigui.HandleInputResult{}
+}
+
+func (d *splitPanelDivider) CursorShape(context *guigui.Context, widgetBounds *guigui.WidgetBounds) (ebiten.CursorShapeType, bool) {
+	if !context.IsEnabled(d) || !d.splitPanel.isDividerDraggable(d.index) {
+		return 0, false
+	}
+	if !widgetBounds.IsHitAtCursor() && !d.splitPanel.isDragging(d.index) {
+		return 0, false
+	}
+	if d.splitPanel.direction == guigui.LayoutDirectionVertical {
+		return ebiten.CursorShapeNSResize, true
+	}
+	return ebiten.CursorShapeEWResize, true
+}
+
+func (d *splitPanelDivider) Draw(context *guigui.Context, widgetBounds *guigui.WidgetBounds, dst *ebiten.Image) {
+	bounds := widgetBounds.Bounds()
+	s := d.splitPanel
+	if d.index >= len(s.dividerPositions) {
+		return
+	}
+	pos := float32(s.dividerPositions[d.index])
+	var x0, y0, x1, y1 float32
+	if s.direction == guigui.LayoutDirectionVertical {
+		x0, y0, x1, y1 = float32(bounds.Min.X), pos, float32(bounds.Max.X), pos
+	} else {
+		x0, y0, x1, y1 = pos, float32(bounds.Min.Y), pos, float32(bounds.Max.Y)
+	}
+	if s.isDragging(d.index) {
+		strokeWidth := float32(2 * context.Scale())
+		clr := draw.Color(context.ColorMode(), draw.SemanticColorAccent, 0.5)
+		vector.StrokeLine(dst, x0, y0, x1, y1, strokeWidth, clr, false)
+		return
+	}
+	drawDividerLine(context, dst, x0, y0, x1, y1)
+}
@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package basicwidget_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/guigui-gui/guigui/basicwidget"
+)
+
+func TestDistributeSplitPanelPaneSizes(t *testing.T) {
+	testCases := []struct {
+		name      string
+		mainSize  int
+		requested []int
+		minSizes  []int
+		maxSizes  []int
+		collapsed []bool
+		flexIndex int
+		out       []int
+	}{
+		{
+			name:      "requested sizes",
+			mainSize:  300,
+			requested: []int{100, 0, 50},
+			minSizes:  []int{10, 10, 10},
+			maxSizes:  []int{0, 0, 0},
+			collapsed: []bool{false, false, false},
+			flexIndex: 1,
+			out:       []int{100, 150, 50},
+		},
+		{
+			name:      "measured sizes",
+			mainSize:  300,
+			requested: []int{0, 0, 0},
+			minSizes:  []int{40, 10, 60},
+			maxSizes:  []int{0, 0, 0},
+			collapsed: []bool{false, false, false},
+			flexIndex: 1,
+			out:       []int{40, 200, 60},
+		},
+		{
+			name:      "clamped to max sizes",
+			mainSize:  300,
+			requested: []int{200, 0},
+			minSizes:  []int{10, 10},
+			maxSizes:  []int{120, 0},
+			collapsed: []bool{false, false},
+			flexIndex: 1,
+			out:       []int{120, 180},
+		},
+		{
+			name:      "collapsed",
+			mainSize:  300,
+			requested: []int{100, 0, 50},
+			minSizes:  []int{10, 10, 10},
+			maxSizes:  []int{0, 0, 0},
+			collapsed: []bool{true, false, false},
+			flexIndex: 1,
+			out:       []int{0, 250, 50},
+		},
+		{
+			name:      "shrink the farthest first",
+			mainSize:  300,
+			requested: []int{150, 100, 0},
+			minSizes:  []int{50, 50, 100},
+			maxSizes:  []int{0, 0, 0},
+			collapsed: []bool{false, false, false},
+			flexIndex: 2,
+			out:       []int{100, 100, 100},
+		},
+		{
+			name:      "shrink the nearer after the farthest reaches the min size",
+			mainSize:  300,
+			requested: []int{150, 150, 0},
+			minSizes:  []int{100, 50, 100},
+			maxSizes:  []int{0, 0, 0},
+			collapsed: []bool{false, false, false},
+			flexIndex: 2,
+			out:       []int{100, 100, 100},
+		},
+		{
+			name:      "not enough space",
+			mainSize:  100,
+			requested: []int{100, 0},
+			minSizes:  []int{80, 50},
+			maxSizes:  []int{0, 0},
+			collapsed: []bool{false, false},
+			flexIndex: 1,
+			out:       []int{80, 20},
+		},
+		{
+			name:      "enlarge the nearest first",
+			mainSize:  300,
+			requested: []int{50, 50, 0},
+			minSizes:  []int{10, 10, 10},
+			maxSizes:  []int{0, 0, 100},
+			collapsed: []bool{false, false, false},
+			flexIndex: 2,
+			out:       []int{50, 150, 100},
+		},
+		{
+			name:      "enlarge the farther after the nearest reaches the max size",
+			mainSize:  300,
+			requested: []int{50, 50, 0},
+			minSizes:  []int{10, 10, 10},
+			maxSizes:  []int{0, 80, 100},
+			collapsed: []bool{false, false, false},
+			flexIndex: 2,
+			out:       []int{120, 80, 100},
+		},
+		{
+			name:      "all collapsed",
+			mainSize:  300,
+			requested: []int{100, 100},
+			minSizes:  []int{0, 0},
+			maxSizes:  []int{0, 0},
+			collapsed: []bool{true, true},
+			flexIndex: -1,
+			out:       []int{0, 0},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := basicwidget.DistributeSplitPanelPaneSizes(tc.mainSize, tc.requested, tc.minSizes, tc.maxSizes, tc.collapsed, tc.flexIndex)
+			if !slices.Equal(got, tc.out) {
+				t.Errorf("got: %v, want: %v", got, tc.out)
+			}
+		})
+	}
+}
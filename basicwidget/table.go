@@ -4,8 +4,12 @@
 package basicwidget
 
 import (
+	"cmp"
 	"image"
+	"math/big"
 	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/vector"
@@ -14,39 +18,241 @@ import (
 	"github.com/guigui-gui/guigui/basicwidget/internal/draw"
 )
 
+var (
+	tableEventSortChanged         guigui.EventKey = guigui.GenerateEventKey()
+	tableEventColumnStatesChanged guigui.EventKey = guigui.GenerateEventKey()
+	tableEventCellEditCommitted   guigui.EventKey = guigui.GenerateEventKey()
+	tableEventItemExpanderToggled guigui.EventKey = guigui.GenerateEventKey()
+)
+
+// SortOrder is the order of sorting.
+type SortOrder int
+
+const (
+	SortOrderNone SortOrder = iota
+	SortOrderAscending
+	SortOrderDescending
+)
+
+// TableSortColumn is a column to sort a [Table] by.
+type TableSortColumn struct {
+	ColumnIndex int
+	Order       SortOrder
+}
+
 type Table[T comparable] struct {
 	guigui.DefaultWidget
 
-	list            List[T]
-	listItems       []ListItem[T]
-	tableRows       []TableRow[T]
+	list      List[T]
+	listItems []ListItem[T]
+
+	// items is the rows in the order given by SetItems.
+	items []TableRow[T]
+
+	tableDisplayedRows[T]
+
 	tableRowWidgets guigui.WidgetSlice[*tableRowWidget[T]]
+	dataSourceRows  tableDataSourceRows[T]
 	tableHeader     tableHeader[T]
 
-	columns              []TableColumn
-	columnLayoutItems    []guigui.LinearLayoutItem
+	columns      []TableColumn
+	columnStates []TableColumnState
+
+	frozenLeadingColumnCount  int
+	frozenTrailingColumnCount int
+
+	// visibleColumnIndices is the indices of the visible columns in the displayed order.
+	visibleColumnIndices []int
+
+	// columnWidthsInPixels is the widths of the visible columns in the displayed order.
 	columnWidthsInPixels []int
 
-	tmpItemBounds []image.Rectangle
+	columnLayoutItems []guigui.LinearLayoutItem
+
+	cellEditor           tableCellEditor[T]
+	editingRowIndexPlus1 int
+	editingColumnIndex   int
+
+	sortColumns     []TableSortColumn
+	externalSorting bool
+
+	// groupColumnIndexPlus1 is the index of the column to group the rows by plus 1.
+	groupColumnIndexPlus1 int
+
+	// collapsedGroups is the keys of the collapsed groups.
+	collapsedGroups map[string]bool
+
+	footer       tableFooter[T]
+	footerHeight int
+
+	// selectedValuesToRestore is the selected values before the sort order is changed.
+	// With external sorting, the selection is restored when the sorted items are set.
+	selectedValuesToRestore    []T
+	hasSelectedValuesToRestore bool
+
+	tmpItemBounds      []image.Rectangle
+	tmpSelectedIndices []int
+	tmpSortColumns     []TableSortColumn
+}
+
+// tableDisplayedRows is the rows of a table in the displayed order, with the group rows and the aggregates.
+type tableDisplayedRows[T comparable] struct {
+	// tableRows is the rows in the displayed order.
+	tableRows []TableRow[T]
+
+	// tableRowInfos is the information of the rows in the displayed order.
+	tableRowInfos []tableRowInfo
+
+	// groupCells is the cells of the group rows, reused across updates.
+	groupCells [][]TableCell
+
+	// footerCells is the cells of the aggregates over all the rows.
+	footerCells []TableCell
+
+	tmpGroupedRows    []TableRow[T]
+	tmpGroupKeys      []string
+	tmpGroupOrders    map[string]int
+	tmpRowIndices     []int
+	tmpAggregateCells []TableCell
+}
+
+// tableRowInfo is the information of a displayed row.
+type tableRowInfo struct {
+	// group reports whether the row is a group row.
+	group bool
+
+	// groupKey is the key of the group row.
+	groupKey string
+
+	// indentLevel is the displayed indent level of the row.
+	// The rows in groups are indented one more level than the group rows.
+	indentLevel int
+
+	// hasChildren reports whether the row has an expander.
+	hasChildren bool
+
+	// collapsed reports whether the children of the row are hidden.
+	collapsed bool
 }
 
+// TableAggregateType is the type of the aggregate of the cells in a column.
+type TableAggregateType int
+
+const (
+	TableAggregateTypeNone TableAggregateType = iota
+
+	// TableAggregateTypeCount counts the rows.
+	TableAggregateTypeCount
+
+	// TableAggregateTypeSum sums up the texts of the cells that can be parsed as numbers.
+	// The number of the fraction digits is the maximum of the ones of the cells.
+	TableAggregateTypeSum
+
+	// TableAggregateTypeMin takes the minimum of the non-empty cells, compared in the same way as sorting.
+	TableAggregateTypeMin
+
+	// TableAggregateTypeMax takes the maximum of the non-empty cells, compared in the same way as sorting.
+	TableAggregateTypeMax
+
+	// TableAggregateTypeCustom aggregates the cells by [TableColumn.AggregateFunc].
+	TableAggregateTypeCustom
+)
+
 type TableColumn struct {
 	HeaderText                string
 	HeaderTextHorizontalAlign HorizontalAlign
 	Width                     guigui.Size
 	MinWidth                  int
+
+	// MaxWidth is the maximum width of the column in pixels.
+	// 0 means no limit.
+	MaxWidth int
+
+	// Resizable reports whether the column can be resized by dragging the header edge.
+	// Double-clicking the header edge fits the width to the content.
+	Resizable bool
+
+	// Movable reports whether the column can be reordered by dragging the header.
+	Movable bool
+
+	// Hideable reports whether the column can be hidden from the header context menu.
+	Hideable bool
+
+	// Editor is the type of the editor to edit the cells in the column.
+	// Double-click, Enter or F2 starts editing a cell.
+	// The initial value of the editor is taken from the cell's Text.
+	Editor TableCellEditorType
+
+	// EditorItems is the items of the editor of [TableCellEditorTypeSelect].
+	EditorItems []string
+
+	// EditorFractionDigits is the number of fraction digits of the editor of [TableCellEditorTypeNumberInput].
+	// See [NumberInput.SetFractionDigits].
+	EditorFractionDigits int
+
+	// Sortable reports whether the rows can be sorted by clicking the column header.
+	Sortable bool
+
+	// Compare compares two cells of the column to sort the rows.
+	// If Compare is nil, the texts of the cells are compared.
+	// The texts are compared as numbers if both can be parsed as numbers.
+	// The numbers are ordered before the other texts.
+	//
+	// The text of a cell is used for sorting even if the cell has a content widget.
+	Compare func(a, b TableCell) int
+
+	// Aggregate is the type of the aggregate of the cells in the column.
+	// The aggregate is shown in the group rows (see [Table.SetGroupColumnIndex]) and in the footer (see [Table.SetFooterHeight]).
+	// Only the leaf rows, i.e. the rows without child rows, are aggregated.
+	//
+	// Aggregates are not available with a data source.
+	Aggregate TableAggregateType
+
+	// AggregateFunc returns the text of the aggregate of the cells for [TableAggregateTypeCustom].
+	AggregateFunc func(cells []TableCell) string
+}
+
+// TableColumnState is the state of a column that the user can change.
+//
+// TableColumnState consists of plain values so that apps can serialize it, e.g. by encoding/json, to persist user layouts.
+type TableColumnState struct {
+	// ColumnIndex is the index of the column in the columns set by [Table.SetColumns].
+	ColumnIndex int
+
+	// Width is the width of the column in device-independent pixels.
+	// 0 means the width specified by [TableColumn.Width].
+	Width int
+
+	// Hidden reports whether the column is hidden.
+	Hidden bool
 }
 
 type TableRow[T comparable] struct {
-	Cells        []TableCell
+	Cells []TableCell
+
+	// Header reports whether the row is a section header.
+	// A header row is not selectable, and sticks to the top of the viewport while its section is scrolled.
+	// Header rows don't stick with a data source.
+	Header bool
+
 	Unselectable bool
 	Movable      bool
 	Checked      bool
 	Value        T
+
+	// IndentLevel is the indent level of the row in a tree, like [ListItem.IndentLevel].
+	// The rows following a row with a greater indent level are its children,
+	// and the row has an expander in the first visible column.
+	// 0 means that the row is not in a tree.
+	IndentLevel int
+
+	// Collapsed reports whether the children of the row are hidden.
+	// Update Collapsed at [Table.OnItemExpanderToggled].
+	Collapsed bool
 }
 
 func (t *TableRow[T]) selectable() bool {
-	return !t.Unselectable
+	return !t.Header && !t.Unselectable
 }
 
 type TableCell struct {
@@ -58,6 +264,942 @@ type TableCell struct {
 func (t *Table[T]) SetColumns(columns []TableColumn) {
 	t.columns = slices.Delete(t.columns, 0, len(t.columns))
 	t.columns = append(t.columns, columns...)
+	t.normalizeColumnStates()
+}
+
+// OnColumnStatesChanged sets the event handler that is called when the column states are changed by the user.
+// states must not be retained after the handler returns.
+func (t *Table[T]) OnColumnStatesChanged(f func(context *guigui.Context, states []TableColumnState)) {
+	guigui.SetEventHandler(t, tableEventColumnStatesChanged, f)
+}
+
+// AppendColumnStates appends the column states in the displayed order to states and returns the extended slice.
+func (t *Table[T]) AppendColumnStates(states []TableColumnState) []TableColumnState {
+	return append(states, t.columnStates...)
+}
+
+// SetColumnStates sets the column states in the displayed order.
+//
+// Invalid or duplicated column indices are ignored,
+// and missing columns are appended with the default state.
+// At least one column is kept visible.
+func (t *Table[T]) SetColumnStates(states []TableColumnState) {
+	if slices.Equal(t.columnStates, states) {
+		return
+	}
+	t.columnStates = slices.Delete(t.columnStates, 0, len(t.columnStates))
+	t.columnStates = append(t.columnStates, states...)
+	t.normalizeColumnStates()
+	guigui.RequestRebuild(t)
+}
+
+// normalizeColumnStates makes the column states consistent with the columns.
+func (t *Table[T]) normalizeColumnStates() {
+	seen := make([]bool, len(t.columns))
+	t.columnStates = slices.DeleteFunc(t.columnStates, func(s TableColumnState) bool {
+		if s.ColumnIndex < 0 || s.ColumnIndex >= len(t.columns) || seen[s.ColumnIndex] {
+			return true
+		}
+		seen[s.ColumnIndex] = true
+		return false
+	})
+	for i, ok := range seen {
+		if ok {
+			continue
+		}
+		t.columnStates = append(t.columnStates, TableColumnState{
+			ColumnIndex: i,
+		})
+	}
+	if len(t.columnStates) > 0 && !slices.ContainsFunc(t.columnStates, func(s TableColumnState) bool {
+		return !s.Hidden
+	}) {
+		t.columnStates[0].Hidden = false
+	}
+
+	t.visibleColumnIndices = slices.Delete(t.visibleColumnIndices, 0, len(t.visibleColumnIndices))
+	for _, s := range t.columnStates {
+		if s.Hidden {
+			continue
+		}
+		t.visibleColumnIndices = append(t.visibleColumnIndices, s.ColumnIndex)
+	}
+	// Keep the lengths consistent until the widths are updated at Layout.
+	t.columnWidthsInPixels = adjustSliceSize(t.columnWidthsInPixels, len(t.visibleColumnIndices))
+}
+
+func (t *Table[T]) columnStateIndex(columnIndex int) int {
+	return slices.IndexFunc(t.columnStates, func(s TableColumnState) bool {
+		return s.ColumnIndex == columnIndex
+	})
+}
+
+func (t *Table[T]) dispatchColumnStatesChanged() {
+	guigui.DispatchEvent(t, tableEventColumnStatesChanged, t.columnStates)
+}
+
+// setColumnWidthByUser sets the width of the column in pixels and reports whether the width is changed.
+func (t *Table[T]) setColumnWidthByUser(context *guigui.Context, columnIndex int, width int) bool {
+	idx := t.columnStateIndex(columnIndex)
+	if idx < 0 {
+		return false
+	}
+	width = t.clampColumnWidth(columnIndex, width)
+	w := max(int(float64(width)/context.Scale()+0.5), 1)
+	if t.columnStates[idx].Width == w {
+		return false
+	}
+	t.columnStates[idx].Width = w
+	guigui.RequestRebuild(t)
+	return true
+}
+
+// fitColumnWidthByUser sets the width of the column to fit its header and cells.
+func (t *Table[T]) fitColumnWidthByUser(context *guigui.Context, columnIndex int) {
+	u := UnitSize(context)
+	w := t.tableHeader.columnTexts.At(columnIndex).Measure(context, guigui.Constraints{}).X + u/2
+	if order, _ := t.sortOrder(columnIndex); order != SortOrderNone {
+		w += LineHeight(context)
+	}
+	p := ListItemTextPadding(context)
+	fit := func(row *tableRowWidget[T]) {
+		if columnIndex >= len(row.row.Cells) {
+			return
+		}
+		// The first visible column has the indent of the row.
+		var indent int
+		if len(t.visibleColumnIndices) > 0 && t.visibleColumnIndices[0] == columnIndex {
+			indent = row.indentSize(context)
+		}
+		if c := row.row.Cells[columnIndex].Content; c != nil {
+			w = max(w, c.Measure(context, guigui.Constraints{}).X+indent)
+			return
+		}
+		row.ensureTexts()
+		w = max(w, row.texts.At(columnIndex).Measure(context, guigui.Constraints{}).X+p.Start+p.End+indent)
+	}
+	for i := range t.tableRowWidgets.Len() {
+		fit(t.tableRowWidgets.At(i))
+	}
+	// With a data source, only the rows around the viewport are measured.
+	for _, row := range t.dataSourceRows.rowWidgets {
+		fit(row)
+	}
+	if t.setColumnWidthByUser(context, columnIndex, w) {
+		t.dispatchColumnStatesChanged()
+	}
+}
+
+// moveColumnByUser moves the visible column at the displayed position from to the displayed position to.
+// to is the position before the column is removed, in [0, the number of the visible columns].
+func (t *Table[T]) moveColumnByUser(from, to int) {
+	if from < 0 || from >= len(t.visibleColumnIndices) || to < 0 || to > len(t.visibleColumnIndices) {
+		return
+	}
+	if to == from || to == from+1 {
+		return
+	}
+	fromIdx := t.columnStateIndex(t.visibleColumnIndices[from])
+	toIdx := len(t.columnStates)
+	if to < len(t.visibleColumnIndices) {
+		toIdx = t.columnStateIndex(t.visibleColumnIndices[to])
+	}
+	MoveItemsInSlice(t.columnStates, fromIdx, 1, toIdx)
+	t.normalizeColumnStates()
+	guigui.RequestRebuild(t)
+	t.dispatchColumnStatesChanged()
+}
+
+// setColumnHiddenByUser hides or shows the column.
+// The last visible column cannot be hidden.
+func (t *Table[T]) setColumnHiddenByUser(columnIndex int, hidden bool) {
+	idx := t.columnStateIndex(columnIndex)
+	if idx < 0 || t.columnStates[idx].Hidden == hidden {
+		return
+	}
+	if hidden && len(t.visibleColumnIndices) <= 1 {
+		return
+	}
+	t.columnStates[idx].Hidden = hidden
+	t.normalizeColumnStates()
+	guigui.RequestRebuild(t)
+	t.dispatchColumnStatesChanged()
+}
+
+// SetFrozenColumnCount sets the numbers of the leading and trailing visible columns that don't scroll horizontally.
+// The frozen columns are counted in the displayed order.
+func (t *Table[T]) SetFrozenColumnCount(leading, trailing int) {
+	leading = max(leading, 0)
+	trailing = max(trailing, 0)
+	if t.frozenLeadingColumnCount == leading && t.frozenTrailingColumnCount == trailing {
+		return
+	}
+	t.frozenLeadingColumnCount = leading
+	t.frozenTrailingColumnCount = trailing
+	guigui.RequestRebuild(t)
+}
+
+// frozenColumnCounts returns the numbers of the leading and trailing frozen columns among the visible columns.
+func (t *Table[T]) frozenColumnCounts() (int, int) {
+	return clampFrozenColumnCounts(len(t.columnWidthsInPixels), t.frozenLeadingColumnCount, t.frozenTrailingColumnCount)
+}
+
+// clampFrozenColumnCounts returns the numbers of the leading and trailing frozen columns among n columns.
+// The leading frozen columns take precedence.
+func clampFrozenColumnCounts(n int, leading, trailing int) (int, int) {
+	leading = min(leading, n)
+	trailing = min(trailing, n-leading)
+	return leading, trailing
+}
+
+func (t *Table[T]) isColumnFrozen(pos int) bool {
+	leading, trailing := t.frozenColumnCounts()
+	return pos < leading || pos >= len(t.columnWidthsInPixels)-trailing
+}
+
+// columnRangeX returns the horizontal range of the visible column at the displayed position pos.
+// x0 is the x position of the left edge of the first column, including the horizontal scroll offset.
+//
+// The frozen columns are shifted to cancel the horizontal scroll offset.
+func (t *Table[T]) columnRangeX(x0 int, pos int) (int, int) {
+	leading, trailing := t.frozenColumnCounts()
+	offsetX, _ := t.list.scrollOffset()
+	return tableColumnRangeX(t.columnWidthsInPixels, leading, trailing, x0, pos, offsetX, t.list.minScrollOffsetX())
+}
+
+// tableColumnRangeX returns the horizontal range of the column at the displayed position pos.
+// widths is the widths of the visible columns, and leading and trailing are the numbers of the frozen columns.
+// x0 is the x position of the left edge of the first column, including the horizontal scroll offset offsetX.
+// minOffsetX is the scroll offset when the columns are scrolled to the right end.
+func tableColumnRangeX(widths []int, leading, trailing int, x0 int, pos int, offsetX, minOffsetX float64) (int, int) {
+	x := x0
+	for _, width := range widths[:pos] {
+		x += width
+	}
+	switch {
+	case pos < leading:
+		x -= int(offsetX)
+	case pos >= len(widths)-trailing:
+		// The trailing frozen columns are at their original positions when the table is scrolled to the end.
+		x += int(minOffsetX) - int(offsetX)
+	}
+	return x, x + widths[pos]
+}
+
+// columnPositionAt returns the displayed position of the visible column at x, or -1 if there is no column.
+// x0 is the x position of the left edge of the first column, including the horizontal scroll offset.
+func (t *Table[T]) columnPositionAt(x0 int, x int) int {
+	// The frozen columns are rendered over the scrollable columns.
+	for pos := range t.columnWidthsInPixels {
+		if !t.isColumnFrozen(pos) {
+			continue
+		}
+		if x1, x2 := t.columnRangeX(x0, pos); x1 <= x && x < x2 {
+			return pos
+		}
+	}
+	for pos := range t.columnWidthsInPixels {
+		if x1, x2 := t.columnRangeX(x0, pos); x1 <= x && x < x2 {
+			return pos
+		}
+	}
+	return -1
+}
+
+// scrollableColumnBounds returns bounds narrowed horizontally to the area not covered by the frozen columns.
+// x0 is the x position of the left edge of the first column, including the horizontal scroll offset.
+func (t *Table[T]) scrollableColumnBounds(x0 int, bounds image.Rectangle) image.Rectangle {
+	leading, trailing := t.frozenColumnCounts()
+	if leading > 0 {
+		_, x := t.columnRangeX(x0, leading-1)
+		bounds.Min.X = max(bounds.Min.X, x)
+	}
+	if trailing > 0 {
+		x, _ := t.columnRangeX(x0, len(t.columnWidthsInPixels)-trailing)
+		bounds.Max.X = min(bounds.Max.X, x)
+	}
+	bounds.Max.X = max(bounds.Max.X, bounds.Min.X)
+	return bounds
+}
+
+func (t *Table[T]) clampColumnWidth(columnIndex int, width int) int {
+	c := t.columns[columnIndex]
+	if c.MaxWidth > 0 {
+		width = min(width, c.MaxWidth)
+	}
+	return max(width, c.MinWidth, 1)
+}
+
+// OnCellEditCommitted sets the event handler that is called when the user commits a value of a cell editor.
+// rowIndex is the index in the displayed order, and columnIndex is the index in the columns.
+//
+// If the handler returns a non-nil error, the value is rejected and the editor is kept open.
+// Otherwise, the handler should update the items by [Table.SetItems] to reflect the value.
+func (t *Table[T]) OnCellEditCommitted(f func(context *guigui.Context, rowIndex, columnIndex int, value TableCellValue) error) {
+	guigui.SetEventHandler(t, tableEventCellEditCommitted, f)
+}
+
+// StartEditingCell starts editing the cell and reports whether editing is started.
+// The column must have an editor and must be visible.
+func (t *Table[T]) StartEditingCell(rowIndex, columnIndex int) bool {
+	return t.startEditingCell(rowIndex, columnIndex)
+}
+
+func (t *Table[T]) isEditingCell() bool {
+	return t.editingRowIndexPlus1 > 0
+}
+
+// editingColumnIndexAt returns the index of the column being edited in the row, or -1 if there is no such column.
+func (t *Table[T]) editingColumnIndexAt(rowIndex int) int {
+	if t.editingRowIndexPlus1-1 != rowIndex {
+		return -1
+	}
+	return t.editingColumnIndex
+}
+
+func (t *Table[T]) isCellEditable(rowIndex, columnIndex int) bool {
+	row, ok := t.ItemByIndex(rowIndex)
+	if !ok {
+		return false
+	}
+	return isTableCellEditable(t.columns, t.visibleColumnIndices, row, columnIndex)
+}
+
+// isTableCellEditable reports whether the cell of the row in the column can be edited.
+func isTableCellEditable[T comparable](columns []TableColumn, visibleColumnIndices []int, row TableRow[T], columnIndex int) bool {
+	if columnIndex < 0 || columnIndex >= len(columns) {
+		return false
+	}
+	if columns[columnIndex].Editor == TableCellEditorTypeNone {
+		return false
+	}
+	if !slices.Contains(visibleColumnIndices, columnIndex) {
+		return false
+	}
+	return row.selectable()
+}
+
+func (t *Table[T]) startEditingCell(rowIndex, columnIndex int) bool {
+	if !t.isCellEditable(rowIndex, columnIndex) {
+		return false
+	}
+	t.editingRowIndexPlus1 = rowIndex + 1
+	t.editingColumnIndex = columnIndex
+
+	var text string
+	if row, _ := t.ItemByIndex(rowIndex); columnIndex < len(row.Cells) {
+		text = row.Cells[columnIndex].Text
+	}
+	t.cellEditor.reset(&t.columns[columnIndex], text)
+
+	if t.list.SelectedItemIndex() != rowIndex {
+		t.list.SelectItemByIndex(rowIndex)
+	}
+	t.list.EnsureItemVisibleByIndex(rowIndex)
+	guigui.RequestRebuild(t)
+	return true
+}
+
+// commitCellEditing commits the value of the cell being edited, and moves to the next cell to edit if needed.
+// If the value is rejected, the editor is kept open.
+func (t *Table[T]) commitCellEditing(context *guigui.Context, value TableCellValue, move tableCellEditorMove) {
+	if !t.isEditingCell() {
+		return
+	}
+	rowIndex, columnIndex := t.editingRowIndexPlus1-1, t.editingColumnIndex
+	if r, ok := guigui.DispatchEvent(t, tableEventCellEditCommitted, rowIndex, columnIndex, value); ok {
+		if err, _ := r[0].(error); err != nil {
+			t.cellEditor.setError(true)
+			// The editor might lose the focus, e.g. when the value is committed by clicking outside.
+			if !context.IsFocusedOrHasFocusedChild(&t.cellEditor) {
+				t.cellEditor.focusPending = true
+			}
+			return
+		}
+	}
+	if r, c, ok := t.nextEditableCell(rowIndex, columnIndex, move); ok {
+		t.startEditingCell(r, c)
+		return
+	}
+	t.endCellEditing(context)
+}
+
+// endCellEditing ends editing without committing the value.
+func (t *Table[T]) endCellEditing(context *guigui.Context) {
+	if !t.isEditingCell() {
+		return
+	}
+	t.editingRowIndexPlus1 = 0
+	t.editingColumnIndex = 0
+	guigui.RequestRebuild(t)
+	if context.IsFocusedOrHasFocusedChild(&t.cellEditor) {
+		context.SetFocused(&t.list, true)
+	}
+}
+
+// nextEditableCell returns the next cell to edit after the cell at rowIndex and columnIndex.
+func (t *Table[T]) nextEditableCell(rowIndex, columnIndex int, move tableCellEditorMove) (int, int, bool) {
+	return nextEditableTableCell(t.ItemCount(), t.visibleColumnIndices, rowIndex, columnIndex, move, t.isCellEditable)
+}
+
+// nextEditableTableCell returns the next cell to edit after the cell at rowIndex and columnIndex
+// among rowCount rows and the visible columns in the displayed order.
+func nextEditableTableCell(rowCount int, visibleColumnIndices []int, rowIndex, columnIndex int, move tableCellEditorMove, isCellEditable func(rowIndex, columnIndex int) bool) (int, int, bool) {
+	switch move {
+	case tableCellEditorMoveDown:
+		if rowIndex+1 < rowCount && isCellEditable(rowIndex+1, columnIndex) {
+			return rowIndex + 1, columnIndex, true
+		}
+		return 0, 0, false
+	case tableCellEditorMoveNext, tableCellEditorMovePrev:
+		step := 1
+		if move == tableCellEditorMovePrev {
+			step = -1
+		}
+		// Move in the displayed order, and wrap to the next or previous row.
+		r, pos := rowIndex, slices.Index(visibleColumnIndices, columnIndex)
+		for {
+			pos += step
+			if pos >= len(visibleColumnIndices) {
+				pos = 0
+				r++
+			} else if pos < 0 {
+				pos = len(visibleColumnIndices) - 1
+				r--
+			}
+			if r < 0 || r >= rowCount {
+				return 0, 0, false
+			}
+			if isCellEditable(r, visibleColumnIndices[pos]) {
+				return r, visibleColumnIndices[pos], true
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+// OnSortChanged sets the event handler that is called when the sort columns are changed by the user.
+// sortColumns must not be retained after the handler returns.
+func (t *Table[T]) OnSortChanged(f func(context *guigui.Context, sortColumns []TableSortColumn)) {
+	guigui.SetEventHandler(t, tableEventSortChanged, f)
+}
+
+// AppendSortColumns appends the sort columns in the priority order to sortColumns and returns the extended slice.
+func (t *Table[T]) AppendSortColumns(sortColumns []TableSortColumn) []TableSortColumn {
+	return append(sortColumns, t.sortColumns...)
+}
+
+// SetSortColumns sets the sort columns in the priority order.
+// Sort columns with [SortOrderNone] are ignored.
+//
+// The selection is preserved by the row values.
+func (t *Table[T]) SetSortColumns(sortColumns []TableSortColumn) {
+	t.tmpSortColumns = slices.Delete(t.tmpSortColumns, 0, len(t.tmpSortColumns))
+	for _, c := range sortColumns {
+		if c.Order == SortOrderNone {
+			continue
+		}
+		t.tmpSortColumns = append(t.tmpSortColumns, c)
+	}
+	if slices.Equal(t.sortColumns, t.tmpSortColumns) {
+		return
+	}
+	t.sortColumns = slices.Delete(t.sortColumns, 0, len(t.sortColumns))
+	t.sortColumns = append(t.sortColumns, t.tmpSortColumns...)
+
+	t.preserveSelectedValues()
+	if !t.externalSorting {
+		t.updateTableRows()
+	}
+	guigui.RequestRebuild(t)
+}
+
+// preserveSelectedValues stores the selected values to restore the selection after the rows are rearranged.
+func (t *Table[T]) preserveSelectedValues() {
+	t.selectedValuesToRestore = slices.Delete(t.selectedValuesToRestore, 0, len(t.selectedValuesToRestore))
+	t.tmpSelectedIndices = t.list.AppendSelectedItemIndices(t.tmpSelectedIndices[:0])
+	for _, idx := range t.tmpSelectedIndices {
+		if row, ok := t.ItemByIndex(idx); ok {
+			t.selectedValuesToRestore = append(t.selectedValuesToRestore, row.Value)
+		}
+	}
+	t.hasSelectedValuesToRestore = true
+}
+
+// SetExternalSorting sets whether the rows are sorted outside of the table, e.g. by a server.
+// If external is true, the table doesn't sort the rows by itself.
+// Sort the rows in [Table.OnSortChanged] and set them by [Table.SetItems] instead.
+// The selection is preserved by the row values when the sorted rows are set.
+func (t *Table[T]) SetExternalSorting(external bool) {
+	if t.externalSorting == external {
+		return
+	}
+	t.externalSorting = external
+	t.updateTableRows()
+}
+
+func (t *Table[T]) sortOrder(columnIndex int) (SortOrder, int) {
+	for i, c := range t.sortColumns {
+		if c.ColumnIndex == columnIndex {
+			return c.Order, i
+		}
+	}
+	return SortOrderNone, -1
+}
+
+// toggleSortByUser cycles the sort order of the column in none, ascending and descending.
+// If multi is true, the other sort columns are kept.
+func (t *Table[T]) toggleSortByUser(columnIndex int, multi bool) {
+	order, priority := t.sortOrder(columnIndex)
+	order = (order + 1) % 3
+
+	var sortColumns []TableSortColumn
+	if multi {
+		sortColumns = slices.Clone(t.sortColumns)
+		switch {
+		case priority >= 0 && order == SortOrderNone:
+			sortColumns = slices.Delete(sortColumns, priority, priority+1)
+		case priority >= 0:
+			sortColumns[priority].Order = order
+		default:
+			sortColumns = append(sortColumns, TableSortColumn{
+				ColumnIndex: columnIndex,
+				Order:       order,
+			})
+		}
+	} else if order != SortOrderNone {
+		sortColumns = []TableSortColumn{
+			{
+				ColumnIndex: columnIndex,
+				Order:       order,
+			},
+		}
+	}
+	t.SetSortColumns(sortColumns)
+	guigui.DispatchEvent(t, tableEventSortChanged, t.sortColumns)
+}
+
+func (t *Table[T]) isSorted() bool {
+	return !t.externalSorting && t.dataSourceRows.dataSource == nil && len(t.sortColumns) > 0
+}
+
+func (t *Table[T]) compareRows(a, b TableRow[T]) int {
+	return compareTableRows(t.columns, t.sortColumns, a, b)
+}
+
+// compareTableRows compares the rows by the sort columns in the priority order.
+// Sort columns with [SortOrderNone] are ignored.
+func compareTableRows[T comparable](columns []TableColumn, sortColumns []TableSortColumn, a, b TableRow[T]) int {
+	for _, c := range sortColumns {
+		if c.Order == SortOrderNone || c.ColumnIndex < 0 || c.ColumnIndex >= len(columns) {
+			continue
+		}
+		var cellA, cellB TableCell
+		if c.ColumnIndex < len(a.Cells) {
+			cellA = a.Cells[c.ColumnIndex]
+		}
+		if c.ColumnIndex < len(b.Cells) {
+			cellB = b.Cells[c.ColumnIndex]
+		}
+		var r int
+		if f := columns[c.ColumnIndex].Compare; f != nil {
+			r = f(cellA, cellB)
+		} else {
+			r = compareTableCellTexts(cellA.Text, cellB.Text)
+		}
+		if c.Order == SortOrderDescending {
+			r = -r
+		}
+		if r != 0 {
+			return r
+		}
+	}
+	return 0
+}
+
+// compareTableCellTexts compares the texts as numbers if both can be parsed as numbers, and as strings otherwise.
+// The numbers are ordered before the other texts, so that the order is consistent in a column mixing them.
+func compareTableCellTexts(a, b string) int {
+	na, errA := strconv.ParseFloat(strings.TrimSpace(a), 64)
+	nb, errB := strconv.ParseFloat(strings.TrimSpace(b), 64)
+	switch {
+	case errA == nil && errB == nil:
+		return cmp.Compare(na, nb)
+	case errA == nil:
+		return -1
+	case errB == nil:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+// sortTableRows sorts the rows stably.
+// The rows in a tree are sorted among their siblings, and the children are kept after their parents.
+func sortTableRows[T comparable](rows []TableRow[T], cmp func(a, b TableRow[T]) int) {
+	if !slices.ContainsFunc(rows, func(row TableRow[T]) bool {
+		return row.IndentLevel > 0
+	}) {
+		slices.SortStableFunc(rows, cmp)
+		return
+	}
+
+	// Split the rows into subtrees, each of which consists of a row and its descendants.
+	type subtree struct {
+		start int
+		end   int
+	}
+	var subtrees []subtree
+	for start := 0; start < len(rows); {
+		end := start + 1
+		if level := rows[start].IndentLevel; level > 0 {
+			for end < len(rows) && rows[end].IndentLevel > level {
+				end++
+			}
+		}
+		sortTableRows(rows[start+1:end], cmp)
+		subtrees = append(subtrees, subtree{
+			start: start,
+			end:   end,
+		})
+		start = end
+	}
+	slices.SortStableFunc(subtrees, func(a, b subtree) int {
+		return cmp(rows[a.start], rows[b.start])
+	})
+	sorted := make([]TableRow[T], 0, len(rows))
+	for _, s := range subtrees {
+		sorted = append(sorted, rows[s.start:s.end]...)
+	}
+	copy(rows, sorted)
+}
+
+// tableRowHasChildren reports whether row has child rows, given the next row.
+func tableRowHasChildren[T comparable](row, next TableRow[T]) bool {
+	return row.IndentLevel > 0 && next.IndentLevel > row.IndentLevel
+}
+
+// OnItemExpanderToggled sets the event handler that is called when the expander of a tree row is toggled by the user.
+// expanded is the new state. Update [TableRow.Collapsed] by [Table.SetItems] to reflect it.
+//
+// The expanders of the group rows are handled by the table itself.
+func (t *Table[T]) OnItemExpanderToggled(f func(context *guigui.Context, index int, expanded bool)) {
+	guigui.SetEventHandler(t, tableEventItemExpanderToggled, f)
+}
+
+// SetGroupColumnIndex sets the index of the column to group the rows by.
+// A negative index means that the rows are not grouped.
+//
+// The rows are grouped by the texts of the cells in the column, and the groups are in the order of their first rows.
+// Each group starts with a group row that can be collapsed.
+// A group row shows the text and the aggregates of the other columns (see [TableColumn.Aggregate]).
+// The rows in a tree belong to the group of their top-level row.
+//
+// The indices of the table's methods and events include the group rows.
+// Grouping is not available with a data source.
+func (t *Table[T]) SetGroupColumnIndex(columnIndex int) {
+	columnIndex = max(columnIndex, -1)
+	if t.groupColumnIndexPlus1 == columnIndex+1 {
+		return
+	}
+	t.preserveSelectedValues()
+	t.groupColumnIndexPlus1 = columnIndex + 1
+	clear(t.collapsedGroups)
+	t.updateTableRows()
+	guigui.RequestRebuild(t)
+}
+
+// GroupColumnIndex returns the index of the column to group the rows by, or -1 if the rows are not grouped.
+func (t *Table[T]) GroupColumnIndex() int {
+	return t.groupColumnIndexPlus1 - 1
+}
+
+func (t *Table[T]) groupColumnIndex() (int, bool) {
+	idx := t.groupColumnIndexPlus1 - 1
+	if idx < 0 || idx >= len(t.columns) || t.dataSourceRows.dataSource != nil {
+		return 0, false
+	}
+	return idx, true
+}
+
+// IsGroupRow reports whether the row at the given index is a group row.
+func (t *Table[T]) IsGroupRow(index int) bool {
+	if index < 0 || index >= len(t.tableRowInfos) {
+		return false
+	}
+	return t.tableRowInfos[index].group
+}
+
+// SetGroupCollapsed sets whether the rows in the group are hidden.
+// key is the text of the cells in the group column.
+//
+// The selection is preserved by the row values.
+func (t *Table[T]) SetGroupCollapsed(key string, collapsed bool) {
+	if t.collapsedGroups[key] == collapsed {
+		return
+	}
+	t.preserveSelectedValues()
+	if collapsed {
+		if t.collapsedGroups == nil {
+			t.collapsedGroups = map[string]bool{}
+		}
+		t.collapsedGroups[key] = true
+	} else {
+		delete(t.collapsedGroups, key)
+	}
+	t.updateTableRows()
+	guigui.RequestRebuild(t)
+}
+
+// IsGroupCollapsed reports whether the rows in the group are hidden.
+func (t *Table[T]) IsGroupCollapsed(key string) bool {
+	return t.collapsedGroups[key]
+}
+
+// toggleExpanderByUser toggles the expander of the row.
+func (t *Table[T]) toggleExpanderByUser(index int) {
+	if index < 0 || index >= len(t.tableRowInfos) {
+		return
+	}
+	info := t.tableRowInfos[index]
+	if !info.hasChildren {
+		return
+	}
+	if info.group {
+		t.SetGroupCollapsed(info.groupKey, !info.collapsed)
+		return
+	}
+	guigui.DispatchEvent(t, tableEventItemExpanderToggled, index, info.collapsed)
+}
+
+// update sets the items sorted by the sort columns and grouped by the column at groupColumnIndex to the displayed rows,
+// and updates the aggregates.
+// If sortColumns is empty, the items are not sorted. If groupColumnIndex is negative, the items are not grouped.
+func (t *tableDisplayedRows[T]) update(items []TableRow[T], columns []TableColumn, sortColumns []TableSortColumn, groupColumnIndex int, collapsedGroups map[string]bool) {
+	t.tableRows = adjustSliceSize(t.tableRows, len(items))
+	copy(t.tableRows, items)
+	if len(sortColumns) > 0 {
+		sortTableRows(t.tableRows, func(a, b TableRow[T]) int {
+			return compareTableRows(columns, sortColumns, a, b)
+		})
+	}
+
+	t.tmpRowIndices = adjustSliceSize(t.tmpRowIndices, len(t.tableRows))
+	for i := range t.tmpRowIndices {
+		t.tmpRowIndices[i] = i
+	}
+	t.footerCells = adjustSliceSize(t.footerCells, len(columns))
+	t.aggregateCells(columns, t.footerCells, t.tmpRowIndices)
+
+	t.groupTableRows(columns, groupColumnIndex, collapsedGroups)
+}
+
+// groupTableRows inserts the group rows into the sorted rows, and removes the rows in the collapsed groups.
+// groupTableRows also updates the information of the displayed rows.
+// If col is negative, the rows are not grouped.
+func (t *tableDisplayedRows[T]) groupTableRows(columns []TableColumn, col int, collapsedGroups map[string]bool) {
+	if col < 0 {
+		t.tableRowInfos = adjustSliceSize(t.tableRowInfos, len(t.tableRows))
+		for i, row := range t.tableRows {
+			var hasChildren bool
+			if i+1 < len(t.tableRows) {
+				hasChildren = tableRowHasChildren(row, t.tableRows[i+1])
+			}
+			t.tableRowInfos[i] = tableRowInfo{
+				indentLevel: row.IndentLevel,
+				hasChildren: hasChildren,
+				collapsed:   row.Collapsed,
+			}
+		}
+		return
+	}
+
+	t.sortRowIndicesByGroups(t.tableRows, col)
+
+	t.tmpGroupedRows = slices.Delete(t.tmpGroupedRows, 0, len(t.tmpGroupedRows))
+	t.tableRowInfos = slices.Delete(t.tableRowInfos, 0, len(t.tableRowInfos))
+	t.groupCells = adjustSliceSize(t.groupCells, len(t.tmpGroupOrders))
+	for start := 0; start < len(t.tmpRowIndices); {
+		key := t.tmpGroupKeys[t.tmpRowIndices[start]]
+		end := start + 1
+		for end < len(t.tmpRowIndices) && t.tmpGroupKeys[t.tmpRowIndices[end]] == key {
+			end++
+		}
+		indices := t.tmpRowIndices[start:end]
+
+		g := t.tmpGroupOrders[key]
+		t.groupCells[g] = adjustSliceSize(t.groupCells[g], len(columns))
+		t.aggregateCells(columns, t.groupCells[g], indices)
+		t.groupCells[g][col] = TableCell{
+			Text:      key,
+			TextStyle: tableRowCell(t.tableRows[indices[0]], col).TextStyle,
+		}
+		collapsed := collapsedGroups[key]
+		t.tmpGroupedRows = append(t.tmpGroupedRows, TableRow[T]{
+			Cells:  t.groupCells[g],
+			Header: true,
+		})
+		t.tableRowInfos = append(t.tableRowInfos, tableRowInfo{
+			group:       true,
+			groupKey:    key,
+			indentLevel: 1,
+			hasChildren: true,
+			collapsed:   collapsed,
+		})
+
+		if !collapsed {
+			for j, idx := range indices {
+				row := t.tableRows[idx]
+				var hasChildren bool
+				if j+1 < len(indices) {
+					hasChildren = tableRowHasChildren(row, t.tableRows[indices[j+1]])
+				}
+				t.tmpGroupedRows = append(t.tmpGroupedRows, row)
+				t.tableRowInfos = append(t.tableRowInfos, tableRowInfo{
+					indentLevel: row.IndentLevel + 1,
+					hasChildren: hasChildren,
+					collapsed:   row.Collapsed,
+				})
+			}
+		}
+
+		start = end
+	}
+	t.tableRows, t.tmpGroupedRows = t.tmpGroupedRows, t.tableRows
+}
+
+// sortRowIndicesByGroups sets the indices of the rows sorted by the groups to t.tmpRowIndices, keeping the order in each group.
+// sortRowIndicesByGroups also sets the group keys of the rows to t.tmpGroupKeys, and the orders of the groups to t.tmpGroupOrders.
+func (t *tableDisplayedRows[T]) sortRowIndicesByGroups(rows []TableRow[T], col int) {
+	// The key of a row is the text of the cell in the group column of its top-level row,
+	// so that a subtree is not split into groups.
+	t.tmpGroupKeys = adjustSliceSize(t.tmpGroupKeys, len(rows))
+	var key string
+	for i, row := range rows {
+		if i == 0 || row.IndentLevel <= 1 {
+			key = tableRowCellText(row, col)
+		}
+		t.tmpGroupKeys[i] = key
+	}
+	if t.tmpGroupOrders == nil {
+		t.tmpGroupOrders = map[string]int{}
+	}
+	clear(t.tmpGroupOrders)
+	for _, key := range t.tmpGroupKeys {
+		if _, ok := t.tmpGroupOrders[key]; !ok {
+			t.tmpGroupOrders[key] = len(t.tmpGroupOrders)
+		}
+	}
+
+	t.tmpRowIndices = adjustSliceSize(t.tmpRowIndices, len(rows))
+	for i := range t.tmpRowIndices {
+		t.tmpRowIndices[i] = i
+	}
+	slices.SortStableFunc(t.tmpRowIndices, func(a, b int) int {
+		return cmp.Compare(t.tmpGroupOrders[t.tmpGroupKeys[a]], t.tmpGroupOrders[t.tmpGroupKeys[b]])
+	})
+}
+
+// aggregateCells sets the aggregates of the columns over the leaf rows to cells.
+// indices is the indices of the rows in t.tableRows in the displayed order.
+//
+// The text style of an aggregate is the one of the cell in the first row.
+func (t *tableDisplayedRows[T]) aggregateCells(columns []TableColumn, cells []TableCell, indices []int) {
+	for col := range columns {
+		column := &columns[col]
+		if column.Aggregate == TableAggregateTypeNone || len(indices) == 0 {
+			cells[col] = TableCell{}
+			continue
+		}
+		t.tmpAggregateCells = slices.Delete(t.tmpAggregateCells, 0, len(t.tmpAggregateCells))
+		for j, idx := range indices {
+			row := t.tableRows[idx]
+			if j+1 < len(indices) && tableRowHasChildren(row, t.tableRows[indices[j+1]]) {
+				continue
+			}
+			t.tmpAggregateCells = append(t.tmpAggregateCells, tableRowCell(row, col))
+		}
+		cells[col] = TableCell{
+			Text:      aggregateTableCells(column, t.tmpAggregateCells),
+			TextStyle: tableRowCell(t.tableRows[indices[0]], col).TextStyle,
+		}
+	}
+}
+
+func tableRowCell[T comparable](row TableRow[T], columnIndex int) TableCell {
+	if columnIndex >= len(row.Cells) {
+		return TableCell{}
+	}
+	return row.Cells[columnIndex]
+}
+
+func tableRowCellText[T comparable](row TableRow[T], columnIndex int) string {
+	return tableRowCell(row, columnIndex).Text
+}
+
+// aggregateTableCells returns the text of the aggregate of the cells in the column.
+func aggregateTableCells(column *TableColumn, cells []TableCell) string {
+	switch column.Aggregate {
+	case TableAggregateTypeCount:
+		return strconv.Itoa(len(cells))
+	case TableAggregateTypeSum:
+		return sumTableCellTexts(cells)
+	case TableAggregateTypeMin, TableAggregateTypeMax:
+		var result TableCell
+		var found bool
+		for _, c := range cells {
+			if strings.TrimSpace(c.Text) == "" {
+				continue
+			}
+			if !found {
+				result = c
+				found = true
+				continue
+			}
+			var r int
+			if column.Compare != nil {
+				r = column.Compare(c, result)
+			} else {
+				r = compareTableCellTexts(c.Text, result.Text)
+			}
+			if column.Aggregate == TableAggregateTypeMin && r < 0 || column.Aggregate == TableAggregateTypeMax && r > 0 {
+				result = c
+			}
+		}
+		return result.Text
+	case TableAggregateTypeCustom:
+		if column.AggregateFunc != nil {
+			return column.AggregateFunc(cells)
+		}
+	}
+	return ""
+}
+
+// sumTableCellTexts returns the sum of the texts that can be parsed as numbers.
+// The sum is computed exactly, and is formatted with the maximum number of the fraction digits of the texts.
+// sumTableCellTexts returns an empty string if there is no such text.
+func sumTableCellTexts(cells []TableCell) string {
+	var sum big.Rat
+	var digits int
+	var found bool
+	for _, c := range cells {
+		text := strings.TrimSpace(c.Text)
+		if text == "" || strings.Contains(text, "/") {
+			continue
+		}
+		var r big.Rat
+		if _, ok := r.SetString(text); !ok {
+			continue
+		}
+		sum.Add(&sum, &r)
+		found = true
+		if i := strings.IndexByte(text, '.'); i >= 0 && !strings.ContainsAny(text, "eE") {
+			digits = max(digits, len(text)-i-1)
+		}
+	}
+	if !found {
+		return ""
+	}
+	return sum.FloatString(digits)
 }
 
 func (t *Table[T]) SetMultiSelection(multi bool) {
@@ -80,12 +1222,29 @@ func (t *Table[T]) OnItemsSelected(f func(context *guigui.Context, indices []int
 	t.list.OnItemsSelected(f)
 }
 
-func (t *Table[T]) OnItemsMoved(f func(context *guigui.Context, from, count, to int)) {
-	t.list.OnItemsMoved(f)
+func (t *Table[T]) OnItemsMoved(f func(context *guigui.Context, from, count, to int)) {
+	t.list.OnItemsMoved(f)
+}
+
+func (t *Table[T]) OnItemsCanMove(f func(context *guigui.Context, from, count, to int) bool) {
+	t.list.OnItemsCanMove(f)
+}
+
+// OnItemClicked sets the event handler that is called when a row is clicked.
+// See [List.OnItemClicked] for details.
+func (t *Table[T]) OnItemClicked(f func(context *guigui.Context, index int, click guigui.Click)) {
+	t.list.OnItemClicked(f)
+}
+
+// OnEndReached sets the event handler that is called when the table is scrolled to the end.
+// The handler is called once per row count, so appending rows, e.g. for infinite scrolling, lets it be called again.
+func (t *Table[T]) OnEndReached(f func(context *guigui.Context)) {
+	t.list.OnEndReached(f)
 }
 
-func (t *Table[T]) OnItemsCanMove(f func(context *guigui.Context, from, count, to int) bool) {
-	t.list.OnItemsCanMove(f)
+// OnItemActivated sets the event handler that is called when a row is double-clicked.
+func (t *Table[T]) OnItemActivated(f func(context *guigui.Context, index int)) {
+	t.list.OnItemActivated(f)
 }
 
 // SetReservesCheckmarkSpace sets whether the table reserves space for the
@@ -95,8 +1254,27 @@ func (t *Table[T]) SetReservesCheckmarkSpace(reserves bool) {
 	t.list.SetReservesCheckmarkSpace(reserves)
 }
 
+// SetCheckboxSelection sets whether the table shows a checkbox for each selectable row.
+// See [List.SetCheckboxSelection] for details.
+func (t *Table[T]) SetCheckboxSelection(checkbox bool) {
+	t.list.SetCheckboxSelection(checkbox)
+}
+
+// OnItemCheckToggled sets the event handler that is called when the checkbox of a row is toggled by the user.
+// checked is the new state. Update [TableRow.Checked] by [Table.SetItems] to reflect it.
+func (t *Table[T]) OnItemCheckToggled(f func(context *guigui.Context, index int, checked bool)) {
+	t.list.OnItemCheckToggled(f)
+}
+
+// SetFooterHeight sets the height of the footer.
+// The footer shows the aggregates of the columns over all the rows (see [TableColumn.Aggregate]).
 func (t *Table[T]) SetFooterHeight(height int) {
+	if t.footerHeight == height {
+		return
+	}
+	t.footerHeight = height
 	t.list.SetFooterHeight(height)
+	guigui.RequestRebuild(t)
 }
 
 func (t *Table[T]) ItemBounds(index int) image.Rectangle {
@@ -110,17 +1288,16 @@ func (t *Table[T]) CellBounds(rowIndex, colIndex int) image.Rectangle {
 	if itemBounds.Empty() {
 		return image.Rectangle{}
 	}
-	if colIndex < 0 || colIndex >= len(t.columnWidthsInPixels) {
+	// colIndex is the index in the columns, not in the displayed order.
+	pos := slices.Index(t.visibleColumnIndices, colIndex)
+	if pos < 0 || pos >= len(t.columnWidthsInPixels) {
 		return image.Rectangle{}
 	}
 
-	x := itemBounds.Min.X
-	for i := range colIndex {
-		x += t.columnWidthsInPixels[i]
-	}
+	x0, x1 := t.columnRangeX(itemBounds.Min.X, pos)
 	return image.Rectangle{
-		Min: image.Pt(x, itemBounds.Min.Y),
-		Max: image.Pt(x+t.columnWidthsInPixels[colIndex], itemBounds.Max.Y),
+		Min: image.Pt(x0, itemBounds.Min.Y),
+		Max: image.Pt(x1, itemBounds.Max.Y),
 	}
 }
 
@@ -134,15 +1311,55 @@ func (t *Table[T]) IsItemAvailable(index int) bool {
 	return t.list.IsItemAvailable(index)
 }
 
+// updateTableRows sorts and groups the rows and updates the list items.
 func (t *Table[T]) updateTableRows() {
+	if t.dataSourceRows.dataSource != nil {
+		t.tableRows = slices.Delete(t.tableRows, 0, len(t.tableRows))
+		t.tableRowInfos = slices.Delete(t.tableRowInfos, 0, len(t.tableRowInfos))
+		t.footerCells = slices.Delete(t.footerCells, 0, len(t.footerCells))
+		t.tableRowWidgets.SetLen(0)
+		t.listItems = slices.Delete(t.listItems, 0, len(t.listItems))
+		t.list.SetDataSource(&t.dataSourceRows)
+		t.restoreSelectedValues()
+		return
+	}
+
+	var sortColumns []TableSortColumn
+	sorted := t.isSorted()
+	if sorted {
+		sortColumns = t.sortColumns
+	}
+	groupColumnIndex, grouped := t.groupColumnIndex()
+	if !grouped {
+		groupColumnIndex = -1
+	}
+	t.update(t.items, t.columns, sortColumns, groupColumnIndex, t.collapsedGroups)
+
 	t.tableRowWidgets.SetLen(len(t.tableRows))
 	t.listItems = adjustSliceSize(t.listItems, len(t.tableRows))
 
 	for i, row := range t.tableRows {
 		t.tableRowWidgets.At(i).setTableRow(row)
+		t.tableRowWidgets.At(i).setInfo(t.tableRowInfos[i])
+		t.tableRowWidgets.At(i).index = i
+		t.tableRowWidgets.At(i).table = t
 		t.listItems[i] = t.tableRowWidgets.At(i).listItem()
+		// Moving rows doesn't make sense while the rows are sorted or grouped.
+		if sorted || grouped {
+			t.listItems[i].Movable = false
+		}
 	}
 	t.list.SetItems(t.listItems)
+	t.restoreSelectedValues()
+}
+
+// restoreSelectedValues selects the rows that were selected before the rows are rearranged.
+func (t *Table[T]) restoreSelectedValues() {
+	if t.hasSelectedValuesToRestore {
+		t.list.SelectItemsByValues(t.selectedValuesToRestore)
+		t.selectedValuesToRestore = slices.Delete(t.selectedValuesToRestore, 0, len(t.selectedValuesToRestore))
+		t.hasSelectedValuesToRestore = false
+	}
 }
 
 func (t *Table[T]) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
@@ -151,30 +1368,81 @@ func (t *Table[T]) Build(context *guigui.Context, adder *guigui.ChildAdder) erro
 
 	adder.AddWidget(&t.list)
 	adder.AddWidget(&t.tableHeader)
+	if t.footerHeight > 0 {
+		adder.AddWidget(&t.footer)
+		context.SetClipChildren(&t.footer, true)
+	}
 
 	context.SetClipChildren(&t.tableHeader, true)
 
 	t.list.SetHeaderHeight(tableHeaderHeight(context))
 	t.list.SetStyle(ListStyleNormal)
 	t.list.SetStripeVisible(true)
+	// The table copies the rows by itself.
+	t.list.setCopyDisabled(true)
 
 	for i := range t.tableRowWidgets.Len() {
 		row := t.tableRowWidgets.At(i)
 		row.table = t
 	}
 	t.tableHeader.table = t
+	t.footer.table = t
+	t.cellEditor.table = t
+
+	// The row being edited might be removed.
+	if t.editingRowIndexPlus1-1 >= t.ItemCount() {
+		t.editingRowIndexPlus1 = 0
+	}
+
+	return nil
+}
+
+func (t *Table[T]) HandleButtonInput(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
+	if t.isEditingCell() {
+		return guigui.HandleInputResult{}
+	}
+	if !isDarwin() && guigui.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyC) ||
+		isDarwin() && guigui.IsKeyPressed(ebiten.KeyMeta) && isKeyRepeating(ebiten.KeyC) {
+		if t.Copy() {
+			return guigui.HandleInputByWidget(t)
+		}
+		return guigui.HandleInputResult{}
+	}
+	if !guigui.IsKeyJustPressed(ebiten.KeyEnter) && !guigui.IsKeyJustPressed(ebiten.KeyF2) {
+		return guigui.HandleInputResult{}
+	}
+	// Start editing the first editable cell of the selected row.
+	rowIndex := t.list.SelectedItemIndex()
+	for _, idx := range t.visibleColumnIndices {
+		if t.startEditingCell(rowIndex, idx) {
+			return guigui.HandleInputByWidget(t)
+		}
+	}
+	return guigui.HandleInputResult{}
+}
 
+func (t *Table[T]) Tick(context *guigui.Context, widgetBounds *guigui.WidgetBounds) error {
+	// End editing when the focus moves out of the editor, e.g. by clicking another widget.
+	if t.isEditingCell() && !t.cellEditor.focusPending && !context.IsFocusedOrHasFocusedChild(&t.cellEditor) {
+		t.editingRowIndexPlus1 = 0
+		t.editingColumnIndex = 0
+		guigui.RequestRebuild(t)
+	}
 	return nil
 }
 
 func (t *Table[T]) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
 	bounds := widgetBounds.Bounds()
 
-	t.columnWidthsInPixels = adjustSliceSize(t.columnWidthsInPixels, len(t.columns))
-	t.columnLayoutItems = adjustSliceSize(t.columnLayoutItems, len(t.columns))
-	for i, column := range t.columns {
+	t.columnWidthsInPixels = adjustSliceSize(t.columnWidthsInPixels, len(t.visibleColumnIndices))
+	t.columnLayoutItems = adjustSliceSize(t.columnLayoutItems, len(t.visibleColumnIndices))
+	for i, idx := range t.visibleColumnIndices {
+		size := t.columns[idx].Width
+		if w := t.columnStates[t.columnStateIndex(idx)].Width; w > 0 {
+			size = guigui.FixedSize(int(float64(w) * context.Scale()))
+		}
 		t.columnLayoutItems[i] = guigui.LinearLayoutItem{
-			Size: column.Width,
+			Size: size,
 		}
 	}
 
@@ -188,9 +1456,8 @@ func (t *Table[T]) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBo
 		},
 	}
 	t.tmpItemBounds = layout.AppendItemBounds(t.tmpItemBounds[:0], context, bounds)
-	for i := range t.columnWidthsInPixels {
-		t.columnWidthsInPixels[i] = t.tmpItemBounds[i].Dx()
-		t.columnWidthsInPixels[i] = max(t.columnWidthsInPixels[i], t.columns[i].MinWidth)
+	for i, idx := range t.visibleColumnIndices {
+		t.columnWidthsInPixels[i] = t.clampColumnWidth(idx, t.tmpItemBounds[i].Dx())
 	}
 	var contentWidth int
 	for _, width := range t.columnWidthsInPixels {
@@ -205,6 +1472,11 @@ func (t *Table[T]) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBo
 	bounds.Min.X += int(listBorderWidth(context))
 	bounds.Max.X -= int(listBorderWidth(context))
 	layouter.LayoutWidget(&t.tableHeader, bounds)
+
+	if t.footerHeight > 0 {
+		bounds.Min.Y = max(bounds.Max.Y-t.footerHeight, bounds.Min.Y)
+		layouter.LayoutWidget(&t.footer, bounds)
+	}
 }
 
 func tableHeaderHeight(context *guigui.Context) int {
@@ -225,21 +1497,29 @@ func (t *Table[T]) AppendSelectedItemIndices(indices []int) []int {
 }
 
 func (t *Table[T]) SelectedItem() (TableRow[T], bool) {
-	if t.list.SelectedItemIndex() < 0 || t.list.SelectedItemIndex() >= t.tableRowWidgets.Len() {
-		return TableRow[T]{}, false
-	}
-	return t.tableRowWidgets.At(t.list.SelectedItemIndex()).row, true
+	return t.ItemByIndex(t.list.SelectedItemIndex())
 }
 
+// ItemByIndex returns the row at the given index.
+// With a data source, ItemByIndex returns false if the row is not available yet.
 func (t *Table[T]) ItemByIndex(index int) (TableRow[T], bool) {
-	if index < 0 || index >= t.tableRowWidgets.Len() {
+	if index < 0 || index >= t.ItemCount() {
 		return TableRow[T]{}, false
 	}
+	if t.dataSourceRows.dataSource != nil {
+		return t.dataSourceRows.dataSource.ItemAt(index)
+	}
 	return t.tableRowWidgets.At(index).row, true
 }
 
 func (t *Table[T]) IndexByValue(value T) int {
+	if t.dataSourceRows.dataSource != nil {
+		return t.dataSourceRows.IndexByValue(value)
+	}
 	for i := range t.tableRowWidgets.Len() {
+		if t.IsGroupRow(i) {
+			continue
+		}
 		if t.tableRowWidgets.At(i).row.Value == value {
 			return i
 		}
@@ -247,18 +1527,40 @@ func (t *Table[T]) IndexByValue(value T) int {
 	return -1
 }
 
+// SetItems sets the rows.
+// If the table has sort columns, the rows are shown in the sorted order,
+// and the indices of the table's methods and events are the indices in the sorted order.
 func (t *Table[T]) SetItems(items []TableRow[T]) {
-	t.tableRows = adjustSliceSize(t.tableRows, len(items))
-	copy(t.tableRows, items)
+	t.dataSourceRows.setDataSource(nil, t)
+	t.items = adjustSliceSize(t.items, len(items))
+	copy(t.items, items)
+	t.updateTableRows()
+}
+
+// SetDataSource sets the data source to provide the rows lazily, instead of [Table.SetItems].
+// The table queries only the rows around the viewport.
+//
+// If dataSource is nil, the table has no rows.
+func (t *Table[T]) SetDataSource(dataSource TableDataSource[T]) {
+	if dataSource == nil {
+		t.SetItems(nil)
+		return
+	}
+	t.items = slices.Delete(t.items, 0, len(t.items))
+	t.dataSourceRows.setDataSource(dataSource, t)
 	t.updateTableRows()
 }
 
 func (t *Table[T]) ItemCount() int {
+	if t.dataSourceRows.dataSource != nil {
+		return t.dataSourceRows.ItemCount()
+	}
 	return t.tableRowWidgets.Len()
 }
 
 func (t *Table[T]) ID(index int) any {
-	return t.tableRowWidgets.At(index).row.Value
+	row, _ := t.ItemByIndex(index)
+	return row.Value
 }
 
 func (t *Table[T]) SelectItemByIndex(index int) {
@@ -274,6 +1576,11 @@ func (t *Table[T]) SelectAllItems() {
 }
 
 func (t *Table[T]) SelectItemByValue(value T) {
+	// A group row might have the same value as the row.
+	if _, ok := t.groupColumnIndex(); ok {
+		t.list.SelectItemByIndex(t.IndexByValue(value))
+		return
+	}
 	t.list.SelectItemByValue(value)
 }
 
@@ -296,19 +1603,39 @@ func (t *Table[T]) Measure(context *guigui.Context, constraints guigui.Constrain
 type tableRowWidget[T comparable] struct {
 	guigui.DefaultWidget
 
-	row   TableRow[T]
-	table *Table[T]
-	texts guigui.WidgetSlice[*Text]
-
-	linearLayoutItems     []guigui.LinearLayoutItem
-	textColumnLayouts     []guigui.LinearLayout
-	textColumnLayoutItems []guigui.LinearLayoutItem
+	row            TableRow[T]
+	info           tableRowInfo
+	index          int
+	table          *Table[T]
+	texts          guigui.WidgetSlice[*Text]
+	expander       Image
+	scrollableArea tableScrollableArea
 }
 
 func (t *tableRowWidget[T]) setTableRow(row TableRow[T]) {
 	t.row = row
 }
 
+func (t *tableRowWidget[T]) setInfo(info tableRowInfo) {
+	t.info = info
+}
+
+// indentSize returns the size of the indent of the first visible column.
+func (t *tableRowWidget[T]) indentSize(context *guigui.Context) int {
+	return ListItemIndentSize(context, t.info.indentLevel)
+}
+
+// expanderBounds returns the bounds of the expander, or an empty rectangle if the row has no expander.
+func (t *tableRowWidget[T]) expanderBounds(context *guigui.Context, bounds image.Rectangle) image.Rectangle {
+	if !t.info.hasChildren || len(t.table.visibleColumnIndices) == 0 {
+		return image.Rectangle{}
+	}
+	x0, _ := t.table.columnRangeX(bounds.Min.X, 0)
+	x := x0 + t.indentSize(context) - LineHeight(context)
+	y := bounds.Min.Y + ListItemTextPadding(context).Top
+	return image.Rect(x, y, x+LineHeight(context), y+LineHeight(context))
+}
+
 func (t *tableRowWidget[T]) ensureTexts() {
 	t.texts.SetLen(len(t.row.Cells))
 	for i, cell := range t.row.Cells {
@@ -320,59 +1647,98 @@ func (t *tableRowWidget[T]) ensureTexts() {
 		// Color is adjusted at Layout.
 		txt.SetHorizontalAlign(cell.TextStyle.HorizontalAlign)
 		txt.SetVerticalAlign(cell.TextStyle.VerticalAlign)
-		txt.SetBold(cell.TextStyle.Bold)
+		txt.SetBold(cell.TextStyle.Bold || t.row.Header)
 		txt.SetTabular(cell.TextStyle.Tabular)
 		txt.SetWrapMode(cell.TextStyle.WrapMode)
 	}
 }
 
+// cellWidget returns the widget of the cell in the column, or nil if there is no cell.
+// cellWidget also reports whether the widget is a text of the cell.
+func (t *tableRowWidget[T]) cellWidget(columnIndex int) (guigui.Widget, bool) {
+	if columnIndex == t.table.editingColumnIndexAt(t.index) {
+		return &t.table.cellEditor, false
+	}
+	if columnIndex >= len(t.row.Cells) {
+		return nil, false
+	}
+	if c := t.row.Cells[columnIndex].Content; c != nil {
+		return c, false
+	}
+	return t.texts.At(columnIndex), true
+}
+
 func (t *tableRowWidget[T]) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
 	t.ensureTexts()
-	for i, cell := range t.row.Cells {
-		if cell.Content != nil {
-			adder.AddWidget(cell.Content)
+
+	// The cells of the scrollable columns are clipped not to be rendered over the frozen columns.
+	t.scrollableArea.reset()
+	adder.AddWidget(&t.scrollableArea)
+	for pos, idx := range t.table.visibleColumnIndices {
+		w, _ := t.cellWidget(idx)
+		if w == nil {
+			continue
+		}
+		if t.table.isColumnFrozen(pos) {
+			adder.AddWidget(w)
+		} else {
+			t.scrollableArea.addWidget(w)
+		}
+	}
+
+	// The expander is in the first visible column.
+	if t.info.hasChildren && len(t.table.visibleColumnIndices) > 0 {
+		name := "keyboard_arrow_down"
+		if t.info.collapsed {
+			name = "keyboard_arrow_right"
+		}
+		img, err := theResourceImages.Get(name, context.ColorMode())
+		if err != nil {
+			return err
+		}
+		t.expander.SetImage(img)
+		if t.table.isColumnFrozen(0) {
+			adder.AddWidget(&t.expander)
 		} else {
-			adder.AddWidget(t.texts.At(i))
+			t.scrollableArea.addWidget(&t.expander)
 		}
 	}
 	return nil
 }
 
 func (t *tableRowWidget[T]) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
-	t.linearLayoutItems = slices.Delete(t.linearLayoutItems, 0, len(t.linearLayoutItems))
-	t.textColumnLayouts = t.textColumnLayouts[:0]
-	t.textColumnLayoutItems = slices.Delete(t.textColumnLayoutItems, 0, len(t.textColumnLayoutItems))
-	for i := range t.table.columnWidthsInPixels {
-		if i < len(t.row.Cells) && t.row.Cells[i].Content != nil {
-			t.linearLayoutItems = append(t.linearLayoutItems, guigui.LinearLayoutItem{
-				Widget: t.row.Cells[i].Content,
-				Size:   guigui.FixedSize(t.table.columnWidthsInPixels[i]),
-			})
+	bounds := widgetBounds.Bounds()
+	layouter.LayoutWidget(&t.scrollableArea, t.table.scrollableColumnBounds(bounds.Min.X, bounds))
+	for pos, idx := range t.table.visibleColumnIndices {
+		w, isText := t.cellWidget(idx)
+		if w == nil {
+			continue
+		}
+		x0, x1 := t.table.columnRangeX(bounds.Min.X, pos)
+		r := image.Rect(x0, bounds.Min.Y, x1, bounds.Max.Y)
+		if pos == 0 {
+			r.Min.X = min(r.Min.X+t.indentSize(context), r.Max.X)
+		}
+		if isText {
+			p := ListItemTextPadding(context)
+			r.Min.X += p.Start
+			r.Min.Y += p.Top
+			r.Max.X -= p.End
+			r.Max.Y -= p.Bottom
+		}
+		if t.table.isColumnFrozen(pos) {
+			layouter.LayoutWidget(w, r)
 		} else {
-			if i >= t.texts.Len() {
-				break
-			}
-			t.textColumnLayoutItems = append(t.textColumnLayoutItems, guigui.LinearLayoutItem{
-				Widget: t.texts.At(i),
-				Size:   guigui.FlexibleSize(1),
-			})
-			t.textColumnLayouts = append(t.textColumnLayouts, guigui.LinearLayout{
-				Direction: guigui.LayoutDirectionHorizontal,
-				Items:     t.textColumnLayoutItems[len(t.textColumnLayoutItems)-1 : len(t.textColumnLayoutItems)],
-				Padding:   ListItemTextPadding(context),
-			})
-			t.linearLayoutItems = append(t.linearLayoutItems,
-				guigui.LinearLayoutItem{
-					Layout: &t.textColumnLayouts[len(t.textColumnLayouts)-1],
-					Size:   guigui.FixedSize(t.table.columnWidthsInPixels[i]),
-				})
+			t.scrollableArea.layoutWidget(w, r)
+		}
+	}
+	if r := t.expanderBounds(context, bounds); !r.Empty() {
+		if t.table.isColumnFrozen(0) {
+			layouter.LayoutWidget(&t.expander, r)
+		} else {
+			t.scrollableArea.layoutWidget(&t.expander, r)
 		}
 	}
-	(guigui.LinearLayout{
-		Direction: guigui.LayoutDirectionHorizontal,
-		Items:     t.linearLayoutItems,
-	}).LayoutWidgets(context, widgetBounds.Bounds(), layouter)
-
 	// Set text colors based on the list item color type provided by the parent list widget.
 	if v, ok := context.Env(t, EnvKeyListItemColorType); ok {
 		ct := v.(ListItemColorType)
@@ -397,47 +1763,212 @@ func (t *tableRowWidget[T]) Measure(context *guigui.Context, constraints guigui.
 		return image.Pt(0, LineHeight(context))
 	}
 
+	editingIdx := t.table.editingColumnIndexAt(t.index)
 	var w, h int
-	for i, cell := range t.row.Cells {
-		if i >= len(t.table.columnWidthsInPixels) {
-			break
+	for i, width := range t.table.columnWidthsInPixels {
+		w += width
+		if i == 0 {
+			width = max(width-t.indentSize(context), 0)
+		}
+		idx := t.table.visibleColumnIndices[i]
+		if idx == editingIdx {
+			h = max(h, t.table.cellEditor.Measure(context, guigui.FixedWidthConstraints(width)).Y)
+			continue
+		}
+		if idx >= len(t.row.Cells) {
+			continue
 		}
 		var s image.Point
-		if cell.Content != nil {
+		if c := t.row.Cells[idx].Content; c != nil {
 			// TODO: t.columnWidthsInPixels should not be accessed here.
-			s = cell.Content.Measure(context, guigui.FixedWidthConstraints(t.table.columnWidthsInPixels[i]))
+			s = c.Measure(context, guigui.FixedWidthConstraints(width))
 		} else {
 			// Assume that every item can use a bold font.
 			p := ListItemTextPadding(context)
-			w := t.table.columnWidthsInPixels[i] - p.Start - p.End
-			s = t.texts.At(i).Measure(context, guigui.FixedWidthConstraints(w))
+			s = t.texts.At(idx).Measure(context, guigui.FixedWidthConstraints(width-p.Start-p.End))
 			s = s.Add(image.Pt(p.Start+p.End, p.Top+p.Bottom))
 		}
-		w += t.table.columnWidthsInPixels[i]
 		h = max(h, s.Y)
 	}
 	h = max(h, LineHeight(context))
 	return image.Pt(w, h)
 }
 
+func (t *tableRowWidget[T]) HandlePointingInput(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
+	if guigui.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) && widgetBounds.IsHitAtCursor() {
+		if image.Pt(guigui.CursorPosition()).In(t.expanderBounds(context, widgetBounds.Bounds())) {
+			t.table.toggleExpanderByUser(t.index)
+			return guigui.HandleInputByWidget(t)
+		}
+	}
+
+	// Double-clicking an editable cell starts editing instead of activating the row.
+	c, ok := widgetBounds.ClickAtCursor()
+	if !ok || c.Button != ebiten.MouseButtonLeft || c.Count != 2 {
+		return guigui.HandleInputResult{}
+	}
+	if pos := t.table.columnPositionAt(widgetBounds.Bounds().Min.X, c.Position.X); pos >= 0 {
+		if t.table.startEditingCell(t.index, t.table.visibleColumnIndices[pos]) {
+			return guigui.HandleInputByWidget(t)
+		}
+	}
+	return guigui.HandleInputResult{}
+}
+
 func (t *tableRowWidget[T]) selectable() bool {
 	return t.row.selectable()
 }
 
 func (t *tableRowWidget[T]) listItem() ListItem[T] {
 	return ListItem[T]{
+		// Text is not rendered as Content is specified, but is used for type-ahead.
+		Text:         t.typeAheadText(),
 		Content:      t,
+		Header:       t.row.Header,
 		Unselectable: !t.selectable(),
 		Movable:      t.row.Movable,
 		Checked:      t.row.Checked,
 		Value:        t.row.Value,
+		IndentLevel:  t.row.IndentLevel,
+		Collapsed:    t.row.Collapsed,
+	}
+}
+
+// typeAheadText returns the text of the cell in the first visible column.
+func (t *tableRowWidget[T]) typeAheadText() string {
+	if t.table == nil || len(t.table.visibleColumnIndices) == 0 {
+		return ""
+	}
+	idx := t.table.visibleColumnIndices[0]
+	if idx >= len(t.row.Cells) {
+		return ""
+	}
+	return t.row.Cells[idx].Text
+}
+
+// tableScrollableArea is a widget to clip the widgets of the scrollable columns,
+// so that they are not rendered over the frozen columns.
+//
+// The widgets are laid out by the owner via layoutWidget, as a widget can lay out only its children.
+type tableScrollableArea struct {
+	guigui.DefaultWidget
+
+	widgets      []guigui.Widget
+	widgetBounds map[guigui.Widget]image.Rectangle
+}
+
+func (t *tableScrollableArea) reset() {
+	t.widgets = slices.Delete(t.widgets, 0, len(t.widgets))
+	clear(t.widgetBounds)
+}
+
+func (t *tableScrollableArea) addWidget(widget guigui.Widget) {
+	t.widgets = append(t.widgets, widget)
+}
+
+func (t *tableScrollableArea) layoutWidget(widget guigui.Widget, bounds image.Rectangle) {
+	if t.widgetBounds == nil {
+		t.widgetBounds = map[guigui.Widget]image.Rectangle{}
+	}
+	t.widgetBounds[widget] = bounds
+}
+
+func (t *tableScrollableArea) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
+	for _, w := range t.widgets {
+		adder.AddWidget(w)
+	}
+	context.SetClipChildren(t, true)
+	return nil
+}
+
+func (t *tableScrollableArea) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
+	for _, w := range t.widgets {
+		layouter.LayoutWidget(w, t.widgetBounds[w])
+	}
+}
+
+// tableFooter is a widget to show the aggregates of the columns in the footer.
+type tableFooter[T comparable] struct {
+	guigui.DefaultWidget
+
+	texts          guigui.WidgetSlice[*Text]
+	scrollableArea tableScrollableArea
+
+	table *Table[T]
+}
+
+func (t *tableFooter[T]) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
+	t.texts.SetLen(len(t.table.footerCells))
+
+	// The texts of the scrollable columns are clipped not to be rendered over the frozen columns.
+	t.scrollableArea.reset()
+	adder.AddWidget(&t.scrollableArea)
+	for pos, idx := range t.table.visibleColumnIndices {
+		if idx >= len(t.table.footerCells) || t.table.columns[idx].Aggregate == TableAggregateTypeNone {
+			continue
+		}
+		cell := t.table.footerCells[idx]
+		txt := t.texts.At(idx)
+		txt.SetValue(cell.Text)
+		txt.SetHorizontalAlign(cell.TextStyle.HorizontalAlign)
+		txt.SetVerticalAlign(VerticalAlignMiddle)
+		txt.SetTabular(cell.TextStyle.Tabular)
+		txt.SetBold(true)
+		if t.table.isColumnFrozen(pos) {
+			adder.AddWidget(txt)
+		} else {
+			t.scrollableArea.addWidget(txt)
+		}
+	}
+	return nil
+}
+
+func (t *tableFooter[T]) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
+	bounds := widgetBounds.Bounds()
+	offsetX, _ := t.table.list.scrollOffset()
+	x0 := bounds.Min.X + int(offsetX) + RoundedCornerRadius(context)
+	layouter.LayoutWidget(&t.scrollableArea, t.table.scrollableColumnBounds(x0, bounds))
+	p := ListItemTextPadding(context)
+	for pos, idx := range t.table.visibleColumnIndices {
+		if idx >= len(t.table.footerCells) || t.table.columns[idx].Aggregate == TableAggregateTypeNone {
+			continue
+		}
+		x1, x2 := t.table.columnRangeX(x0, pos)
+		r := image.Rect(x1+p.Start, bounds.Min.Y, max(x2-p.End, x1+p.Start), bounds.Max.Y)
+		if t.table.isColumnFrozen(pos) {
+			layouter.LayoutWidget(t.texts.At(idx), r)
+		} else {
+			t.scrollableArea.layoutWidget(t.texts.At(idx), r)
+		}
 	}
 }
 
 type tableHeader[T comparable] struct {
 	guigui.DefaultWidget
 
-	columnTexts guigui.WidgetSlice[*Text]
+	columnTexts         guigui.WidgetSlice[*Text]
+	sortIcons           guigui.WidgetSlice[*Image]
+	sortPriorityTexts   guigui.WidgetSlice[*Text]
+	sortAscendingImage  *ebiten.Image
+	sortDescendingImage *ebiten.Image
+	contextMenu         ContextMenuArea[int]
+	contextMenuItems    []PopupMenuItem[int]
+	scrollableArea      tableScrollableArea
+
+	// resizingColumnIndexPlus1 is the index of the column being resized plus 1.
+	resizingColumnIndexPlus1 int
+	resizingStartX           int
+	resizingStartWidth       int
+	resized                  bool
+
+	// pressedColumnPositionPlus1 is the displayed position of the pressed column plus 1.
+	pressedColumnPositionPlus1 int
+	pressedX                   int
+	pressedWithShift           bool
+	movingColumn               bool
+	dropPosition               int
+
+	onContextMenuItemSelected func(context *guigui.Context, index int)
 
 	table *Table[T]
 }
@@ -446,16 +1977,95 @@ func (t *tableHeader[T]) SetTable(table *Table[T]) {
 	t.table = table
 }
 
+func (t *tableHeader[T]) WriteStateKey(w *guigui.StateKeyWriter) {
+	w.WriteInt(len(t.table.sortColumns))
+	for _, c := range t.table.sortColumns {
+		w.WriteInt(c.ColumnIndex)
+		w.WriteInt(int(c.Order))
+	}
+	w.WriteBool(t.movingColumn)
+	w.WriteInt(t.dropPosition)
+}
+
 func (t *tableHeader[T]) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
 	t.columnTexts.SetLen(len(t.table.columns))
-	for i := range t.columnTexts.Len() {
-		adder.AddWidget(t.columnTexts.At(i))
+	t.sortIcons.SetLen(len(t.table.columns))
+	t.sortPriorityTexts.SetLen(len(t.table.columns))
+
+	var err error
+	t.sortAscendingImage, err = theResourceImages.Get("keyboard_arrow_up", context.ColorMode())
+	if err != nil {
+		return err
+	}
+	t.sortDescendingImage, err = theResourceImages.Get("keyboard_arrow_down", context.ColorMode())
+	if err != nil {
+		return err
 	}
 
-	for i, column := range t.table.columns {
+	// The widgets of the scrollable columns are clipped not to be rendered over the frozen columns.
+	t.scrollableArea.reset()
+	adder.AddWidget(&t.scrollableArea)
+	for pos, i := range t.table.visibleColumnIndices {
+		addWidget := t.scrollableArea.addWidget
+		if t.table.isColumnFrozen(pos) {
+			addWidget = adder.AddWidget
+		}
+
+		column := t.table.columns[i]
+		addWidget(t.columnTexts.At(i))
 		t.columnTexts.At(i).SetValue(column.HeaderText)
 		t.columnTexts.At(i).SetHorizontalAlign(column.HeaderTextHorizontalAlign)
 		t.columnTexts.At(i).SetVerticalAlign(VerticalAlignMiddle)
+
+		order, priority := t.table.sortOrder(i)
+		switch order {
+		case SortOrderAscending:
+			t.sortIcons.At(i).SetImage(t.sortAscendingImage)
+		case SortOrderDescending:
+			t.sortIcons.At(i).SetImage(t.sortDescendingImage)
+		default:
+			continue
+		}
+		addWidget(t.sortIcons.At(i))
+
+		// Show the priorities only for multi-column sorting.
+		if len(t.table.sortColumns) > 1 {
+			addWidget(t.sortPriorityTexts.At(i))
+			t.sortPriorityTexts.At(i).SetValue(strconv.Itoa(priority + 1))
+			t.sortPriorityTexts.At(i).SetVerticalAlign(VerticalAlignMiddle)
+			t.sortPriorityTexts.At(i).SetScale(0.75)
+			t.sortPriorityTexts.At(i).SetOpacity(0.5)
+		}
+	}
+
+	// The context menu to show and hide the columns is available only when any column is hideable.
+	if slices.ContainsFunc(t.table.columns, func(c TableColumn) bool {
+		return c.Hideable
+	}) {
+		adder.AddWidget(&t.contextMenu)
+		t.contextMenuItems = slices.Delete(t.contextMenuItems, 0, len(t.contextMenuItems))
+		for i, column := range t.table.columns {
+			visible := slices.Contains(t.table.visibleColumnIndices, i)
+			t.contextMenuItems = append(t.contextMenuItems, PopupMenuItem[int]{
+				Text:    column.HeaderText,
+				Checked: visible,
+				// The last visible column cannot be hidden.
+				Disabled: !column.Hideable || visible && len(t.table.visibleColumnIndices) <= 1,
+				Value:    i,
+			})
+		}
+		t.contextMenu.PopupMenu().SetItems(t.contextMenuItems)
+		t.contextMenu.PopupMenu().SetReservesCheckmarkSpace(true)
+		if t.onContextMenuItemSelected == nil {
+			t.onContextMenuItemSelected = func(context *guigui.Context, index int) {
+				item, ok := t.contextMenu.PopupMenu().ItemByIndex(index)
+				if !ok {
+					return
+				}
+				t.table.setColumnHiddenByUser(item.Value, item.Checked)
+			}
+		}
+		t.contextMenu.PopupMenu().OnItemSelected(t.onContextMenuItemSelected)
 	}
 
 	return nil
@@ -463,44 +2073,261 @@ func (t *tableHeader[T]) Build(context *guigui.Context, adder *guigui.ChildAdder
 
 func (t *tableHeader[T]) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
 	bounds := widgetBounds.Bounds()
-	offsetX, _ := t.table.list.scrollOffset()
-	pt := bounds.Min
-	pt.X += int(offsetX)
-	pt.X += RoundedCornerRadius(context)
-	for i := range t.columnTexts.Len() {
-		if i >= len(t.table.columnWidthsInPixels) {
-			break
-		}
-		textMin := pt.Add(image.Pt(UnitSize(context)/4, 0))
-		width := t.table.columnWidthsInPixels[i] - UnitSize(context)/2
+	x0 := t.firstColumnX(context, bounds)
+	u := UnitSize(context)
+	h := tableHeaderHeight(context)
+	scrollableBounds := bounds
+	scrollableBounds.Max.Y = bounds.Min.Y + h
+	layouter.LayoutWidget(&t.scrollableArea, t.table.scrollableColumnBounds(x0, scrollableBounds))
+	for pos, columnWidth := range t.table.columnWidthsInPixels {
+		layoutWidget := t.scrollableArea.layoutWidget
+		if t.table.isColumnFrozen(pos) {
+			layoutWidget = layouter.LayoutWidget
+		}
+
+		i := t.table.visibleColumnIndices[pos]
+		x, _ := t.table.columnRangeX(x0, pos)
+		pt := image.Pt(x, bounds.Min.Y)
+		textMin := pt.Add(image.Pt(u/4, 0))
+		width := columnWidth - u/2
+
+		if order, _ := t.table.sortOrder(i); order != SortOrderNone {
+			iconSize := LineHeight(context)
+			iconMin := image.Pt(textMin.X+width-iconSize, pt.Y+(h-iconSize)/2)
+			layoutWidget(t.sortIcons.At(i), image.Rectangle{
+				Min: iconMin,
+				Max: iconMin.Add(image.Pt(iconSize, iconSize)),
+			})
+			width -= iconSize
+			if len(t.table.sortColumns) > 1 {
+				s := t.sortPriorityTexts.At(i).Measure(context, guigui.Constraints{})
+				p := image.Pt(iconMin.X-s.X, pt.Y)
+				layoutWidget(t.sortPriorityTexts.At(i), image.Rectangle{
+					Min: p,
+					Max: p.Add(image.Pt(s.X, h)),
+				})
+				width -= s.X
+			}
+		}
+
 		textBounds := image.Rectangle{
 			Min: textMin,
-			Max: textMin.Add(image.Pt(width, tableHeaderHeight(context))),
+			Max: textMin.Add(image.Pt(max(width, 0), h)),
 		}
-		layouter.LayoutWidget(t.columnTexts.At(i), textBounds)
-		pt.X += t.table.columnWidthsInPixels[i]
+		layoutWidget(t.columnTexts.At(i), textBounds)
 	}
+
+	layouter.LayoutWidget(&t.contextMenu, image.Rectangle{
+		Min: bounds.Min,
+		Max: image.Pt(bounds.Max.X, bounds.Min.Y+h),
+	})
 }
 
-func (t *tableHeader[T]) Draw(context *guigui.Context, widgetBounds *guigui.WidgetBounds, dst *ebiten.Image) {
-	if len(t.table.columnWidthsInPixels) <= 1 {
-		return
+// firstColumnX returns the x position of the left edge of the first column, including the horizontal scroll offset.
+func (t *tableHeader[T]) firstColumnX(context *guigui.Context, bounds image.Rectangle) int {
+	offsetX, _ := t.table.list.scrollOffset()
+	return bounds.Min.X + int(offsetX) + RoundedCornerRadius(context)
+}
+
+// columnX returns the x position of the left edge of the column at the displayed position pos.
+// If pos is the number of the visible columns, columnX returns the x position of the right edge of the last column.
+func (t *tableHeader[T]) columnX(context *guigui.Context, bounds image.Rectangle, pos int) int {
+	x0 := t.firstColumnX(context, bounds)
+	if n := len(t.table.columnWidthsInPixels); pos >= n {
+		if n == 0 {
+			return x0
+		}
+		_, x := t.table.columnRangeX(x0, n-1)
+		return x
+	}
+	x, _ := t.table.columnRangeX(x0, pos)
+	return x
+}
+
+// columnPositionAt returns the displayed position of the column at x, or -1 if there is no column.
+func (t *tableHeader[T]) columnPositionAt(context *guigui.Context, bounds image.Rectangle, x int) int {
+	return t.table.columnPositionAt(t.firstColumnX(context, bounds), x)
+}
+
+// resizableColumnPositionAt returns the displayed position of the resizable column whose right edge is at x,
+// or -1 if there is no such column.
+func (t *tableHeader[T]) resizableColumnPositionAt(context *guigui.Context, bounds image.Rectangle, x int) int {
+	d := UnitSize(context) / 8
+	x0 := t.firstColumnX(context, bounds)
+	for i := range t.table.columnWidthsInPixels {
+		if !t.isColumnEdgeVisible(x0, bounds, i) {
+			continue
+		}
+		_, x1 := t.table.columnRangeX(x0, i)
+		if x1-d <= x && x < x1+d && t.table.columns[t.table.visibleColumnIndices[i]].Resizable {
+			return i
+		}
+	}
+	return -1
+}
+
+// isColumnEdgeVisible reports whether the right edge of the column at the displayed position pos is not hidden by the frozen columns.
+func (t *tableHeader[T]) isColumnEdgeVisible(x0 int, bounds image.Rectangle, pos int) bool {
+	if t.table.isColumnFrozen(pos) {
+		return true
+	}
+	_, x := t.table.columnRangeX(x0, pos)
+	b := t.table.scrollableColumnBounds(x0, bounds)
+	return b.Min.X < x && x <= b.Max.X
+}
+
+// dropPositionAt returns the displayed position to drop the moving column at x.
+func (t *tableHeader[T]) dropPositionAt(context *guigui.Context, bounds image.Rectangle, x int) int {
+	x0 := t.firstColumnX(context, bounds)
+	pos := t.table.columnPositionAt(x0, x)
+	if pos < 0 {
+		if x < x0 {
+			return 0
+		}
+		return len(t.table.columnWidthsInPixels)
+	}
+	if x1, x2 := t.table.columnRangeX(x0, pos); x >= (x1+x2)/2 {
+		return pos + 1
+	}
+	return pos
+}
+
+func (t *tableHeader[T]) isInHeaderRow(context *guigui.Context, bounds image.Rectangle, y int) bool {
+	return bounds.Min.Y <= y && y < bounds.Min.Y+tableHeaderHeight(context)
+}
+
+func (t *tableHeader[T]) HandlePointingInput(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
+	bounds := widgetBounds.Bounds()
+	x, _ := guigui.CursorPosition()
+
+	if t.resizingColumnIndexPlus1 > 0 {
+		idx := t.resizingColumnIndexPlus1 - 1
+		if !guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
+			t.resizingColumnIndexPlus1 = 0
+			if t.resized {
+				t.table.dispatchColumnStatesChanged()
+			}
+			return guigui.HandleInputByWidget(t)
+		}
+		if t.table.setColumnWidthByUser(context, idx, t.resizingStartWidth+x-t.resizingStartX) {
+			t.resized = true
+		}
+		return guigui.HandleInputByWidget(t)
 	}
+
+	if t.pressedColumnPositionPlus1 > 0 {
+		pos := t.pressedColumnPositionPlus1 - 1
+		if pos >= len(t.table.visibleColumnIndices) {
+			// The columns are changed while pressing.
+			t.pressedColumnPositionPlus1 = 0
+			t.movingColumn = false
+			return guigui.HandleInputResult{}
+		}
+		if !guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
+			if t.movingColumn {
+				t.table.moveColumnByUser(pos, t.dropPosition)
+			} else if idx := t.table.visibleColumnIndices[pos]; t.table.columns[idx].Sortable {
+				// Shift+click adds the column to the sort columns.
+				t.table.toggleSortByUser(idx, t.pressedWithShift)
+			}
+			t.pressedColumnPositionPlus1 = 0
+			t.movingColumn = false
+			return guigui.HandleInputByWidget(t)
+		}
+		if !t.movingColumn && t.table.columns[t.table.visibleColumnIndices[pos]].Movable {
+			if d := x - t.pressedX; d < -UnitSize(context)/4 || d > UnitSize(context)/4 {
+				t.movingColumn = true
+			}
+		}
+		if t.movingColumn {
+			t.dropPosition = t.dropPositionAt(context, bounds, x)
+		}
+		return guigui.HandleInputByWidget(t)
+	}
+
+	c, ok := widgetBounds.ClickAtCursor()
+	if !ok || c.Button != ebiten.MouseButtonLeft {
+		return guigui.HandleInputResult{}
+	}
+	if !t.isInHeaderRow(context, bounds, c.Position.Y) {
+		return guigui.HandleInputResult{}
+	}
+	if pos := t.resizableColumnPositionAt(context, bounds, c.Position.X); pos >= 0 {
+		idx := t.table.visibleColumnIndices[pos]
+		if c.Count == 2 {
+			t.table.fitColumnWidthByUser(context, idx)
+			return guigui.HandleInputByWidget(t)
+		}
+		t.resizingColumnIndexPlus1 = idx + 1
+		t.resizingStartX = c.Position.X
+		t.resizingStartWidth = t.table.columnWidthsInPixels[pos]
+		t.resized = false
+		return guigui.HandleInputByWidget(t)
+	}
+	pos := t.columnPositionAt(context, bounds, c.Position.X)
+	if pos < 0 {
+		return guigui.HandleInputResult{}
+	}
+	if column := t.table.columns[t.table.visibleColumnIndices[pos]]; !column.Sortable && !column.Movable {
+		return guigui.HandleInputResult{}
+	}
+	t.pressedColumnPositionPlus1 = pos + 1
+	t.pressedX = c.Position.X
+	t.pressedWithShift = c.Modifiers&guigui.ModifierShift != 0
+	return guigui.HandleInputByWidget(t)
+}
+
+func (t *tableHeader[T]) CursorShape(context *guigui.Context, widgetBounds *guigui.WidgetBounds) (ebiten.CursorShapeType, bool) {
+	if t.resizingColumnIndexPlus1 > 0 {
+		return ebiten.CursorShapeEWResize, true
+	}
+	if !widgetBounds.IsHitAtCursor() {
+		return 0, false
+	}
+	x, y := guigui.CursorPosition()
+	bounds := widgetBounds.Bounds()
+	if !t.isInHeaderRow(context, bounds, y) || t.resizableColumnPositionAt(context, bounds, x) < 0 {
+		return 0, false
+	}
+	return ebiten.CursorShapeEWResize, true
+}
+
+func (t *tableHeader[T]) Draw(context *guigui.Context, widgetBounds *guigui.WidgetBounds, dst *ebiten.Image) {
 	u := UnitSize(context)
 	b := widgetBounds.Bounds()
-	x := b.Min.X + RoundedCornerRadius(context)
-	offsetX, _ := t.table.list.scrollOffset()
-	x += int(offsetX)
-	for _, width := range t.table.columnWidthsInPixels[:len(t.table.columnWidthsInPixels)-1] {
-		x += width
-		x0 := float32(x)
-		x1 := x0
-		y0 := float32(b.Min.Y + u/4)
-		y1 := float32(b.Min.Y + tableHeaderHeight(context) - u/4)
-		clr := draw.Color2(context.ColorMode(), draw.SemanticColorBase, 0.9, 0.4)
-		if !context.IsEnabled(t) {
-			clr = draw.Color2(context.ColorMode(), draw.SemanticColorBase, 0.8, 0.3)
-		}
-		vector.StrokeLine(dst, x0, y0, x1, y1, float32(context.Scale()), clr, false)
+	if len(t.table.columnWidthsInPixels) > 1 {
+		firstX := t.firstColumnX(context, b)
+		_, trailing := t.table.frozenColumnCounts()
+		for pos := range len(t.table.columnWidthsInPixels) - 1 {
+			var x int
+			if pos+1 >= len(t.table.columnWidthsInPixels)-trailing {
+				// Draw the left edge of the trailing frozen column.
+				x, _ = t.table.columnRangeX(firstX, pos+1)
+			} else {
+				// The right edge of a scrollable column might be hidden by the frozen columns.
+				if !t.isColumnEdgeVisible(firstX, b, pos) {
+					continue
+				}
+				_, x = t.table.columnRangeX(firstX, pos)
+			}
+			x0 := float32(x)
+			x1 := x0
+			y0 := float32(b.Min.Y + u/4)
+			y1 := float32(b.Min.Y + tableHeaderHeight(context) - u/4)
+			clr := draw.Color2(context.ColorMode(), draw.SemanticColorBase, 0.9, 0.4)
+			if !context.IsEnabled(t) {
+				clr = draw.Color2(context.ColorMode(), draw.SemanticColorBase, 0.8, 0.3)
+			}
+			vector.StrokeLine(dst, x0, y0, x1, y1, float32(context.Scale()), clr, false)
+		}
+	}
+
+	// Draw the indicator where the moving column is dropped.
+	if t.movingColumn {
+		x := float32(t.columnX(context, b, t.dropPosition))
+		y0 := float32(b.Min.Y)
+		y1 := float32(b.Min.Y + tableHeaderHeight(context))
+		clr := draw.Color(context.ColorMode(), draw.SemanticColorAccent, 0.5)
+		vector.StrokeLine(dst, x, y0, x, y1, float32(2*context.Scale()), clr, false)
 	}
 }
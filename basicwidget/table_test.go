@@ -0,0 +1,618 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package basicwidget_test
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/guigui-gui/guigui/basicwidget"
+)
+
+func TestTableSort(t *testing.T) {
+	rows := []basicwidget.TableRow[int]{
+		{Value: 1, Cells: []basicwidget.TableCell{{Text: "b"}, {Text: "10"}}},
+		{Value: 2, Cells: []basicwidget.TableCell{{Text: "a"}, {Text: "9"}}},
+		{Value: 3, Cells: []basicwidget.TableCell{{Text: "b"}, {Text: "2"}}},
+		{Value: 4, Cells: []basicwidget.TableCell{{Text: "A"}, {Text: "x"}}},
+	}
+	columns := []basicwidget.TableColumn{
+		{Sortable: true},
+		{Sortable: true},
+	}
+	caseInsensitiveColumns := []basicwidget.TableColumn{
+		{
+			Sortable: true,
+			Compare: func(a, b basicwidget.TableCell) int {
+				return strings.Compare(strings.ToLower(a.Text), strings.ToLower(b.Text))
+			},
+		},
+		{Sortable: true},
+	}
+
+	testCases := []struct {
+		name        string
+		columns     []basicwidget.TableColumn
+		sortColumns []basicwidget.TableSortColumn
+		want        []int
+	}{
+		{
+			name:    "none",
+			columns: columns,
+			want:    []int{1, 2, 3, 4},
+		},
+		{
+			name:        "text ascending",
+			columns:     columns,
+			sortColumns: []basicwidget.TableSortColumn{{ColumnIndex: 0, Order: basicwidget.SortOrderAscending}},
+			want:        []int{4, 2, 1, 3},
+		},
+		{
+			name:        "number ascending",
+			columns:     columns,
+			sortColumns: []basicwidget.TableSortColumn{{ColumnIndex: 1, Order: basicwidget.SortOrderAscending}},
+			want:        []int{3, 2, 1, 4},
+		},
+		{
+			name:        "number descending",
+			columns:     columns,
+			sortColumns: []basicwidget.TableSortColumn{{ColumnIndex: 1, Order: basicwidget.SortOrderDescending}},
+			want:        []int{4, 1, 2, 3},
+		},
+		{
+			name:    "multi columns",
+			columns: columns,
+			sortColumns: []basicwidget.TableSortColumn{
+				{ColumnIndex: 0, Order: basicwidget.SortOrderDescending},
+				{ColumnIndex: 1, Order: basicwidget.SortOrderAscending},
+			},
+			want: []int{3, 1, 2, 4},
+		},
+		{
+			name:    "none order is ignored",
+			columns: columns,
+			sortColumns: []basicwidget.TableSortColumn{
+				{ColumnIndex: 0, Order: basicwidget.SortOrderNone},
+				{ColumnIndex: 1, Order: basicwidget.SortOrderAscending},
+			},
+			want: []int{3, 2, 1, 4},
+		},
+		{
+			name:        "compare func",
+			columns:     caseInsensitiveColumns,
+			sortColumns: []basicwidget.TableSortColumn{{ColumnIndex: 0, Order: basicwidget.SortOrderAscending}},
+			want:        []int{2, 4, 1, 3},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := basicwidget.SortedTableRowValues(tc.columns, tc.sortColumns, rows)
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestTableSortMixedColumn(t *testing.T) {
+	rows := []basicwidget.TableRow[int]{
+		{Value: 1, Cells: []basicwidget.TableCell{{Text: "10"}}},
+		{Value: 2, Cells: []basicwidget.TableCell{{Text: "b"}}},
+		{Value: 3, Cells: []basicwidget.TableCell{{Text: "9"}}},
+		{Value: 4, Cells: []basicwidget.TableCell{{Text: "A"}}},
+		{Value: 5, Cells: []basicwidget.TableCell{{Text: "2"}}},
+		{Value: 6, Cells: []basicwidget.TableCell{{Text: "10a"}}},
+	}
+	columns := []basicwidget.TableColumn{
+		{Sortable: true},
+	}
+	sortColumns := []basicwidget.TableSortColumn{{ColumnIndex: 0, Order: basicwidget.SortOrderAscending}}
+	// The numbers are ordered before the other texts regardless of the initial order.
+	want := []int{5, 3, 1, 6, 4, 2}
+	for i := range rows {
+		rotated := slices.Concat(rows[i:], rows[:i])
+		if got := basicwidget.SortedTableRowValues(columns, sortColumns, rotated); !slices.Equal(got, want) {
+			t.Errorf("rotation %d: got %v, want %v", i, got, want)
+		}
+	}
+}
+
+func TestTableColumnStates(t *testing.T) {
+	columns := make([]basicwidget.TableColumn, 3)
+
+	testCases := []struct {
+		name   string
+		states []basicwidget.TableColumnState
+		want   []basicwidget.TableColumnState
+	}{
+		{
+			name: "default",
+			want: []basicwidget.TableColumnState{
+				{ColumnIndex: 0},
+				{ColumnIndex: 1},
+				{ColumnIndex: 2},
+			},
+		},
+		{
+			name: "reordered",
+			states: []basicwidget.TableColumnState{
+				{ColumnIndex: 2, Width: 100},
+				{ColumnIndex: 0, Hidden: true},
+				{ColumnIndex: 1},
+			},
+			want: []basicwidget.TableColumnState{
+				{ColumnIndex: 2, Width: 100},
+				{ColumnIndex: 0, Hidden: true},
+				{ColumnIndex: 1},
+			},
+		},
+		{
+			name: "missing",
+			states: []basicwidget.TableColumnState{
+				{ColumnIndex: 1, Width: 50},
+			},
+			want: []basicwidget.TableColumnState{
+				{ColumnIndex: 1, Width: 50},
+				{ColumnIndex: 0},
+				{ColumnIndex: 2},
+			},
+		},
+		{
+			name: "invalid and duplicated",
+			states: []basicwidget.TableColumnState{
+				{ColumnIndex: 3},
+				{ColumnIndex: -1},
+				{ColumnIndex: 2},
+				{ColumnIndex: 2, Hidden: true},
+			},
+			want: []basicwidget.TableColumnState{
+				{ColumnIndex: 2},
+				{ColumnIndex: 0},
+				{ColumnIndex: 1},
+			},
+		},
+		{
+			name: "all hidden",
+			states: []basicwidget.TableColumnState{
+				{ColumnIndex: 1, Hidden: true},
+				{ColumnIndex: 0, Hidden: true},
+				{ColumnIndex: 2, Hidden: true},
+			},
+			want: []basicwidget.TableColumnState{
+				{ColumnIndex: 1},
+				{ColumnIndex: 0, Hidden: true},
+				{ColumnIndex: 2, Hidden: true},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var table basicwidget.Table[int]
+			table.SetColumns(columns)
+			table.SetColumnStates(tc.states)
+			if got := table.AppendColumnStates(nil); !slices.Equal(got, tc.want) {
+				t.Errorf("got: %v, want: %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestTableNextEditableCell(t *testing.T) {
+	columns := []basicwidget.TableColumn{
+		{Editor: basicwidget.TableCellEditorTypeTextInput},
+		{},
+		{Editor: basicwidget.TableCellEditorTypeCheckbox},
+	}
+	rows := []basicwidget.TableRow[int]{
+		{Value: 1},
+		{Value: 2, Unselectable: true},
+		{Value: 3},
+	}
+
+	testCases := []struct {
+		name     string
+		row      int
+		column   int
+		backward bool
+		wantRow  int
+		wantCol  int
+		wantOK   bool
+	}{
+		{name: "next in row", row: 0, column: 0, wantRow: 0, wantCol: 2, wantOK: true},
+		{name: "wrap to next row", row: 0, column: 2, wantRow: 2, wantCol: 0, wantOK: true},
+		{name: "last", row: 2, column: 2, wantOK: false},
+		{name: "prev in row", row: 2, column: 2, backward: true, wantRow: 2, wantCol: 0, wantOK: true},
+		{name: "wrap to prev row", row: 2, column: 0, backward: true, wantRow: 0, wantCol: 2, wantOK: true},
+		{name: "first", row: 0, column: 0, backward: true, wantOK: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r, c, ok := basicwidget.NextEditableTableCell(columns, rows, tc.row, tc.column, tc.backward)
+			if ok != tc.wantOK {
+				t.Fatalf("ok: got: %v, want: %v", ok, tc.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if r != tc.wantRow || c != tc.wantCol {
+				t.Errorf("got: (%d, %d), want: (%d, %d)", r, c, tc.wantRow, tc.wantCol)
+			}
+		})
+	}
+}
+
+func TestTableFrozenColumns(t *testing.T) {
+	widths := []int{10, 20, 30, 40}
+
+	testCases := []struct {
+		name       string
+		leading    int
+		trailing   int
+		offsetX    float64
+		minOffsetX float64
+		want       [][2]int
+	}{
+		{
+			name:       "not frozen",
+			offsetX:    -15,
+			minOffsetX: -50,
+			want:       [][2]int{{-15, -5}, {-5, 15}, {15, 45}, {45, 85}},
+		},
+		{
+			name:       "leading",
+			leading:    1,
+			offsetX:    -15,
+			minOffsetX: -50,
+			want:       [][2]int{{0, 10}, {-5, 15}, {15, 45}, {45, 85}},
+		},
+		{
+			name:       "trailing",
+			trailing:   1,
+			offsetX:    -15,
+			minOffsetX: -50,
+			want:       [][2]int{{-15, -5}, {-5, 15}, {15, 45}, {10, 50}},
+		},
+		{
+			name:       "trailing at the end",
+			trailing:   1,
+			offsetX:    -50,
+			minOffsetX: -50,
+			want:       [][2]int{{-50, -40}, {-40, -20}, {-20, 10}, {10, 50}},
+		},
+		{
+			name:       "too many",
+			leading:    3,
+			trailing:   3,
+			offsetX:    -15,
+			minOffsetX: -50,
+			want:       [][2]int{{0, 10}, {10, 30}, {30, 60}, {10, 50}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := basicwidget.TableColumnRangesX(widths, tc.leading, tc.trailing, tc.offsetX, tc.minOffsetX)
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("got: %v, want: %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestTableSortTree(t *testing.T) {
+	row := func(value int, text string, indentLevel int) basicwidget.TableRow[int] {
+		return basicwidget.TableRow[int]{
+			Value:       value,
+			Cells:       []basicwidget.TableCell{{Text: text}},
+			IndentLevel: indentLevel,
+		}
+	}
+	rows := []basicwidget.TableRow[int]{
+		row(1, "b", 1),
+		row(2, "y", 2),
+		row(3, "x", 2),
+		row(4, "a", 1),
+		row(5, "z", 2),
+		row(6, "c", 3),
+		row(7, "a", 3),
+		row(8, "c", 1),
+	}
+	columns := []basicwidget.TableColumn{{Sortable: true}}
+
+	testCases := []struct {
+		name  string
+		order basicwidget.SortOrder
+		want  []int
+	}{
+		{
+			name:  "ascending",
+			order: basicwidget.SortOrderAscending,
+			want:  []int{4, 5, 7, 6, 1, 3, 2, 8},
+		},
+		{
+			name:  "descending",
+			order: basicwidget.SortOrderDescending,
+			want:  []int{8, 1, 2, 3, 4, 5, 6, 7},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sortColumns := []basicwidget.TableSortColumn{{ColumnIndex: 0, Order: tc.order}}
+			got := basicwidget.SortedTableRowValues(columns, sortColumns, rows)
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestTableAggregates(t *testing.T) {
+	columns := []basicwidget.TableColumn{
+		{Aggregate: basicwidget.TableAggregateTypeCount},
+		{Aggregate: basicwidget.TableAggregateTypeSum},
+		{Aggregate: basicwidget.TableAggregateTypeMin},
+		{Aggregate: basicwidget.TableAggregateTypeMax},
+		{
+			Aggregate: basicwidget.TableAggregateTypeCustom,
+			AggregateFunc: func(cells []basicwidget.TableCell) string {
+				var texts []string
+				for _, c := range cells {
+					texts = append(texts, c.Text)
+				}
+				return strings.Join(texts, ",")
+			},
+		},
+		{},
+	}
+	row := func(indentLevel int, texts ...string) basicwidget.TableRow[int] {
+		var cells []basicwidget.TableCell
+		for _, text := range texts {
+			cells = append(cells, basicwidget.TableCell{Text: text})
+		}
+		return basicwidget.TableRow[int]{
+			Cells:       cells,
+			IndentLevel: indentLevel,
+		}
+	}
+
+	testCases := []struct {
+		name string
+		rows []basicwidget.TableRow[int]
+		want []string
+	}{
+		{
+			name: "empty",
+			want: []string{"", "", "", "", "", ""},
+		},
+		{
+			name: "flat",
+			rows: []basicwidget.TableRow[int]{
+				row(0, "a", "1.5", "10", "b", "p", "x"),
+				row(0, "b", "0.25", "9", "", "q", "y"),
+				row(0, "c", "-3", "", "a", "r", "z"),
+			},
+			want: []string{"3", "-1.25", "9", "b", "p,q,r", ""},
+		},
+		{
+			name: "non-numbers are ignored in sum",
+			rows: []basicwidget.TableRow[int]{
+				row(0, "a", "0.1"),
+				row(0, "b", "n/a"),
+				row(0, "c", "0.2"),
+			},
+			want: []string{"3", "0.3", "", "", ",,", ""},
+		},
+		{
+			name: "only leaf rows",
+			rows: []basicwidget.TableRow[int]{
+				row(1, "a", "100"),
+				row(2, "b", "1"),
+				row(2, "c", "2"),
+				row(1, "d", "4"),
+			},
+			want: []string{"3", "7", "", "", ",,", ""},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := basicwidget.TableFooterTexts(columns, tc.rows)
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestTableGroups(t *testing.T) {
+	columns := []basicwidget.TableColumn{
+		{Sortable: true},
+		{Aggregate: basicwidget.TableAggregateTypeSum},
+	}
+	row := func(value int, account, amount string, indentLevel int) basicwidget.TableRow[int] {
+		return basicwidget.TableRow[int]{
+			Value:       value,
+			Cells:       []basicwidget.TableCell{{Text: account}, {Text: amount}},
+			IndentLevel: indentLevel,
+		}
+	}
+	rows := []basicwidget.TableRow[int]{
+		row(1, "Cash", "10", 0),
+		row(2, "Bank", "20", 0),
+		row(3, "Cash", "30", 1),
+		row(4, "Bank", "40", 2),
+		row(5, "Bank", "50", 0),
+	}
+
+	// A group row is described as "[key: aggregate]".
+	describe := func(rows []basicwidget.TableRow[int], groups []bool) []string {
+		var strs []string
+		for i, r := range rows {
+			if groups[i] {
+				strs = append(strs, "["+r.Cells[0].Text+": "+r.Cells[1].Text+"]")
+				continue
+			}
+			strs = append(strs, strconv.Itoa(r.Value))
+		}
+		return strs
+	}
+
+	testCases := []struct {
+		name            string
+		sortColumns     []basicwidget.TableSortColumn
+		collapsedGroups []string
+		want            []string
+	}{
+		{
+			name: "groups in the order of the first rows",
+			want: []string{"[Cash: 50]", "1", "3", "4", "[Bank: 70]", "2", "5"},
+		},
+		{
+			name:        "sorted",
+			sortColumns: []basicwidget.TableSortColumn{{ColumnIndex: 0, Order: basicwidget.SortOrderAscending}},
+			want:        []string{"[Bank: 70]", "2", "5", "[Cash: 50]", "1", "3", "4"},
+		},
+		{
+			name:            "collapsed",
+			collapsedGroups: []string{"Cash"},
+			want:            []string{"[Cash: 50]", "[Bank: 70]", "2", "5"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := describe(basicwidget.GroupedTableRows(columns, tc.sortColumns, 0, tc.collapsedGroups, rows))
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestTableExport(t *testing.T) {
+	columns := []basicwidget.TableColumn{
+		{HeaderText: "Name", Sortable: true},
+		{HeaderText: "Memo"},
+		{HeaderText: "Amount"},
+	}
+	rows := []basicwidget.TableRow[int]{
+		{Cells: []basicwidget.TableCell{{Text: "b"}, {Text: "x, y"}, {Text: "1"}}},
+		{Cells: []basicwidget.TableCell{{Text: "a"}, {Text: "<z>\n\"w\""}, {Text: "2"}}},
+		{Cells: []basicwidget.TableCell{{Text: "c"}}},
+	}
+
+	testCases := []struct {
+		name   string
+		format basicwidget.TableExportFormat
+		want   string
+	}{
+		{
+			name:   "csv",
+			format: basicwidget.TableExportFormatCSV,
+			want: `Name,Amount,Memo
+a,2,"<z>
+""w"""
+b,1,"x, y"
+c,,
+`,
+		},
+		{
+			name:   "tsv",
+			format: basicwidget.TableExportFormatTSV,
+			want: "Name\tAmount\tMemo\n" +
+				"a\t2\t\"<z>\n\"\"w\"\"\"\n" +
+				"b\t1\tx, y\n" +
+				"c\t\t\n",
+		},
+		{
+			name:   "html",
+			format: basicwidget.TableExportFormatHTML,
+			want: `<table>
+<thead>
+<tr><th>Name</th><th>Amount</th><th>Memo</th></tr>
+</thead>
+<tbody>
+<tr><td>a</td><td>2</td><td>&lt;z&gt;<br>&#34;w&#34;</td></tr>
+<tr><td>b</td><td>1</td><td>x, y</td></tr>
+<tr><td>c</td><td></td><td></td></tr>
+</tbody>
+</table>
+`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var table basicwidget.Table[int]
+			table.SetColumns(columns)
+			// The rows are exported in the displayed order of the columns and the rows.
+			table.SetColumnStates([]basicwidget.TableColumnState{
+				{ColumnIndex: 0},
+				{ColumnIndex: 2},
+				{ColumnIndex: 1},
+			})
+			table.SetSortColumns([]basicwidget.TableSortColumn{{ColumnIndex: 0, Order: basicwidget.SortOrderAscending}})
+			table.SetItems(rows)
+			var buf strings.Builder
+			if err := table.Export(&buf, tc.format); err != nil {
+				t.Fatal(err)
+			}
+			if got := buf.String(); got != tc.want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestTableCellEditorValue(t *testing.T) {
+	testCases := []struct {
+		name   string
+		column basicwidget.TableColumn
+		text   string
+		out    basicwidget.TableCellValue
+	}{
+		{
+			name:   "text",
+			column: basicwidget.TableColumn{Editor: basicwidget.TableCellEditorTypeTextInput},
+			text:   "foo",
+			out:    basicwidget.TableCellValue{Text: "foo"},
+		},
+		{
+			name:   "integer",
+			column: basicwidget.TableColumn{Editor: basicwidget.TableCellEditorTypeNumberInput},
+			text:   "1,234",
+			out:    basicwidget.TableCellValue{Number: 1234, NumberFloat64: 1234},
+		},
+		{
+			name:   "decimal",
+			column: basicwidget.TableColumn{Editor: basicwidget.TableCellEditorTypeNumberInput, EditorFractionDigits: 2},
+			text:   "12.5",
+			out:    basicwidget.TableCellValue{Number: 12, NumberFloat64: 12.5},
+		},
+		{
+			name:   "decimal rounded",
+			column: basicwidget.TableColumn{Editor: basicwidget.TableCellEditorTypeNumberInput, EditorFractionDigits: 1},
+			text:   "-0.25",
+			out:    basicwidget.TableCellValue{Number: 0, NumberFloat64: -0.3},
+		},
+		{
+			name:   "not a number",
+			column: basicwidget.TableColumn{Editor: basicwidget.TableCellEditorTypeNumberInput, EditorFractionDigits: 2},
+			text:   "foo",
+			out:    basicwidget.TableCellValue{},
+		},
+		{
+			name:   "checkbox",
+			column: basicwidget.TableColumn{Editor: basicwidget.TableCellEditorTypeCheckbox},
+			text:   "true",
+			out:    basicwidget.TableCellValue{Checked: true},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := basicwidget.TableCellEditorValue(tc.column, tc.text); got != tc.out {
+				t.Errorf("got: %+v, want: %+v", got, tc.out)
+			}
+		})
+	}
+}
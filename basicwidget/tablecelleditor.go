@@ -0,0 +1,303 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package basicwidget
+
+import (
+	"image"
+	"strconv"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/guigui-gui/guigui"
+)
+
+// TableCellEditorType is the type of the editor to edit the cells in a [TableColumn].
+type TableCellEditorType int
+
+const (
+	// TableCellEditorTypeNone means the cells are not editable.
+	TableCellEditorTypeNone TableCellEditorType = iota
+
+	// TableCellEditorTypeTextInput edits the cells with a [TextInput].
+	TableCellEditorTypeTextInput
+
+	// TableCellEditorTypeNumberInput edits the cells with a [NumberInput].
+	TableCellEditorTypeNumberInput
+
+	// TableCellEditorTypeSelect edits the cells with a [Select] of [TableColumn.EditorItems].
+	TableCellEditorTypeSelect
+
+	// TableCellEditorTypeCheckbox edits the cells with a [Checkbox].
+	TableCellEditorTypeCheckbox
+)
+
+// TableCellValue is a value committed by a cell editor.
+type TableCellValue struct {
+	// Text is the value of a [TextInput] editor, or the text of the selected item of a [Select] editor.
+	Text string
+
+	// Number is the integer part of the value of a [NumberInput] editor.
+	Number int
+
+	// NumberFloat64 is the value of a [NumberInput] editor including the fraction part.
+	// See [TableColumn.EditorFractionDigits].
+	NumberFloat64 float64
+
+	// Checked is the value of a [Checkbox] editor.
+	Checked bool
+}
+
+// tableCellEditorMove is the cell to edit next after committing a value.
+type tableCellEditorMove int
+
+const (
+	tableCellEditorMoveNone tableCellEditorMove = iota
+	tableCellEditorMoveNext
+	tableCellEditorMovePrev
+	tableCellEditorMoveDown
+)
+
+type tableCellEditor[T comparable] struct {
+	guigui.DefaultWidget
+
+	textInput   TextInput
+	numberInput NumberInput
+	selector    Select[string]
+	checkbox    Checkbox
+
+	editorType    TableCellEditorType
+	selectItems   []SelectItem[string]
+	focusPending  bool
+	settingValues bool
+
+	onTextInputValueChanged   func(context *guigui.Context, text string, committed bool)
+	onNumberInputValueChanged func(context *guigui.Context, value int, committed bool)
+	onSelectItemSelected      func(context *guigui.Context, index int)
+	onCheckboxValueChanged    func(context *guigui.Context, value bool)
+
+	table *Table[T]
+}
+
+// reset sets the editor of the column and the initial value from the text of a cell, and requests to focus the editor.
+func (e *tableCellEditor[T]) reset(column *TableColumn, text string) {
+	e.editorType = column.Editor
+
+	// Setting values might dispatch events. Ignore them.
+	e.settingValues = true
+	defer func() {
+		e.settingValues = false
+	}()
+
+	switch e.editorType {
+	case TableCellEditorTypeTextInput:
+		e.textInput.ForceSetValue(text)
+		e.textInput.SetError(false)
+	case TableCellEditorTypeNumberInput:
+		e.numberInput.SetFractionDigits(column.EditorFractionDigits)
+		// A cell that is not a number starts with 0.
+		if !e.numberInput.forceSetValueString(text) {
+			e.numberInput.ForceSetValue(0)
+		}
+		e.numberInput.SetError(false)
+	case TableCellEditorTypeSelect:
+		e.selectItems = adjustSliceSize(e.selectItems, len(column.EditorItems))
+		for i, item := range column.EditorItems {
+			e.selectItems[i] = SelectItem[string]{
+				Text:  item,
+				Value: item,
+			}
+		}
+		e.selector.SetItems(e.selectItems)
+		e.selector.SelectItemByValue(text)
+	case TableCellEditorTypeCheckbox:
+		b, _ := strconv.ParseBool(text)
+		e.checkbox.SetValue(b)
+	}
+	e.focusPending = true
+	guigui.RequestRebuild(e)
+}
+
+// setError marks the editor as having an invalid value.
+func (e *tableCellEditor[T]) setError(hasError bool) {
+	switch e.editorType {
+	case TableCellEditorTypeTextInput:
+		e.textInput.SetError(hasError)
+	case TableCellEditorTypeNumberInput:
+		e.numberInput.SetError(hasError)
+	}
+}
+
+func (e *tableCellEditor[T]) value() TableCellValue {
+	switch e.editorType {
+	case TableCellEditorTypeTextInput:
+		return TableCellValue{
+			Text: e.textInput.Value(),
+		}
+	case TableCellEditorTypeNumberInput:
+		return TableCellValue{
+			Number:        e.numberInput.Value(),
+			NumberFloat64: e.numberInput.ValueFloat64(),
+		}
+	case TableCellEditorTypeSelect:
+		item, _ := e.selector.SelectedItem()
+		return TableCellValue{
+			Text: item.Value,
+		}
+	case TableCellEditorTypeCheckbox:
+		return TableCellValue{
+			Checked: e.checkbox.Value(),
+		}
+	}
+	return TableCellValue{}
+}
+
+func (e *tableCellEditor[T]) editorWidget() guigui.Widget {
+	switch e.editorType {
+	case TableCellEditorTypeTextInput:
+		return &e.textInput
+	case TableCellEditorTypeNumberInput:
+		return &e.numberInput
+	case TableCellEditorTypeSelect:
+		return &e.selector
+	case TableCellEditorTypeCheckbox:
+		return &e.checkbox
+	}
+	return nil
+}
+
+// commit commits the current value, unless the value changes are caused by the editor itself.
+func (e *tableCellEditor[T]) commit(context *guigui.Context, editorType TableCellEditorType, move tableCellEditorMove) {
+	if e.settingValues || e.editorType != editorType || !e.table.isEditingCell() {
+		return
+	}
+	e.table.commitCellEditing(context, e.value(), move)
+}
+
+func (e *tableCellEditor[T]) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
+	if w := e.editorWidget(); w != nil {
+		adder.AddWidget(w)
+	}
+
+	if e.onTextInputValueChanged == nil {
+		e.onTextInputValueChanged = func(context *guigui.Context, text string, committed bool) {
+			if !committed {
+				return
+			}
+			// The text input commits the value by Enter or by losing the focus.
+			move := tableCellEditorMoveNone
+			if guigui.IsKeyJustPressed(ebiten.KeyEnter) {
+				move = tableCellEditorMoveDown
+			}
+			e.commit(context, TableCellEditorTypeTextInput, move)
+		}
+	}
+	e.textInput.OnValueChanged(e.onTextInputValueChanged)
+
+	if e.onNumberInputValueChanged == nil {
+		e.onNumberInputValueChanged = func(context *guigui.Context, value int, committed bool) {
+			if !committed {
+				return
+			}
+			move := tableCellEditorMoveNone
+			if guigui.IsKeyJustPressed(ebiten.KeyEnter) {
+				move = tableCellEditorMoveDown
+			}
+			e.commit(context, TableCellEditorTypeNumberInput, move)
+		}
+	}
+	e.numberInput.OnValueChanged(e.onNumberInputValueChanged)
+
+	if e.onSelectItemSelected == nil {
+		e.onSelectItemSelected = func(context *guigui.Context, index int) {
+			e.commit(context, TableCellEditorTypeSelect, tableCellEditorMoveNone)
+		}
+	}
+	e.selector.OnItemSelected(e.onSelectItemSelected)
+
+	if e.onCheckboxValueChanged == nil {
+		e.onCheckboxValueChanged = func(context *guigui.Context, value bool) {
+			e.commit(context, TableCellEditorTypeCheckbox, tableCellEditorMoveNone)
+		}
+	}
+	e.checkbox.OnValueChanged(e.onCheckboxValueChanged)
+
+	return nil
+}
+
+func (e *tableCellEditor[T]) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
+	bounds := widgetBounds.Bounds()
+	if e.editorType != TableCellEditorTypeCheckbox {
+		if w := e.editorWidget(); w != nil {
+			layouter.LayoutWidget(w, bounds)
+		}
+		return
+	}
+
+	s := e.checkbox.Measure(context, guigui.Constraints{})
+	p := ListItemTextPadding(context)
+	pt := image.Pt(bounds.Min.X+p.Start, bounds.Min.Y+(bounds.Dy()-s.Y)/2)
+	layouter.LayoutWidget(&e.checkbox, image.Rectangle{
+		Min: pt,
+		Max: pt.Add(s),
+	})
+}
+
+func (e *tableCellEditor[T]) Measure(context *guigui.Context, constraints guigui.Constraints) image.Point {
+	if e.editorType == TableCellEditorTypeCheckbox {
+		p := ListItemTextPadding(context)
+		s := e.checkbox.Measure(context, guigui.Constraints{})
+		return s.Add(image.Pt(p.Start+p.End, p.Top+p.Bottom))
+	}
+	if w := e.editorWidget(); w != nil {
+		return w.Measure(context, constraints)
+	}
+	return image.Point{}
+}
+
+func (e *tableCellEditor[T]) HandleButtonInput(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
+	if !e.table.isEditingCell() {
+		return guigui.HandleInputResult{}
+	}
+	switch {
+	case guigui.IsKeyJustPressed(ebiten.KeyEscape):
+		e.table.endCellEditing(context)
+		return guigui.HandleInputByWidget(e)
+	case guigui.IsKeyJustPressed(ebiten.KeyTab):
+		move := tableCellEditorMoveNext
+		if guigui.IsKeyPressed(ebiten.KeyShift) {
+			move = tableCellEditorMovePrev
+		}
+		e.table.commitCellEditing(context, e.value(), move)
+		return guigui.HandleInputByWidget(e)
+	case guigui.IsKeyJustPressed(ebiten.KeyEnter):
+		// Text inputs commit the values by Enter by themselves.
+		e.table.commitCellEditing(context, e.value(), tableCellEditorMoveDown)
+		return guigui.HandleInputByWidget(e)
+	case e.editorType == TableCellEditorTypeCheckbox && guigui.IsKeyJustPressed(ebiten.KeySpace):
+		e.checkbox.SetValue(!e.checkbox.Value())
+		return guigui.HandleInputByWidget(e)
+	}
+	return guigui.HandleInputResult{}
+}
+
+func (e *tableCellEditor[T]) Tick(context *guigui.Context, widgetBounds *guigui.WidgetBounds) error {
+	// Focus after starting editing, as a widget can be focused only when it is in the widget tree.
+	if !e.focusPending {
+		return nil
+	}
+	e.focusPending = false
+	switch e.editorType {
+	case TableCellEditorTypeTextInput:
+		context.SetFocused(&e.textInput, true)
+		e.textInput.SelectAll()
+	case TableCellEditorTypeNumberInput:
+		context.SetFocused(&e.numberInput, true)
+	case TableCellEditorTypeSelect:
+		context.SetFocused(e, true)
+		e.selector.openPopupMenu()
+	case TableCellEditorTypeCheckbox:
+		context.SetFocused(e, true)
+	}
+	return nil
+}
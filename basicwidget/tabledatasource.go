@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package basicwidget
+
+import (
+	"github.com/guigui-gui/guigui"
+)
+
+// TableDataSource provides the rows of a [Table] lazily.
+//
+// A table with a data source queries only the rows around the viewport,
+// so a data source can have millions of rows without materializing them up front.
+//
+// The table doesn't sort the rows of a data source by itself.
+// Sort the rows in [Table.OnSortChanged] instead.
+//
+// Movable and Checked of the rows are ignored.
+type TableDataSource[T comparable] interface {
+	// ItemCount returns the number of the rows.
+	ItemCount() int
+
+	// ItemAt returns the row at the given index.
+	//
+	// ItemAt returns false if the row is not available yet, e.g. while the row is being loaded asynchronously.
+	// Then a placeholder row is shown instead.
+	// ItemAt is called for the rows around the viewport every tick,
+	// so the placeholder row is replaced with the row once ItemAt returns true.
+	ItemAt(index int) (TableRow[T], bool)
+}
+
+// tableDataSourceRows adapts a [TableDataSource] to a [ListDataSource] of the row widgets.
+// The row widgets are created only for the rows queried by the list, and are reused.
+type tableDataSourceRows[T comparable] struct {
+	dataSource TableDataSource[T]
+	rowWidgets map[int]*tableRowWidget[T]
+	freeRows   []*tableRowWidget[T]
+
+	table *Table[T]
+}
+
+func (t *tableDataSourceRows[T]) setDataSource(dataSource TableDataSource[T], table *Table[T]) {
+	if dataSource == nil {
+		for i := range t.rowWidgets {
+			t.releaseItem(i)
+		}
+	}
+	t.dataSource = dataSource
+	t.table = table
+}
+
+// rowWidget returns the row widget at the given index if the row is queried by the list.
+func (t *tableDataSourceRows[T]) rowWidget(index int) (*tableRowWidget[T], bool) {
+	w, ok := t.rowWidgets[index]
+	return w, ok
+}
+
+// ItemCount implements [ListDataSource.ItemCount].
+func (t *tableDataSourceRows[T]) ItemCount() int {
+	return t.dataSource.ItemCount()
+}
+
+// ItemAt implements [ListDataSource.ItemAt].
+func (t *tableDataSourceRows[T]) ItemAt(index int) (ListItem[T], bool) {
+	row, ok := t.dataSource.ItemAt(index)
+	if !ok {
+		return ListItem[T]{}, false
+	}
+
+	w, ok := t.rowWidgets[index]
+	if !ok {
+		if n := len(t.freeRows); n > 0 {
+			w = t.freeRows[n-1]
+			t.freeRows[n-1] = nil
+			t.freeRows = t.freeRows[:n-1]
+		} else {
+			w = &tableRowWidget[T]{}
+		}
+		if t.rowWidgets == nil {
+			t.rowWidgets = map[int]*tableRowWidget[T]{}
+		}
+		t.rowWidgets[index] = w
+	}
+	w.setTableRow(row)
+	w.index = index
+	w.table = t.table
+	item := w.listItem()
+	item.Movable = false
+	return item, true
+}
+
+// ItemHeightAt implements [ItemHeightProvider.ItemHeightAt].
+func (t *tableDataSourceRows[T]) ItemHeightAt(context *guigui.Context, index int) int {
+	p, ok := t.dataSource.(ItemHeightProvider)
+	if !ok {
+		return -1
+	}
+	return p.ItemHeightAt(context, index)
+}
+
+// IndexByValue implements [ValueIndexProvider.IndexByValue].
+func (t *tableDataSourceRows[T]) IndexByValue(value T) int {
+	if p, ok := t.dataSource.(ValueIndexProvider[T]); ok {
+		return p.IndexByValue(value)
+	}
+	// Search only the rows queried by the list. Querying all the rows might load every page of the data source.
+	idx := -1
+	for i, w := range t.rowWidgets {
+		if w.row.Value != value {
+			continue
+		}
+		if idx < 0 || i < idx {
+			idx = i
+		}
+	}
+	return idx
+}
+
+// releaseItem implements listDataSourceReleaser.
+func (t *tableDataSourceRows[T]) releaseItem(index int) {
+	w, ok := t.rowWidgets[index]
+	if !ok {
+		return
+	}
+	delete(t.rowWidgets, index)
+	t.freeRows = append(t.freeRows, w)
+}
@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package basicwidget
+
+import (
+	"encoding/csv"
+	"fmt"
+	"html"
+	"io"
+	"slices"
+	"strings"
+)
+
+// TableExportFormat is the format to export the rows of a [Table].
+type TableExportFormat int
+
+const (
+	TableExportFormatCSV TableExportFormat = iota
+	TableExportFormatTSV
+
+	// TableExportFormatHTML is a simple HTML table element without styles.
+	TableExportFormatHTML
+)
+
+// CanCopy reports whether there is any selected row to copy.
+func (t *Table[T]) CanCopy() bool {
+	return t.list.CanCopy()
+}
+
+// Copy copies the selected rows to the clipboard as TSV, which spreadsheets can paste.
+// The texts of the cells in the visible columns are copied in the displayed order, without the column headers.
+// Copy reports whether the rows are copied.
+//
+// Ctrl+C, or Cmd+C on macOS, also copies the selected rows while the table is focused.
+func (t *Table[T]) Copy() bool {
+	t.tmpSelectedIndices = t.list.AppendSelectedItemIndices(t.tmpSelectedIndices[:0])
+	if len(t.tmpSelectedIndices) == 0 {
+		return false
+	}
+	records := make([][]string, 0, len(t.tmpSelectedIndices))
+	for _, idx := range t.tmpSelectedIndices {
+		row, ok := t.ItemByIndex(idx)
+		if !ok {
+			continue
+		}
+		records = append(records, t.rowTexts(row))
+	}
+	return copyTSVToClipboard(records)
+}
+
+// Export writes all the rows with the column headers to w in the format.
+// The texts of the cells in the visible columns are written in the displayed order.
+//
+// The rows are written in the current order, i.e. sorted and grouped as displayed.
+// The rows hidden by collapsing are also written, and the group rows are not.
+//
+// With a data source, Export returns an error if any row is not available yet.
+func (t *Table[T]) Export(w io.Writer, format TableExportFormat) error {
+	records := make([][]string, 0, t.ItemCount()+1)
+	header := make([]string, 0, len(t.visibleColumnIndices))
+	for _, idx := range t.visibleColumnIndices {
+		header = append(header, t.columns[idx].HeaderText)
+	}
+	records = append(records, header)
+
+	if t.dataSourceRows.dataSource != nil {
+		for i := range t.dataSourceRows.ItemCount() {
+			row, ok := t.dataSourceRows.dataSource.ItemAt(i)
+			if !ok {
+				return fmt.Errorf("basicwidget: the row %d is not available yet", i)
+			}
+			records = append(records, t.rowTexts(row))
+		}
+	} else {
+		rows := slices.Clone(t.items)
+		if t.isSorted() {
+			sortTableRows(rows, t.compareRows)
+		}
+		if col, ok := t.groupColumnIndex(); ok {
+			t.sortRowIndicesByGroups(rows, col)
+			for _, idx := range t.tmpRowIndices {
+				records = append(records, t.rowTexts(rows[idx]))
+			}
+		} else {
+			for _, row := range rows {
+				records = append(records, t.rowTexts(row))
+			}
+		}
+	}
+
+	switch format {
+	case TableExportFormatCSV, TableExportFormatTSV:
+		cw := csv.NewWriter(w)
+		if format == TableExportFormatTSV {
+			cw.Comma = '\t'
+		}
+		return cw.WriteAll(records)
+	case TableExportFormatHTML:
+		_, err := io.WriteString(w, tableRecordsToHTML(records))
+		return err
+	}
+	return fmt.Errorf("basicwidget: unknown export format: %d", format)
+}
+
+// rowTexts returns the texts of the cells in the visible columns of the row in the displayed order.
+func (t *Table[T]) rowTexts(row TableRow[T]) []string {
+	texts := make([]string, 0, len(t.visibleColumnIndices))
+	for _, idx := range t.visibleColumnIndices {
+		texts = append(texts, tableRowCellText(row, idx))
+	}
+	return texts
+}
+
+// tableRecordsToHTML returns an HTML table element of the records.
+// The first record is the header.
+func tableRecordsToHTML(records [][]string) string {
+	var sb strings.Builder
+	sb.WriteString("<table>\n")
+	for i, record := range records {
+		tag := "td"
+		switch i {
+		case 0:
+			sb.WriteString("<thead>\n")
+			tag = "th"
+		case 1:
+			sb.WriteString("<tbody>\n")
+		}
+		sb.WriteString("<tr>")
+		for _, field := range record {
+			sb.WriteString("<" + tag + ">")
+			sb.WriteString(strings.ReplaceAll(html.EscapeString(field), "\n", "<br>"))
+			sb.WriteString("</" + tag + ">")
+		}
+		sb.WriteString("</tr>\n")
+		if i == 0 {
+			sb.WriteString("</thead>\n")
+		}
+	}
+	if len(records) > 1 {
+		sb.WriteString("</tbody>\n")
+	}
+	sb.WriteString("</table>\n")
+	return sb.String()
+}
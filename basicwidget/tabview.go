@@ -0,0 +1,785 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package basicwidget
+
+import (
+	"fmt"
+	"image"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+
+	"github.com/guigui-gui/guigui"
+	"github.com/guigui-gui/guigui/basicwidget/basicwidgetdraw"
+	"github.com/guigui-gui/guigui/basicwidget/internal/draw"
+)
+
+var (
+	tabViewEventItemSelected guigui.EventKey = guigui.GenerateEventKey()
+	tabViewEventItemClosed   guigui.EventKey = guigui.GenerateEventKey()
+	tabViewEventItemsMoved   guigui.EventKey = guigui.GenerateEventKey()
+)
+
+// TabPosition represents the position of the tab strip in a [TabView].
+type TabPosition int
+
+const (
+	TabPositionTop TabPosition = iota
+	TabPositionBottom
+	TabPositionStart
+	TabPositionEnd
+)
+
+func (t TabPosition) isVertical() bool {
+	return t == TabPositionStart || t == TabPositionEnd
+}
+
+// TabViewItem is a single tab in a [TabView].
+type TabViewItem[T comparable] struct {
+	Text string
+	Icon *ebiten.Image
+
+	// Content is the page shown when the tab is selected.
+	// Only the content of the selected tab is added to the widget tree.
+	Content guigui.Widget
+
+	// Closable reports whether the tab has a close button.
+	// See [TabView.OnItemClosed].
+	Closable bool
+
+	// Movable reports whether the tab can be reordered by dragging.
+	// See [TabView.OnItemsMoved].
+	Movable bool
+
+	Disabled bool
+	Value    T
+}
+
+// TabView is a container that shows one of its pages at a time, with a strip of tabs to switch the pages.
+//
+// If the tabs don't fit in the strip, the tabs around the selected tab are shown,
+// and all the tabs are listed in a popup menu opened by a button at the end of the strip.
+//
+// When TabView or its descendant is focused, Ctrl+Tab and Ctrl+Page Down select the next tab,
+// and Ctrl+Shift+Tab and Ctrl+Page Up select the previous tab.
+type TabView[T comparable] struct {
+	guigui.DefaultWidget
+
+	items          []TabViewItem[T]
+	tabs           guigui.WidgetSlice[*tabViewTab[T]]
+	overflowButton Button
+	overflowMenu   PopupMenu[int]
+	menuItems      []PopupMenuItem[int]
+
+	position      TabPosition
+	selectedIndex int
+
+	firstVisibleIndex int
+	overflowing       bool
+	tabBounds         []image.Rectangle
+
+	pressedIndexPlus1 int
+	pressStart        image.Point
+	dragging          bool
+	dragDstIndexPlus1 int
+
+	onOverflowButtonDown       func(context *guigui.Context)
+	onOverflowMenuItemSelected func(context *guigui.Context, index int)
+}
+
+// OnItemSelected sets the event handler that is called when the user selects a tab.
+func (t *TabView[T]) OnItemSelected(f func(context *guigui.Context, index int)) {
+	guigui.SetEventHandler(t, tabViewEventItemSelected, f)
+}
+
+// OnItemClosed sets the event handler that is called when the user presses the close button of a tab.
+// TabView doesn't remove the item by itself. The handler is expected to remove the item and call [TabView.SetItems].
+func (t *TabView[T]) OnItemClosed(f func(context *guigui.Context, index int)) {
+	guigui.SetEventHandler(t, tabViewEventItemClosed, f)
+}
+
+// OnItemsMoved sets the event handler that is called when the user moves a tab by dragging.
+// The arguments are the same as [List.OnItemsMoved], and [MoveItemsInSlice] can be used to move the items.
+// TabView doesn't move the item by itself. The handler is expected to move the item and call [TabView.SetItems].
+func (t *TabView[T]) OnItemsMoved(f func(context *guigui.Context, from, count, to int)) {
+	guigui.SetEventHandler(t, tabViewEventItemsMoved, f)
+}
+
+// SetItems sets the tabs.
+func (t *TabView[T]) SetItems(items []TabViewItem[T]) {
+	t.items = adjustSliceSize(t.items, len(items))
+	copy(t.items, items)
+	t.tabs.SetLen(len(items))
+}
+
+// ItemByIndex returns the item at the index.
+func (t *TabView[T]) ItemByIndex(index int) (TabViewItem[T], bool) {
+	if index < 0 || index >= len(t.items) {
+		return TabViewItem[T]{}, false
+	}
+	return t.items[index], true
+}
+
+// SelectedItem returns the selected item.
+func (t *TabView[T]) SelectedItem() (TabViewItem[T], bool) {
+	return t.ItemByIndex(t.SelectedItemIndex())
+}
+
+// SelectedItemIndex returns the index of the selected tab, or -1 if there are no tabs.
+func (t *TabView[T]) SelectedItemIndex() int {
+	if len(t.items) == 0 {
+		return -1
+	}
+	return min(max(t.selectedIndex, 0), len(t.items)-1)
+}
+
+// SelectItemByIndex selects the tab at the index.
+func (t *TabView[T]) SelectItemByIndex(index int) {
+	if index < 0 || index >= len(t.items) {
+		return
+	}
+	t.selectedIndex = index
+}
+
+// SelectItemByValue selects the first tab with the value.
+func (t *TabView[T]) SelectItemByValue(value T) {
+	for i, item := range t.items {
+		if item.Value == value {
+			t.selectedIndex = i
+			return
+		}
+	}
+}
+
+// TabPosition returns the position of the tab strip.
+func (t *TabView[T]) TabPosition() TabPosition {
+	return t.position
+}
+
+// SetTabPosition sets the position of the tab strip.
+// The default value is [TabPositionTop].
+func (t *TabView[T]) SetTabPosition(position TabPosition) {
+	t.position = position
+}
+
+func (t *TabView[T]) WriteStateKey(w *guigui.StateKeyWriter) {
+	w.WriteInt(t.SelectedItemIndex())
+	w.WriteInt(int(t.position))
+	w.WriteInt(len(t.items))
+	for _, item := range t.items {
+		w.WriteString(item.Text)
+		w.WriteBool(item.Closable)
+		w.WriteBool(item.Movable)
+		w.WriteBool(item.Disabled)
+		if item.Content != nil {
+			w.WriteWidget(item.Content)
+		}
+	}
+	w.WriteInt(t.dragDstIndexPlus1)
+}
+
+func (t *TabView[T]) selectItemByUser(index int) {
+	if index < 0 || index >= len(t.items) || t.items[index].Disabled {
+		return
+	}
+	if t.SelectedItemIndex() == index {
+		return
+	}
+	t.selectedIndex = index
+	guigui.DispatchEvent(t, tabViewEventItemSelected, index)
+}
+
+func (t *TabView[T]) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
+	selected := t.SelectedItemIndex()
+
+	for i := range t.tabs.Len() {
+		tab := t.tabs.At(i)
+		item := t.items[i]
+		tab.tabView = t
+		tab.index = i
+		tab.setText(item.Text)
+		tab.icon.SetImage(item.Icon)
+		tab.closable = item.Closable
+		tab.selected = i == selected
+		tab.dropIndicator = tabViewDropIndicatorNone
+		if dst := t.dragDstIndexPlus1 - 1; dst >= 0 {
+			switch {
+			case dst == i && t.isTabVisible(i):
+				tab.dropIndicator = tabViewDropIndicatorStart
+			case dst == i+1 && !t.isTabVisible(dst):
+				tab.dropIndicator = tabViewDropIndicatorEnd
+			}
+		}
+		adder.AddWidget(tab)
+		context.SetEnabled(tab, !item.Disabled)
+	}
+
+	// Add only the content of the selected tab.
+	if selected >= 0 {
+		if content := t.items[selected].Content; content != nil {
+			adder.AddWidget(content)
+		}
+	}
+
+	adder.AddWidget(&t.overflowButton)
+	img, err := theResourceImages.Get("keyboard_arrow_down", context.ColorMode())
+	if err != nil {
+		return err
+	}
+	t.overflowButton.SetIcon(img)
+	if t.onOverflowButtonDown == nil {
+		t.onOverflowButtonDown = func(context *guigui.Context) {
+			t.overflowMenu.SetOpen(!t.overflowMenu.IsOpen())
+		}
+	}
+	t.overflowButton.OnDown(t.onOverflowButtonDown)
+
+	t.menuItems = adjustSliceSize(t.menuItems, len(t.items))
+	for i, item := range t.items {
+		t.menuItems[i] = PopupMenuItem[int]{
+			Text:     item.Text,
+			Disabled: item.Disabled,
+			Checked:  i == selected,
+			Value:    i,
+		}
+	}
+	t.overflowMenu.SetItems(t.menuItems)
+	t.overflowMenu.SetReservesCheckmarkSpace(true)
+	if t.onOverflowMenuItemSelected == nil {
+		t.onOverflowMenuItemSelected = func(context *guigui.Context, index int) {
+			t.selectItemByUser(index)
+		}
+	}
+	t.overflowMenu.OnItemSelected(t.onOverflowMenuItemSelected)
+	if t.overflowMenu.IsOpen() {
+		adder.AddWidget(&t.overflowMenu)
+	}
+
+	return nil
+}
+
+// isTabVisible reports whether the tab at the index was laid out in the strip.
+func (t *TabView[T]) isTabVisible(index int) bool {
+	return index >= 0 && index < len(t.tabBounds) && !t.tabBounds[index].Empty()
+}
+
+func (t *TabView[T]) stripThickness(context *guigui.Context) int {
+	if !t.position.isVertical() {
+		return UnitSize(context) * 5 / 4
+	}
+	var w int
+	for i := range t.tabs.Len() {
+		w = max(w, t.tabs.At(i).Measure(context, guigui.Constraints{}).X)
+	}
+	return min(w, 8*UnitSize(context))
+}
+
+// tabLength returns the length of the tab along the strip.
+func (t *TabView[T]) tabLength(context *guigui.Context, index int) int {
+	if t.position.isVertical() {
+		return UnitSize(context) * 5 / 4
+	}
+	return t.tabs.At(index).Measure(context, guigui.Constraints{}).X
+}
+
+func (t *TabView[T]) stripAndContentBounds(context *guigui.Context, bounds image.Rectangle) (strip, content image.Rectangle) {
+	s := t.stripThickness(context)
+	strip, content = bounds, bounds
+	switch t.position {
+	case TabPositionTop:
+		strip.Max.Y = min(bounds.Min.Y+s, bounds.Max.Y)
+		content.Min.Y = strip.Max.Y
+	case TabPositionBottom:
+		strip.Min.Y = max(bounds.Max.Y-s, bounds.Min.Y)
+		content.Max.Y = strip.Min.Y
+	case TabPositionStart:
+		strip.Max.X = min(bounds.Min.X+s, bounds.Max.X)
+		content.Min.X = strip.Max.X
+	case TabPositionEnd:
+		strip.Min.X = max(bounds.Max.X-s, bounds.Min.X)
+		content.Max.X = strip.Min.X
+	}
+	return strip, content
+}
+
+// updateVisibleTabs updates the range of the tabs shown in the strip so that the selected tab is visible,
+// and returns the end of the range.
+func (t *TabView[T]) updateVisibleTabs(context *guigui.Context, length int) int {
+	n := t.tabs.Len()
+	var total int
+	for i := range n {
+		total += t.tabLength(context, i)
+	}
+	t.overflowing = total > length
+	if !t.overflowing {
+		t.firstVisibleIndex = 0
+		return n
+	}
+
+	length -= UnitSize(context)
+	selected := max(t.SelectedItemIndex(), 0)
+	start := min(max(t.firstVisibleIndex, 0), n-1)
+	if selected < start {
+		start = selected
+	}
+	end := start
+	for l := 0; end < n; end++ {
+		l += t.tabLength(context, end)
+		if l > length && end > start {
+			break
+		}
+	}
+	if selected >= end {
+		// Show the tabs ending at the selected tab.
+		end = selected + 1
+		start = selected
+		for l := t.tabLength(context, start); start > 0; start-- {
+			l += t.tabLength(context, start-1)
+			if l > length {
+				break
+			}
+		}
+	}
+	t.firstVisibleIndex = start
+	return end
+}
+
+func (t *TabView[T]) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
+	u := UnitSize(context)
+	strip, content := t.stripAndContentBounds(context, widgetBounds.Bounds())
+	vertical := t.position.isVertical()
+
+	length := strip.Dx()
+	if vertical {
+		length = strip.Dy()
+	}
+	end := t.updateVisibleTabs(context, length)
+
+	t.tabBounds = adjustSliceSize(t.tabBounds, t.tabs.Len())
+	pos := strip.Min
+	for i := range t.tabs.Len() {
+		if i < t.firstVisibleIndex || i >= end {
+			t.tabBounds[i] = image.Rectangle{}
+			continue
+		}
+		l := t.tabLength(context, i)
+		var b image.Rectangle
+		if vertical {
+			b = image.Rect(strip.Min.X, pos.Y, strip.Max.X, pos.Y+l)
+			pos.Y += l
+		} else {
+			b = image.Rect(pos.X, strip.Min.Y, pos.X+l, strip.Max.Y)
+			pos.X += l
+		}
+		t.tabBounds[i] = b
+		layouter.LayoutWidget(t.tabs.At(i), b)
+	}
+
+	if t.overflowing {
+		var b image.Rectangle
+		if vertical {
+			b = image.Rect(strip.Min.X, strip.Max.Y-u, strip.Max.X, strip.Max.Y)
+		} else {
+			b = image.Rect(strip.Max.X-u, strip.Min.Y, strip.Max.X, strip.Max.Y)
+		}
+		layouter.LayoutWidget(&t.overflowButton, b)
+
+		t.overflowMenu.setCloseByClickingOutsideExcludedRect(b)
+		s := t.overflowMenu.Measure(context, guigui.Constraints{})
+		var p image.Point
+		switch t.position {
+		case TabPositionTop:
+			p = image.Pt(b.Max.X-s.X, b.Max.Y)
+		case TabPositionBottom:
+			p = image.Pt(b.Max.X-s.X, b.Min.Y-s.Y)
+		case TabPositionStart:
+			p = image.Pt(b.Max.X, b.Max.Y-s.Y)
+		case TabPositionEnd:
+			p = image.Pt(b.Min.X-s.X, b.Max.Y-s.Y)
+		}
+		layouter.LayoutWidget(&t.overflowMenu, image.Rectangle{Min: p, Max: p.Add(s)})
+	}
+
+	if selected := t.SelectedItemIndex(); selected >= 0 {
+		if c := t.items[selected].Content; c != nil {
+			layouter.LayoutWidget(c, content)
+		}
+	}
+}
+
+func (t *TabView[T]) Measure(context *guigui.Context, constraints guigui.Constraints) image.Point {
+	var s image.Point
+	if selected := t.SelectedItemIndex(); selected >= 0 {
+		if c := t.items[selected].Content; c != nil {
+			s = c.Measure(context, guigui.Constraints{})
+		}
+	}
+	thickness := t.stripThickness(context)
+	if t.position.isVertical() {
+		s.X += thickness
+		s.Y = max(s.Y, UnitSize(context)*5/4)
+	} else {
+		var w int
+		for i := range t.tabs.Len() {
+			w += t.tabLength(context, i)
+		}
+		s.X = max(s.X, w)
+		s.Y += thickness
+	}
+	if w, ok := constraints.FixedWidth(); ok {
+		s.X = w
+	}
+	if h, ok := constraints.FixedHeight(); ok {
+		s.Y = h
+	}
+	return s
+}
+
+func (t *TabView[T]) pressTab(context *guigui.Context, index int) {
+	context.SetFocused(t, true)
+	t.selectItemByUser(index)
+	t.pressedIndexPlus1 = index + 1
+	x, y := guigui.CursorPosition()
+	t.pressStart = image.Pt(x, y)
+	t.dragging = false
+	t.dragDstIndexPlus1 = 0
+}
+
+func (t *TabView[T]) closeTab(context *guigui.Context, index int) {
+	guigui.DispatchEvent(t, tabViewEventItemClosed, index)
+}
+
+// calcDropDstIndex returns the index to insert the dragged tab at the cursor.
+func (t *TabView[T]) calcDropDstIndex() int {
+	x, y := guigui.CursorPosition()
+	c := x
+	if t.position.isVertical() {
+		c = y
+	}
+	var last int
+	for i, b := range t.tabBounds {
+		if b.Empty() {
+			continue
+		}
+		mid := (b.Min.X + b.Max.X) / 2
+		if t.position.isVertical() {
+			mid = (b.Min.Y + b.Max.Y) / 2
+		}
+		if c < mid {
+			return i
+		}
+		last = i + 1
+	}
+	return last
+}
+
+func (t *TabView[T]) HandlePointingInput(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
+	from := t.pressedIndexPlus1 - 1
+	if from < 0 || from >= len(t.items) {
+		t.pressedIndexPlus1 = 0
+		t.dragging = false
+		t.dragDstIndexPlus1 = 0
+		return guigui.HandleInputResult{}
+	}
+
+	if guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
+		if !t.items[from].Movable || !context.IsEnabled(t) {
+			return guigui.HandleInputResult{}
+		}
+		if !t.dragging {
+			x, y := guigui.CursorPosition()
+			d := x - t.pressStart.X
+			if t.position.isVertical() {
+				d = y - t.pressStart.Y
+			}
+			if abs(d) < UnitSize(context)/4 {
+				return guigui.HandleInputResult{}
+			}
+			t.dragging = true
+		}
+		if i := t.calcDropDstIndex(); t.dragDstIndexPlus1-1 != i {
+			t.dragDstIndexPlus1 = i + 1
+		}
+		return guigui.HandleInputByWidget(t)
+	}
+
+	to := t.dragDstIndexPlus1 - 1
+	t.pressedIndexPlus1 = 0
+	t.dragging = false
+	t.dragDstIndexPlus1 = 0
+	if to < 0 || (from <= to && to <= from+1) {
+		return guigui.HandleInputResult{}
+	}
+	guigui.DispatchEvent(t, tabViewEventItemsMoved, from, 1, to)
+	return guigui.HandleInputByWidget(t)
+}
+
+// HandleButtonInput implements [guigui.Widget.HandleButtonInput].
+func (t *TabView[T]) HandleButtonInput(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
+	if !context.IsEnabled(t) || !context.IsFocusedOrHasFocusedChild(t) || !guigui.IsKeyPressed(ebiten.KeyControl) {
+		return guigui.HandleInputResult{}
+	}
+	var dir int
+	switch {
+	case guigui.IsKeyJustPressed(ebiten.KeyTab) && guigui.IsKeyPressed(ebiten.KeyShift), guigui.IsKeyJustPressed(ebiten.KeyPageUp):
+		dir = -1
+	case guigui.IsKeyJustPressed(ebiten.KeyTab), guigui.IsKeyJustPressed(ebiten.KeyPageDown):
+		dir = 1
+	default:
+		return guigui.HandleInputResult{}
+	}
+	n := len(t.items)
+	if n == 0 {
+		return guigui.HandleInputResult{}
+	}
+	// Skip disabled tabs, and wrap around.
+	for i, idx := 0, t.SelectedItemIndex(); i < n-1; i++ {
+		idx = (idx + dir + n) % n
+		if !t.items[idx].Disabled {
+			t.selectItemByUser(idx)
+			break
+		}
+	}
+	return guigui.HandleInputByWidget(t)
+}
+
+func (t *TabView[T]) Draw(context *guigui.Context, widgetBounds *guigui.WidgetBounds, dst *ebiten.Image) {
+	strip, _ := t.stripAndContentBounds(context, widgetBounds.Bounds())
+	strokeWidth := float32(1 * context.Scale())
+	clr := draw.Color(context.ColorMode(), draw.SemanticColorBase, 0.8)
+	var x0, y0, x1, y1 float32
+	switch t.position {
+	case TabPositionTop:
+		x0, y0, x1, y1 = float32(strip.Min.X), float32(strip.Max.Y)-strokeWidth/2, float32(strip.Max.X), float32(strip.Max.Y)-strokeWidth/2
+	case TabPositionBottom:
+		x0, y0, x1, y1 = float32(strip.Min.X), float32(strip.Min.Y)+strokeWidth/2, float32(strip.Max.X), float32(strip.Min.Y)+strokeWidth/2
+	case TabPositionStart:
+		x0, y0, x1, y1 = float32(strip.Max.X)-strokeWidth/2, float32(strip.Min.Y), float32(strip.Max.X)-strokeWidth/2, float32(strip.Max.Y)
+	case TabPositionEnd:
+		x0, y0, x1, y1 = float32(strip.Min.X)+strokeWidth/2, float32(strip.Min.Y), float32(strip.Min.X)+strokeWidth/2, float32(strip.Max.Y)
+	}
+	vector.StrokeLine(dst, x0, y0, x1, y1, strokeWidth, clr, false)
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+type tabViewDropIndicator int
+
+const (
+	tabViewDropIndicatorNone tabViewDropIndicator = iota
+	tabViewDropIndicatorStart
+	tabViewDropIndicatorEnd
+)
+
+// tabViewTab is a tab in the strip of a [TabView].
+type tabViewTab[T comparable] struct {
+	guigui.DefaultWidget
+
+	tabView *TabView[T]
+	index   int
+	text    Text
+	icon    Image
+
+	closable      bool
+	selected      bool
+	dropIndicator tabViewDropIndicator
+
+	prevHovered      bool
+	prevCloseHovered bool
+}
+
+func (t *tabViewTab[T]) setText(value string) {
+	t.text.SetValue(value)
+}
+
+func (t *tabViewTab[T]) WriteStateKey(w *guigui.StateKeyWriter) {
+	w.WriteInt(t.index)
+	w.WriteBool(t.closable)
+	w.WriteBool(t.selected)
+	w.WriteInt(int(t.dropIndicator))
+}
+
+func (t *tabViewTab[T]) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
+	if t.icon.HasImage() {
+		adder.AddWidget(&t.icon)
+	}
+	adder.AddWidget(&t.text)
+	t.text.SetVerticalAlign(VerticalAlignMiddle)
+	t.text.SetEllipsisString("…")
+	t.text.SetBold(t.selected)
+	if t.selected && context.IsEnabled(t) {
+		t.text.SetColor(draw.Color(context.ColorMode(), draw.SemanticColorAccent, 0.5))
+	} else {
+		t.text.SetColor(nil)
+	}
+	return nil
+}
+
+func (t *tabViewTab[T]) padding(context *guigui.Context) int {
+	return UnitSize(context) / 2
+}
+
+func (t *tabViewTab[T]) closeButtonSize(context *guigui.Context) int {
+	return UnitSize(context) * 3 / 4
+}
+
+func (t *tabViewTab[T]) closeButtonBounds(context *guigui.Context, bounds image.Rectangle) image.Rectangle {
+	if !t.closable {
+		return image.Rectangle{}
+	}
+	s := t.closeButtonSize(context)
+	x := bounds.Max.X - t.padding(context)/2 - s
+	y := bounds.Min.Y + (bounds.Dy()-s)/2
+	return image.Rect(x, y, x+s, y+s)
+}
+
+func (t *tabViewTab[T]) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
+	b := widgetBounds.Bounds()
+	p := t.padding(context)
+	x := b.Min.X + p
+	if t.icon.HasImage() {
+		s := defaultIconSize(context)
+		y := b.Min.Y + (b.Dy()-s)/2
+		layouter.LayoutWidget(&t.icon, image.Rect(x, y, x+s, y+s))
+		x += s + UnitSize(context)/4
+	}
+	maxX := b.Max.X - p
+	if t.closable {
+		maxX = t.closeButtonBounds(context, b).Min.X - UnitSize(context)/8
+	}
+	layouter.LayoutWidget(&t.text, image.Rect(x, b.Min.Y, max(x, maxX), b.Max.Y))
+}
+
+func (t *tabViewTab[T]) Measure(context *guigui.Context, constraints guigui.Constraints) image.Point {
+	u := UnitSize(context)
+	p := t.padding(context)
+	w := 2*p + t.text.Measure(context, guigui.Constraints{}).X
+	if t.icon.HasImage() {
+		w += defaultIconSize(context) + u/4
+	}
+	if t.closable {
+		w += t.closeButtonSize(context) + u/8 - p/2
+	}
+	return image.Pt(w, u*5/4)
+}
+
+func (t *tabViewTab[T]) HandlePointingInput(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
+	if !context.IsEnabled(t) || !widgetBounds.IsHitAtCursor() || !guigui.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
+		return guigui.HandleInputResult{}
+	}
+	x, y := guigui.CursorPosition()
+	if image.Pt(x, y).In(t.closeButtonBounds(context, widgetBounds.Bounds())) {
+		t.tabView.closeTab(context, t.index)
+		return guigui.HandleInputByWidget(t)
+	}
+	t.tabView.pressTab(context, t.index)
+	return guigui.HandleInputByWidget(t)
+}
+
+func (t *tabViewTab[T]) Tick(context *guigui.Context, widgetBounds *guigui.WidgetBounds) error {
+	hovered := t.isHovered(context, widgetBounds)
+	closeHovered := t.isCloseButtonHovered(context, widgetBounds)
+	if hovered != t.prevHovered || closeHovered != t.prevCloseHovered {
+		t.prevHovered = hovered
+		t.prevCloseHovered = closeHovered
+		guigui.RequestRedraw(t)
+	}
+	return nil
+}
+
+func (t *tabViewTab[T]) CursorShape(context *guigui.Context, widgetBounds *guigui.WidgetBounds) (ebiten.CursorShapeType, bool) {
+	if t.isHovered(context, widgetBounds) {
+		return ebiten.CursorShapePointer, true
+	}
+	return 0, true
+}
+
+func (t *tabViewTab[T]) isHovered(context *guigui.Context, widgetBounds *guigui.WidgetBounds) bool {
+	return context.IsEnabled(t) && widgetBounds.IsHitAtCursor() && !guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft)
+}
+
+func (t *tabViewTab[T]) isCloseButtonHovered(context *guigui.Context, widgetBounds *guigui.WidgetBounds) bool {
+	if !t.isHovered(context, widgetBounds) {
+		return false
+	}
+	x, y := guigui.CursorPosition()
+	return image.Pt(x, y).In(t.closeButtonBounds(context, widgetBounds.Bounds()))
+}
+
+func (t *tabViewTab[T]) Draw(context *guigui.Context, widgetBounds *guigui.WidgetBounds, dst *ebiten.Image) {
+	cm := context.ColorMode()
+	b := widgetBounds.Bounds()
+	u := UnitSize(context)
+	r := RoundedCornerRadius(context)
+
+	if t.isHovered(context, widgetBounds) && !t.selected {
+		hb := b.Inset(u / 8)
+		basicwidgetdraw.DrawRoundedRect(context, dst, hb, draw.Color2(cm, draw.SemanticColorBase, 0.9, 0.3), r)
+	}
+
+	// Draw the indicator of the selected tab at the side facing the content.
+	if t.selected {
+		clr := draw.Color(cm, draw.SemanticColorAccent, 0.5)
+		if !context.IsEnabled(t) {
+			clr = basicwidgetdraw.ControlColor(cm, false)
+		}
+		w := u / 8
+		ib := b
+		switch t.tabView.position {
+		case TabPositionTop:
+			ib.Min.Y = b.Max.Y - w
+		case TabPositionBottom:
+			ib.Max.Y = b.Min.Y + w
+		case TabPositionStart:
+			ib.Min.X = b.Max.X - w
+		case TabPositionEnd:
+			ib.Max.X = b.Min.X + w
+		}
+		vector.FillRect(dst, float32(ib.Min.X), float32(ib.Min.Y), float32(ib.Dx()), float32(ib.Dy()), clr, false)
+	}
+
+	if t.closable {
+		cb := t.closeButtonBounds(context, b)
+		if t.isCloseButtonHovered(context, widgetBounds) {
+			basicwidgetdraw.DrawRoundedRect(context, dst, cb, draw.Color2(cm, draw.SemanticColorBase, 0.8, 0.4), cb.Dx()/2)
+		}
+		img, err := theResourceImages.Get("close", cm)
+		if err != nil {
+			panic(fmt.Sprintf("basicwidget: failed to get close image: %v", err))
+		}
+		s := float64(cb.Dx()) * 2 / 3
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Scale(s/float64(img.Bounds().Dx()), s/float64(img.Bounds().Dy()))
+		op.GeoM.Translate(float64(cb.Min.X)+(float64(cb.Dx())-s)/2, float64(cb.Min.Y)+(float64(cb.Dy())-s)/2)
+		if !context.IsEnabled(t) {
+			op.ColorScale.ScaleAlpha(0.25)
+		}
+		op.Filter = ebiten.FilterLinear
+		dst.DrawImage(img, op)
+	}
+
+	// Draw a dragging guideline.
+	if t.dropIndicator != tabViewDropIndicatorNone {
+		clr := draw.Color(cm, draw.SemanticColorAccent, 0.5)
+		sw := 2 * float32(context.Scale())
+		if t.tabView.position.isVertical() {
+			y := float32(b.Min.Y) + sw/2
+			if t.dropIndicator == tabViewDropIndicatorEnd {
+				y = float32(b.Max.Y) - sw/2
+			}
+			vector.StrokeLine(dst, float32(b.Min.X), y, float32(b.Max.X), y, sw, clr, false)
+		} else {
+			x := float32(b.Min.X) + sw/2
+			if t.dropIndicator == tabViewDropIndicatorEnd {
+				x = float32(b.Max.X) - sw/2
+			}
+			vector.StrokeLine(dst, x, float32(b.Min.Y), x, float32(b.Max.Y), sw, clr, false)
+		}
+	}
+}
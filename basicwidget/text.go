@@ -14,7 +14,6 @@ import (
 	"strings"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
 	"github.com/hajimehoshi/ebiten/v2/text/v2"
 	"github.com/zeebo/xxh3"
 	"golang.org/x/text/language"
@@ -90,17 +89,17 @@ var (
 )
 
 func isMouseButtonRepeating(button ebiten.MouseButton) bool {
-	if !ebiten.IsMouseButtonPressed(button) {
+	if !guigui.IsMouseButtonPressed(button) {
 		return false
 	}
-	return repeat(inpututil.MouseButtonPressDuration(button))
+	return repeat(guigui.MouseButtonPressDuration(button))
 }
 
 func isKeyRepeating(key ebiten.Key) bool {
-	if !ebiten.IsKeyPressed(key) {
+	if !guigui.IsKeyPressed(key) {
 		return false
 	}
-	return repeat(inpututil.KeyPressDuration(key))
+	return repeat(guigui.KeyPressDuration(key))
 }
 
 func repeat(duration int) bool {
@@ -149,6 +148,11 @@ type Text struct {
 	selectionVisibleWhenUnfocus bool
 	ellipsisString              string
 
+	// highlightStart and highlightEnd are the byte range highlighted when no selection is drawn,
+	// e.g. the matched part of a filtered item.
+	highlightStart int
+	highlightEnd   int
+
 	selectionDragStartPlus1 int
 	selectionDragEndPlus1   int
 
@@ -158,7 +162,6 @@ type Text struct {
 	dragging bool
 
 	clickCount         int
-	lastClickTick      int64
 	lastClickTextIndex int
 
 	caret textCaret
@@ -397,6 +400,8 @@ func (t *Text) WriteStateKey(w *guigui.StateKeyWriter) {
 	w.WriteBool(t.keepTailingSpace)
 	w.WriteBool(t.selectionVisibleWhenUnfocus)
 	w.WriteString(t.ellipsisString)
+	w.WriteInt(t.highlightStart)
+	w.WriteInt(t.highlightEnd)
 	writePadding(w, t.paddingForScrollOffset)
 	selStart, selEnd := t.field.Selection()
 	w.WriteInt(selStart)
@@ -1004,6 +1009,13 @@ func (t *Text) SetSelectionVisibleWhenUnfocused(visible bool) {
 	t.selectionVisibleWhenUnfocus = visible
 }
 
+// setHighlightRange sets the byte range of the value to highlight with the selection color.
+// The highlight is not drawn while a selection is drawn.
+func (t *Text) setHighlightRange(start, end int) {
+	t.highlightStart = start
+	t.highlightEnd = end
+}
+
 func (t *Text) SetEllipsisString(str string) {
 	if t.ellipsisString == str {
 		return
@@ -1102,9 +1114,9 @@ func (t *Text) HandlePointingInput(context *guigui.Context, widgetBounds *guigui
 		return guigui.HandleInputResult{}
 	}
 
-	cursorPosition := image.Pt(ebiten.CursorPosition())
+	cursorPosition := image.Pt(guigui.CursorPosition())
 	if t.dragging {
-		if ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
+		if guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
 			idx := t.textIndexFromPosition(context, widgetBounds.Bounds(), cursorPosition, false)
 			start, end := idx, idx
 			if t.selectionDragStartPlus1-1 >= 0 {
@@ -1119,7 +1131,7 @@ func (t *Text) HandlePointingInput(context *guigui.Context, widgetBounds *guigui
 				return guigui.AbortHandlingInputByWidget(t)
 			}
 		}
-		if inpututil.IsMouseButtonJustReleased(ebiten.MouseButtonLeft) {
+		if guigui.IsMouseButtonJustReleased(ebiten.MouseButtonLeft) {
 			t.dragging = false
 			t.selectionDragStartPlus1 = 0
 			t.selectionDragEndPlus1 = 0
@@ -1128,11 +1140,11 @@ func (t *Text) HandlePointingInput(context *guigui.Context, widgetBounds *guigui
 		return guigui.AbortHandlingInputByWidget(t)
 	}
 
-	left := inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft)
-	right := inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonRight)
+	left := guigui.IsMouseButtonJustPressed(ebiten.MouseButtonLeft)
+	right := guigui.IsMouseButtonJustPressed(ebiten.MouseButtonRight)
 	if left || right {
-		if widgetBounds.IsHitAtCursor() {
-			t.handleClick(context, widgetBounds.Bounds(), cursorPosition, left)
+		if click, ok := widgetBounds.ClickAtCursor(); ok {
+			t.handleClick(context, widgetBounds.Bounds(), cursorPosition, left, click.Count > 1)
 			if left {
 				return guigui.HandleInputByWidget(t)
 			}
@@ -1162,11 +1174,11 @@ func (t *Text) HandlePointingInput(context *guigui.Context, widgetBounds *guigui
 	return guigui.HandleInputResult{}
 }
 
-func (t *Text) handleClick(context *guigui.Context, textBounds image.Rectangle, cursorPosition image.Point, leftClick bool) {
+func (t *Text) handleClick(context *guigui.Context, textBounds image.Rectangle, cursorPosition image.Point, leftClick bool, consecutive bool) {
 	idx := t.textIndexFromPosition(context, textBounds, cursorPosition, false)
 
 	if leftClick {
-		if ebiten.Tick()-t.lastClickTick < int64(doubleClickLimitInTicks()) && t.lastClickTextIndex == idx {
+		if consecutive && t.lastClickTextIndex == idx {
 			t.clickCount++
 		} else {
 			t.clickCount = 1
@@ -1203,7 +1215,6 @@ func (t *Text) handleClick(context *guigui.Context, textBounds image.Rectangle,
 
 	context.SetFocused(t, true)
 
-	t.lastClickTick = ebiten.Tick()
 	t.lastClickTextIndex = idx
 }
 
@@ -1473,7 +1484,7 @@ func (t *Text) handleButtonInput(context *guigui.Context, widgetBounds *guigui.W
 		// https://support.microsoft.com/en-us/windows/keyboard-shortcuts-in-windows-dcc61a57-8ff0-cffe-9796-cb9706c75eec#textediting
 
 		switch {
-		case inpututil.IsKeyJustPressed(ebiten.KeyEnter):
+		case guigui.IsKeyJustPressed(ebiten.KeyEnter):
 			if t.multiline {
 				t.replaceTextAtSelection("\n")
 			} else {
@@ -1481,7 +1492,7 @@ func (t *Text) handleButtonInput(context *guigui.Context, widgetBounds *guigui.W
 			}
 			return guigui.HandleInputByWidget(t)
 		case isKeyRepeating(ebiten.KeyBackspace) ||
-			isDarwin() && ebiten.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyH):
+			isDarwin() && guigui.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyH):
 			start, end := t.field.Selection()
 			if start != end {
 				t.replaceTextAtSelection("")
@@ -1490,8 +1501,8 @@ func (t *Text) handleButtonInput(context *guigui.Context, widgetBounds *guigui.W
 				t.replaceTextAt("", pos, start)
 			}
 			return guigui.HandleInputByWidget(t)
-		case !isDarwin() && ebiten.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyD) ||
-			isDarwin() && ebiten.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyD):
+		case !isDarwin() && guigui.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyD) ||
+			isDarwin() && guigui.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyD):
 			// Delete
 			start, end := t.field.Selection()
 			if start != end {
@@ -1508,27 +1519,27 @@ func (t *Text) handleButtonInput(context *guigui.Context, widgetBounds *guigui.W
 				t.replaceTextAt("", start, pos)
 			}
 			return guigui.HandleInputByWidget(t)
-		case !isDarwin() && ebiten.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyX) ||
-			isDarwin() && ebiten.IsKeyPressed(ebiten.KeyMeta) && isKeyRepeating(ebiten.KeyX):
+		case !isDarwin() && guigui.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyX) ||
+			isDarwin() && guigui.IsKeyPressed(ebiten.KeyMeta) && isKeyRepeating(ebiten.KeyX):
 			t.Cut()
 			return guigui.HandleInputByWidget(t)
-		case !isDarwin() && ebiten.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyV) ||
-			isDarwin() && ebiten.IsKeyPressed(ebiten.KeyMeta) && isKeyRepeating(ebiten.KeyV):
+		case !isDarwin() && guigui.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyV) ||
+			isDarwin() && guigui.IsKeyPressed(ebiten.KeyMeta) && isKeyRepeating(ebiten.KeyV):
 			t.Paste()
 			return guigui.HandleInputByWidget(t)
-		case !isDarwin() && ebiten.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyY) ||
-			isDarwin() && ebiten.IsKeyPressed(ebiten.KeyMeta) && ebiten.IsKeyPressed(ebiten.KeyShift) && isKeyRepeating(ebiten.KeyZ):
+		case !isDarwin() && guigui.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyY) ||
+			isDarwin() && guigui.IsKeyPressed(ebiten.KeyMeta) && guigui.IsKeyPressed(ebiten.KeyShift) && isKeyRepeating(ebiten.KeyZ):
 			t.Redo()
 			return guigui.HandleInputByWidget(t)
-		case !isDarwin() && ebiten.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyZ) ||
-			isDarwin() && ebiten.IsKeyPressed(ebiten.KeyMeta) && isKeyRepeating(ebiten.KeyZ):
+		case !isDarwin() && guigui.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyZ) ||
+			isDarwin() && guigui.IsKeyPressed(ebiten.KeyMeta) && isKeyRepeating(ebiten.KeyZ):
 			t.Undo()
 			return guigui.HandleInputByWidget(t)
 		}
 	}
 
 	switch {
-	case ebiten.IsKeyPressed(ebiten.KeyControl) && ebiten.IsKeyPressed(ebiten.KeyShift) && isKeyRepeating(ebiten.KeyLeft):
+	case guigui.IsKeyPressed(ebiten.KeyControl) && guigui.IsKeyPressed(ebiten.KeyShift) && isKeyRepeating(ebiten.KeyLeft):
 		idx := 0
 		start, end := t.field.Selection()
 		if i, l := textutil.LastLineBreakPositionAndLen(t.stringValueWithRange(0, start)); i >= 0 {
@@ -1536,7 +1547,7 @@ func (t *Text) handleButtonInput(context *guigui.Context, widgetBounds *guigui.W
 		}
 		t.setSelection(idx, end, idx, true)
 		return guigui.HandleInputByWidget(t)
-	case ebiten.IsKeyPressed(ebiten.KeyControl) && ebiten.IsKeyPressed(ebiten.KeyShift) && isKeyRepeating(ebiten.KeyRight):
+	case guigui.IsKeyPressed(ebiten.KeyControl) && guigui.IsKeyPressed(ebiten.KeyShift) && isKeyRepeating(ebiten.KeyRight):
 		idx := t.field.TextLengthInBytes()
 		start, end := t.field.Selection()
 		if i, _ := textutil.FirstLineBreakPositionAndLen(t.stringValueWithRange(end, -1)); i >= 0 {
@@ -1545,9 +1556,9 @@ func (t *Text) handleButtonInput(context *guigui.Context, widgetBounds *guigui.W
 		t.setSelection(start, idx, idx, true)
 		return guigui.HandleInputByWidget(t)
 	case isKeyRepeating(ebiten.KeyLeft) ||
-		isDarwin() && ebiten.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyB):
+		isDarwin() && guigui.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyB):
 		start, end := t.field.Selection()
-		if ebiten.IsKeyPressed(ebiten.KeyShift) {
+		if guigui.IsKeyPressed(ebiten.KeyShift) {
 			if t.selectionShiftIndexPlus1-1 == end {
 				pos := t.prevPositionOnGraphemes(end)
 				t.setSelection(start, pos, pos, true)
@@ -1565,9 +1576,9 @@ func (t *Text) handleButtonInput(context *guigui.Context, widgetBounds *guigui.W
 		}
 		return guigui.HandleInputByWidget(t)
 	case isKeyRepeating(ebiten.KeyRight) ||
-		isDarwin() && ebiten.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyF):
+		isDarwin() && guigui.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyF):
 		start, end := t.field.Selection()
-		if ebiten.IsKeyPressed(ebiten.KeyShift) {
+		if guigui.IsKeyPressed(ebiten.KeyShift) {
 			if t.selectionShiftIndexPlus1-1 == start {
 				pos := t.nextPositionOnGraphemes(start)
 				t.setSelection(pos, end, pos, true)
@@ -1585,9 +1596,9 @@ func (t *Text) handleButtonInput(context *guigui.Context, widgetBounds *guigui.W
 		}
 		return guigui.HandleInputByWidget(t)
 	case isKeyRepeating(ebiten.KeyUp) ||
-		isDarwin() && ebiten.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyP):
+		isDarwin() && guigui.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyP):
 		lh := t.lineHeight(context)
-		shift := ebiten.IsKeyPressed(ebiten.KeyShift)
+		shift := guigui.IsKeyPressed(ebiten.KeyShift)
 		var moveEnd bool
 		start, end := t.field.Selection()
 		idx := start
@@ -1610,9 +1621,9 @@ func (t *Text) handleButtonInput(context *guigui.Context, widgetBounds *guigui.W
 		}
 		return guigui.HandleInputByWidget(t)
 	case isKeyRepeating(ebiten.KeyDown) ||
-		isDarwin() && ebiten.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyN):
+		isDarwin() && guigui.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyN):
 		lh := t.lineHeight(context)
-		shift := ebiten.IsKeyPressed(ebiten.KeyShift)
+		shift := guigui.IsKeyPressed(ebiten.KeyShift)
 		var moveStart bool
 		start, end := t.field.Selection()
 		idx := end
@@ -1634,40 +1645,40 @@ func (t *Text) handleButtonInput(context *guigui.Context, widgetBounds *guigui.W
 			}
 		}
 		return guigui.HandleInputByWidget(t)
-	case isDarwin() && ebiten.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyA):
+	case isDarwin() && guigui.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyA):
 		idx := 0
 		start, end := t.field.Selection()
 		if i, l := textutil.LastLineBreakPositionAndLen(t.stringValueWithRange(0, start)); i >= 0 {
 			idx = i + l
 		}
-		if ebiten.IsKeyPressed(ebiten.KeyShift) {
+		if guigui.IsKeyPressed(ebiten.KeyShift) {
 			t.setSelection(idx, end, idx, true)
 		} else {
 			t.setSelection(idx, idx, -1, true)
 		}
 		return guigui.HandleInputByWidget(t)
-	case isDarwin() && ebiten.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyE):
+	case isDarwin() && guigui.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyE):
 		idx := t.field.TextLengthInBytes()
 		start, end := t.field.Selection()
 		if i, _ := textutil.FirstLineBreakPositionAndLen(t.stringValueWithRange(end, -1)); i >= 0 {
 			idx = end + i
 		}
-		if ebiten.IsKeyPressed(ebiten.KeyShift) {
+		if guigui.IsKeyPressed(ebiten.KeyShift) {
 			t.setSelection(start, idx, idx, true)
 		} else {
 			t.setSelection(idx, idx, -1, true)
 		}
 		return guigui.HandleInputByWidget(t)
-	case !isDarwin() && ebiten.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyA) ||
-		isDarwin() && ebiten.IsKeyPressed(ebiten.KeyMeta) && isKeyRepeating(ebiten.KeyA):
+	case !isDarwin() && guigui.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyA) ||
+		isDarwin() && guigui.IsKeyPressed(ebiten.KeyMeta) && isKeyRepeating(ebiten.KeyA):
 		t.doSelectAll()
 		return guigui.HandleInputByWidget(t)
-	case !isDarwin() && ebiten.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyC) ||
-		isDarwin() && ebiten.IsKeyPressed(ebiten.KeyMeta) && isKeyRepeating(ebiten.KeyC):
+	case !isDarwin() && guigui.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyC) ||
+		isDarwin() && guigui.IsKeyPressed(ebiten.KeyMeta) && isKeyRepeating(ebiten.KeyC):
 		// Copy
 		t.Copy()
 		return guigui.HandleInputByWidget(t)
-	case isDarwin() && ebiten.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyK):
+	case isDarwin() && guigui.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyK):
 		// 'Kill' the text after the caret or the selection.
 		start, end := t.field.Selection()
 		if start == end {
@@ -1683,7 +1694,7 @@ func (t *Text) handleButtonInput(context *guigui.Context, widgetBounds *guigui.W
 		t.tmpClipboard = t.stringValueWithRange(start, end)
 		t.replaceTextAt("", start, end)
 		return guigui.HandleInputByWidget(t)
-	case isDarwin() && ebiten.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyY):
+	case isDarwin() && guigui.IsKeyPressed(ebiten.KeyControl) && isKeyRepeating(ebiten.KeyY):
 		// 'Yank' the killed text.
 		if t.tmpClipboard != "" {
 			t.replaceTextAtSelection(t.tmpClipboard)
@@ -1767,6 +1778,11 @@ func (t *Text) Draw(context *guigui.Context, widgetBounds *guigui.WidgetBounds,
 			op.SelectionStart = start
 			op.SelectionEnd = end
 			op.SelectionColor = basicwidgetdraw.TextSelectionColor(context.ColorMode())
+		} else if t.highlightStart < t.highlightEnd && t.highlightEnd <= t.field.TextLengthInBytes() {
+			op.DrawSelection = true
+			op.SelectionStart = t.highlightStart
+			op.SelectionEnd = t.highlightEnd
+			op.SelectionColor = basicwidgetdraw.TextSelectionColor(context.ColorMode())
 		} else {
 			op.DrawSelection = false
 		}
@@ -2421,7 +2437,7 @@ func (t *Text) adjustScrollOffset(context *guigui.Context, widgetBounds *guigui.
 
 	if t.dragging {
 		// Drag autoscroll tracks the mouse, not the caret.
-		cx, cy := ebiten.CursorPosition()
+		cx, cy := guigui.CursorPosition()
 		exEnd := float64(textVisibleBounds.Max.X) - float64(cx) - float64(t.paddingForScrollOffset.End)
 		eyEnd := float64(textVisibleBounds.Max.Y) - float64(cy) - float64(t.paddingForScrollOffset.Bottom)
 		if cx > textVisibleBounds.Max.X {
@@ -6,7 +6,11 @@ package basicwidget
 import (
 	"image"
 	"io"
+	"io/fs"
+	"log/slog"
 	"math"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/hajimehoshi/ebiten/v2"
 
@@ -23,6 +27,21 @@ const (
 	TextInputStyleInline
 )
 
+// TextInputFileDropMode represents how a [TextInput] treats files dropped onto it.
+type TextInputFileDropMode int
+
+const (
+	// TextInputFileDropModeNone indicates that the text input doesn't accept dropped files.
+	TextInputFileDropModeNone TextInputFileDropMode = iota
+
+	// TextInputFileDropModeName indicates that the names of dropped files are inserted at the selection.
+	TextInputFileDropModeName
+
+	// TextInputFileDropModeContent indicates that the contents of dropped files are inserted at the selection.
+	// Directories and files that are not valid UTF-8 are skipped.
+	TextInputFileDropModeContent
+)
+
 type TextInput struct {
 	guigui.DefaultWidget
 
@@ -34,6 +53,9 @@ type TextInput struct {
 	hasError          bool
 	focusBorderHidden bool
 	supportTextValue  string
+	fileDropMode      TextInputFileDropMode
+
+	onFilesDropped func(context *guigui.Context, files fs.FS, position image.Point)
 }
 
 // OnValueChanged sets the event handler that is called when the text value changes.
@@ -201,6 +223,7 @@ func (t *TextInput) WriteStateKey(w *guigui.StateKeyWriter) {
 	w.WriteBool(t.hasError)
 	w.WriteBool(t.focusBorderHidden)
 	w.WriteString(t.supportTextValue)
+	w.WriteUint64(uint64(t.fileDropMode))
 }
 
 // SetFocusBorderVisible sets whether the focus border is drawn around the
@@ -252,6 +275,63 @@ func (t *TextInput) SetIcon(icon *ebiten.Image) {
 	t.textInput.SetIcon(icon)
 }
 
+// FileDropMode returns how the text input treats dropped files.
+func (t *TextInput) FileDropMode() TextInputFileDropMode {
+	return t.fileDropMode
+}
+
+// SetFileDropMode sets how the text input treats files dropped onto it from outside the application.
+// The default is [TextInputFileDropModeNone].
+// A read-only text input doesn't accept dropped files regardless of this setting.
+func (t *TextInput) SetFileDropMode(mode TextInputFileDropMode) {
+	t.fileDropMode = mode
+}
+
+func (t *TextInput) insertDroppedFiles(files fs.FS) {
+	strs := droppedFileTexts(files, t.fileDropMode)
+	if len(strs) == 0 {
+		return
+	}
+
+	sep := " "
+	if t.textInput.text.Text().IsMultiline() {
+		sep = "\n"
+	}
+	t.ReplaceValueAtSelection(strings.Join(strs, sep))
+}
+
+// droppedFileTexts returns the texts to insert for the dropped files in the mode.
+// In [TextInputFileDropModeContent], directories and files that are not valid UTF-8 are skipped.
+func droppedFileTexts(files fs.FS, mode TextInputFileDropMode) []string {
+	entries, err := fs.ReadDir(files, ".")
+	if err != nil {
+		slog.Error(err.Error())
+		return nil
+	}
+
+	var strs []string
+	for _, e := range entries {
+		switch mode {
+		case TextInputFileDropModeName:
+			strs = append(strs, e.Name())
+		case TextInputFileDropModeContent:
+			if e.IsDir() {
+				continue
+			}
+			bs, err := fs.ReadFile(files, e.Name())
+			if err != nil {
+				slog.Error(err.Error())
+				continue
+			}
+			if !utf8.Valid(bs) {
+				continue
+			}
+			strs = append(strs, string(bs))
+		}
+	}
+	return strs
+}
+
 func (t *TextInput) CanCut() bool {
 	return t.textInput.CanCut()
 }
@@ -298,6 +378,16 @@ func (t *TextInput) Build(context *guigui.Context, adder *guigui.ChildAdder) err
 	context.SetPassthrough(&t.focus, true)
 	context.DelegateFocus(t, &t.textInput.text)
 
+	if t.fileDropMode != TextInputFileDropModeNone && t.textInput.IsEditable() {
+		if t.onFilesDropped == nil {
+			t.onFilesDropped = func(context *guigui.Context, files fs.FS, position image.Point) {
+				t.insertDroppedFiles(files)
+				context.SetFocused(t, true)
+			}
+		}
+		guigui.OnFilesDropped(t, t.onFilesDropped)
+	}
+
 	if t.supportTextValue != "" {
 		adder.AddWidget(&t.supportText)
 		t.supportText.SetValue(t.supportTextValue)
@@ -356,7 +446,9 @@ func (t *TextInput) Measure(context *guigui.Context, constraints guigui.Constrai
 }
 
 func (t *TextInput) Tick(context *guigui.Context, widgetBounds *guigui.WidgetBounds) error {
-	context.SetVisible(&t.focus, !t.focusBorderHidden && t.style != TextInputStyleInline && context.IsFocused(t.textInput.text.Text()))
+	focusBorderVisible := !t.focusBorderHidden && t.style != TextInputStyleInline && context.IsFocused(t.textInput.text.Text())
+	// Reuse the focus border as the hover feedback for dropping files.
+	context.SetVisible(&t.focus, focusBorderVisible || context.IsFileDropHovered(t))
 	return nil
 }
 
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package basicwidget_test
+
+import (
+	"slices"
+	"testing"
+	"testing/fstest"
+
+	"github.com/guigui-gui/guigui/basicwidget"
+)
+
+func TestDroppedFileTexts(t *testing.T) {
+	files := fstest.MapFS{
+		"a.txt":       {Data: []byte("alpha")},
+		"b.bin":       {Data: []byte{0xff, 0xfe}},
+		"c.txt":       {Data: []byte("gamma")},
+		"dir/d.txt":   {Data: []byte("delta")},
+		"empty.txt":   {Data: []byte("")},
+		"dir2/e.text": {Data: []byte("epsilon")},
+	}
+	testCases := []struct {
+		name string
+		mode basicwidget.TextInputFileDropMode
+		out  []string
+	}{
+		{
+			name: "none",
+			mode: basicwidget.TextInputFileDropModeNone,
+		},
+		{
+			name: "name",
+			mode: basicwidget.TextInputFileDropModeName,
+			out:  []string{"a.txt", "b.bin", "c.txt", "dir", "dir2", "empty.txt"},
+		},
+		{
+			name: "content",
+			mode: basicwidget.TextInputFileDropModeContent,
+			out:  []string{"alpha", "gamma", ""},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := basicwidget.DroppedFileTexts(files, tc.mode); !slices.Equal(got, tc.out) {
+				t.Errorf("got: %q, want: %q", got, tc.out)
+			}
+		})
+	}
+}
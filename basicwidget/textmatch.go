@@ -0,0 +1,211 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package basicwidget
+
+import (
+	"bytes"
+	"iter"
+	"slices"
+	"unicode"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+var collatorCache = map[language.Tag]*collate.Collator{}
+
+// collatorForLocale returns a collator to match texts for the locale.
+// The collator ignores the differences of cases, widths and diacritics.
+func collatorForLocale(locale language.Tag) *collate.Collator {
+	if c, ok := collatorCache[locale]; ok {
+		return c
+	}
+	c := collate.New(locale, collate.Loose)
+	collatorCache[locale] = c
+	return c
+}
+
+// hasPrefixCollated reports whether str starts with prefix in terms of the collator.
+func hasPrefixCollated(collator *collate.Collator, str, prefix string) bool {
+	if prefix == "" {
+		return true
+	}
+	var buf collate.Buffer
+	key := slices.Clone(collator.KeyFromString(&buf, prefix))
+	_, ok := prefixLenCollated(collator, &buf, str, key)
+	return ok
+}
+
+// indexCollated returns the byte range of the first part of str that matches substr in terms of the collator.
+// indexCollated returns false if there is no such part.
+func indexCollated(collator *collate.Collator, str, substr string) (start, end int, ok bool) {
+	if substr == "" {
+		return 0, 0, true
+	}
+	var buf collate.Buffer
+	key := slices.Clone(collator.KeyFromString(&buf, substr))
+	for start := range runeBoundaries(str) {
+		if start == len(str) {
+			break
+		}
+		if n, ok := prefixLenCollated(collator, &buf, str[start:], key); ok {
+			return start, start + n, true
+		}
+	}
+	return 0, 0, false
+}
+
+// prefixLenCollated returns the byte length of the shortest non-empty prefix of str whose collation key equals key.
+// prefixLenCollated returns false if there is no such prefix.
+//
+// The collators for matching ignore all the levels but the primary one,
+// so the key of a longer prefix extends the key of a shorter one.
+// Thus, the search stops as soon as the key of a prefix doesn't lead to key,
+// and only a few prefixes around the length of key are examined.
+func prefixLenCollated(collator *collate.Collator, buf *collate.Buffer, str string, key []byte) (int, bool) {
+	for end := range runeBoundaries(str) {
+		if end == 0 {
+			continue
+		}
+		buf.Reset()
+		k := collator.KeyFromString(buf, str[:end])
+		if bytes.Equal(k, key) {
+			return end, true
+		}
+		if !bytes.HasPrefix(key, k) {
+			return 0, false
+		}
+	}
+	return 0, false
+}
+
+// runeBoundaries iterates the byte offsets of the rune boundaries of str, including 0 and len(str).
+func runeBoundaries(str string) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := range str {
+			if !yield(i) {
+				return
+			}
+		}
+		yield(len(str))
+	}
+}
+
+// typeAhead accumulates the characters typed to search items by their prefixes.
+type typeAhead struct {
+	query    []rune
+	lastTick int64
+}
+
+// appendChars appends the typed characters to the query.
+// appendChars reports whether the query is continued from the previous one, and whether any character is appended.
+//
+// Control characters are ignored, and so are leading spaces, as a space key might be used for other purposes.
+func (t *typeAhead) appendChars(chars []rune, tick int64) (continued bool, appended bool) {
+	// The query is reset after one second without typing.
+	if tick-t.lastTick > int64(ebiten.TPS()) {
+		t.query = t.query[:0]
+	}
+	continued = len(t.query) > 0
+	for _, r := range chars {
+		if unicode.IsControl(r) {
+			continue
+		}
+		if len(t.query) == 0 && unicode.IsSpace(r) {
+			continue
+		}
+		t.query = append(t.query, r)
+		appended = true
+	}
+	if appended {
+		t.lastTick = tick
+	}
+	return continued, appended
+}
+
+func (t *typeAhead) queryString() string {
+	return string(t.query)
+}
+
+// isRepeatedRune reports whether the query consists of one rune repeated, e.g. "aaa".
+// Typing the same character repeatedly cycles the items starting with the character.
+func (t *typeAhead) isRepeatedRune() bool {
+	if len(t.query) < 2 {
+		return false
+	}
+	for _, r := range t.query[1:] {
+		if r != t.query[0] {
+			return false
+		}
+	}
+	return true
+}
+
+// nextMatchingIndex returns the first index matching match, searching from start and wrapping around.
+// nextMatchingIndex returns -1 if there is no such index.
+func nextMatchingIndex(count int, start int, match func(index int) bool) int {
+	if count <= 0 {
+		return -1
+	}
+	start = min(max(start, 0), count)
+	for i := range count {
+		idx := (start + i) % count
+		if match(idx) {
+			return idx
+		}
+	}
+	return -1
+}
+
+// textFilter matches texts with a query to filter items.
+// The results are cached until the query is changed.
+type textFilter struct {
+	collator *collate.Collator
+	query    string
+	matches  map[string]textMatch
+}
+
+type textMatch struct {
+	start int
+	end   int
+	ok    bool
+}
+
+// setQuery sets the query and reports whether the query is changed.
+func (t *textFilter) setQuery(collator *collate.Collator, query string) bool {
+	if t.collator == collator && t.query == query {
+		return false
+	}
+	t.collator = collator
+	t.query = query
+	clear(t.matches)
+	return true
+}
+
+// isActive reports whether the filter narrows items.
+func (t *textFilter) isActive() bool {
+	return t.collator != nil && t.query != ""
+}
+
+// match returns the byte range of the part of text matching the query.
+// match returns false if text doesn't match the query.
+func (t *textFilter) match(text string) (start, end int, ok bool) {
+	if !t.isActive() {
+		return 0, 0, true
+	}
+	if m, ok := t.matches[text]; ok {
+		return m.start, m.end, m.ok
+	}
+	start, end, ok = indexCollated(t.collator, text, t.query)
+	if t.matches == nil {
+		t.matches = map[string]textMatch{}
+	}
+	t.matches[text] = textMatch{
+		start: start,
+		end:   end,
+		ok:    ok,
+	}
+	return start, end, ok
+}
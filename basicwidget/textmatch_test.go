@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package basicwidget_test
+
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+
+	"github.com/guigui-gui/guigui/basicwidget"
+)
+
+func TestHasPrefixCollated(t *testing.T) {
+	testCases := []struct {
+		str    string
+		prefix string
+		out    bool
+	}{
+		{str: "Apple", prefix: "", out: true},
+		{str: "Apple", prefix: "a", out: true},
+		{str: "Apple", prefix: "AP", out: true},
+		{str: "Apple", prefix: "apple", out: true},
+		{str: "Apple", prefix: "apples", out: false},
+		{str: "Apple", prefix: "p", out: false},
+		{str: "Éclair", prefix: "ec", out: true},
+		{str: "ｆｕｌｌ", prefix: "fu", out: true},
+		{str: "", prefix: "a", out: false},
+		{str: "Aapple", prefix: "apple", out: false},
+	}
+	for _, tc := range testCases {
+		if got := basicwidget.HasPrefixCollated(language.English, tc.str, tc.prefix); got != tc.out {
+			t.Errorf("HasPrefixCollated(%q, %q): got: %t, want: %t", tc.str, tc.prefix, got, tc.out)
+		}
+	}
+}
+
+func TestIndexCollated(t *testing.T) {
+	testCases := []struct {
+		str    string
+		substr string
+		start  int
+		end    int
+		ok     bool
+	}{
+		{str: "Apple", substr: "", start: 0, end: 0, ok: true},
+		{str: "Pineapple", substr: "APP", start: 4, end: 7, ok: true},
+		{str: "Crème brûlée", substr: "brulee", start: 7, end: 15, ok: true},
+		{str: "Crème brûlée", substr: "creme", start: 0, end: 6, ok: true},
+		{str: "Banana", substr: "nana", start: 2, end: 6, ok: true},
+		{str: "Aapple", substr: "apple", start: 1, end: 6, ok: true},
+		{str: "Zero\u200bwidth", substr: "owi", start: 3, end: 9, ok: true},
+		{str: "Banana", substr: "cherry", ok: false},
+	}
+	for _, tc := range testCases {
+		start, end, ok := basicwidget.IndexCollated(language.English, tc.str, tc.substr)
+		if ok != tc.ok || ok && (start != tc.start || end != tc.end) {
+			t.Errorf("IndexCollated(%q, %q): got: (%d, %d, %t), want: (%d, %d, %t)", tc.str, tc.substr, start, end, ok, tc.start, tc.end, tc.ok)
+		}
+	}
+}
+
+func TestNextMatchingIndex(t *testing.T) {
+	items := []string{"Apple", "Banana", "Avocado", "Cherry"}
+	startsWithA := func(index int) bool {
+		return items[index][0] == 'A'
+	}
+	testCases := []struct {
+		start int
+		out   int
+	}{
+		{start: 0, out: 0},
+		{start: 1, out: 2},
+		{start: 3, out: 0},
+		{start: 4, out: 0},
+		{start: -1, out: 0},
+	}
+	for _, tc := range testCases {
+		if got := basicwidget.NextMatchingIndex(len(items), tc.start, startsWithA); got != tc.out {
+			t.Errorf("NextMatchingIndex(start: %d): got: %d, want: %d", tc.start, got, tc.out)
+		}
+	}
+	if got := basicwidget.NextMatchingIndex(len(items), 0, func(index int) bool { return false }); got != -1 {
+		t.Errorf("NextMatchingIndex with no matches: got: %d, want: -1", got)
+	}
+	if got := basicwidget.NextMatchingIndex(0, 0, startsWithA); got != -1 {
+		t.Errorf("NextMatchingIndex with no items: got: %d, want: -1", got)
+	}
+}
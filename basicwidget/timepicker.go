@@ -0,0 +1,260 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package basicwidget
+
+import (
+	"image"
+
+	"github.com/guigui-gui/guigui"
+)
+
+var (
+	timePickerEventValueChanged guigui.EventKey = guigui.GenerateEventKey()
+)
+
+const (
+	timePickerPeriodAM = iota
+	timePickerPeriodPM
+)
+
+// TimePicker is a composite widget to input a time of a day with [NumberInput]s for the hour, the minute and the second.
+//
+// Whether the 12-hour clock with AM and PM is used depends on [guigui.Context.FirstLocale].
+// The values of TimePicker are always in the 24-hour clock.
+type TimePicker struct {
+	guigui.DefaultWidget
+
+	hourInput     NumberInput
+	minuteInput   NumberInput
+	secondInput   NumberInput
+	colonTexts    [2]Text
+	periodControl SegmentedControl[int]
+
+	hour           int
+	minute         int
+	second         int
+	secondsVisible bool
+	twelveHour     bool
+
+	layoutItems []guigui.LinearLayoutItem
+
+	onHourChanged   func(context *guigui.Context, value int, committed bool)
+	onMinuteChanged func(context *guigui.Context, value int, committed bool)
+	onSecondChanged func(context *guigui.Context, value int, committed bool)
+	onPeriodChanged func(context *guigui.Context, index int)
+}
+
+// OnValueChanged sets the event handler that is called when the time is changed by the user.
+// hour is in [0, 23].
+func (t *TimePicker) OnValueChanged(f func(context *guigui.Context, hour, minute, second int, committed bool)) {
+	guigui.SetEventHandler(t, timePickerEventValueChanged, f)
+}
+
+// Value returns the time in the 24-hour clock.
+func (t *TimePicker) Value() (hour, minute, second int) {
+	return t.hour, t.minute, t.second
+}
+
+// SetValue sets the time in the 24-hour clock.
+// Each value is clamped into its valid range.
+func (t *TimePicker) SetValue(hour, minute, second int) {
+	t.hour = min(max(hour, 0), 23)
+	t.minute = min(max(minute, 0), 59)
+	t.second = min(max(second, 0), 59)
+}
+
+// IsSecondsVisible reports whether the input for the second is shown.
+func (t *TimePicker) IsSecondsVisible() bool {
+	return t.secondsVisible
+}
+
+// SetSecondsVisible sets whether the input for the second is shown.
+// The default value is false.
+func (t *TimePicker) SetSecondsVisible(visible bool) {
+	t.secondsVisible = visible
+}
+
+func (t *TimePicker) WriteStateKey(w *guigui.StateKeyWriter) {
+	w.WriteInt64(int64(t.hour))
+	w.WriteInt64(int64(t.minute))
+	w.WriteInt64(int64(t.second))
+	w.WriteBool(t.secondsVisible)
+}
+
+func (t *TimePicker) setValue(hour, minute, second int, committed bool) {
+	t.SetValue(hour, minute, second)
+	guigui.DispatchEvent(t, timePickerEventValueChanged, t.hour, t.minute, t.second, committed)
+}
+
+func (t *TimePicker) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
+	locale := context.FirstLocale()
+	t.twelveHour = uses12HourClockForLocale(locale)
+
+	adder.AddWidget(&t.hourInput)
+	adder.AddWidget(&t.colonTexts[0])
+	adder.AddWidget(&t.minuteInput)
+	if t.secondsVisible {
+		adder.AddWidget(&t.colonTexts[1])
+		adder.AddWidget(&t.secondInput)
+	}
+	if t.twelveHour {
+		adder.AddWidget(&t.periodControl)
+	}
+
+	enabled := context.IsEnabled(t)
+	for i := range t.colonTexts {
+		t.colonTexts[i].SetValue(":")
+		t.colonTexts[i].SetHorizontalAlign(HorizontalAlignCenter)
+		t.colonTexts[i].SetVerticalAlign(VerticalAlignMiddle)
+		context.SetEnabled(&t.colonTexts[i], enabled)
+	}
+
+	if t.twelveHour {
+		t.hourInput.SetMinimumValue(1)
+		t.hourInput.SetMaximumValue(12)
+		h := t.hour % 12
+		if h == 0 {
+			h = 12
+		}
+		t.hourInput.SetValue(h)
+		t.hourInput.setMinimumIntegerDigits(1)
+	} else {
+		t.hourInput.SetMinimumValue(0)
+		t.hourInput.SetMaximumValue(23)
+		t.hourInput.SetValue(t.hour)
+		t.hourInput.setMinimumIntegerDigits(2)
+	}
+	if t.onHourChanged == nil {
+		t.onHourChanged = func(context *guigui.Context, value int, committed bool) {
+			h := value
+			if t.twelveHour {
+				h = value % 12
+				if t.hour >= 12 {
+					h += 12
+				}
+			}
+			t.setValue(h, t.minute, t.second, committed)
+		}
+	}
+	t.hourInput.OnValueChanged(t.onHourChanged)
+
+	t.minuteInput.SetMinimumValue(0)
+	t.minuteInput.SetMaximumValue(59)
+	t.minuteInput.SetValue(t.minute)
+	t.minuteInput.setMinimumIntegerDigits(2)
+	if t.onMinuteChanged == nil {
+		t.onMinuteChanged = func(context *guigui.Context, value int, committed bool) {
+			t.setValue(t.hour, value, t.second, committed)
+		}
+	}
+	t.minuteInput.OnValueChanged(t.onMinuteChanged)
+
+	t.secondInput.SetMinimumValue(0)
+	t.secondInput.SetMaximumValue(59)
+	t.secondInput.SetValue(t.second)
+	t.secondInput.setMinimumIntegerDigits(2)
+	if t.onSecondChanged == nil {
+		t.onSecondChanged = func(context *guigui.Context, value int, committed bool) {
+			t.setValue(t.hour, t.minute, value, committed)
+		}
+	}
+	t.secondInput.OnValueChanged(t.onSecondChanged)
+
+	symbols := dateSymbolsForLocale(locale)
+	t.periodControl.SetItems([]SegmentedControlItem[int]{
+		{
+			Text:  symbols.am,
+			Value: timePickerPeriodAM,
+		},
+		{
+			Text:  symbols.pm,
+			Value: timePickerPeriodPM,
+		},
+	})
+	t.periodControl.SelectItemByValue(t.hour / 12)
+	if t.onPeriodChanged == nil {
+		t.onPeriodChanged = func(context *guigui.Context, index int) {
+			item, ok := t.periodControl.ItemByIndex(index)
+			if !ok {
+				return
+			}
+			t.setValue(t.hour%12+12*item.Value, t.minute, t.second, true)
+		}
+	}
+	t.periodControl.OnItemSelected(t.onPeriodChanged)
+
+	return nil
+}
+
+func (t *TimePicker) fieldWidth(context *guigui.Context) int {
+	return UnitSize(context) * 5 / 2
+}
+
+func (t *TimePicker) colonWidth(context *guigui.Context) int {
+	return UnitSize(context) / 2
+}
+
+func (t *TimePicker) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
+	fw := t.fieldWidth(context)
+	cw := t.colonWidth(context)
+
+	t.layoutItems = t.layoutItems[:0]
+	t.layoutItems = append(t.layoutItems,
+		guigui.LinearLayoutItem{
+			Widget: &t.hourInput,
+			Size:   guigui.FixedSize(fw),
+		},
+		guigui.LinearLayoutItem{
+			Widget: &t.colonTexts[0],
+			Size:   guigui.FixedSize(cw),
+		},
+		guigui.LinearLayoutItem{
+			Widget: &t.minuteInput,
+			Size:   guigui.FixedSize(fw),
+		})
+	if t.secondsVisible {
+		t.layoutItems = append(t.layoutItems,
+			guigui.LinearLayoutItem{
+				Widget: &t.colonTexts[1],
+				Size:   guigui.FixedSize(cw),
+			},
+			guigui.LinearLayoutItem{
+				Widget: &t.secondInput,
+				Size:   guigui.FixedSize(fw),
+			})
+	}
+	if t.twelveHour {
+		t.layoutItems = append(t.layoutItems,
+			guigui.LinearLayoutItem{
+				Size: guigui.FixedSize(UnitSize(context) / 4),
+			},
+			guigui.LinearLayoutItem{
+				Widget: &t.periodControl,
+			})
+	}
+
+	b := widgetBounds.Bounds()
+	b.Max.Y = min(b.Max.Y, b.Min.Y+t.hourInput.Measure(context, guigui.FixedWidthConstraints(fw)).Y)
+	(guigui.LinearLayout{
+		Direction: guigui.LayoutDirectionHorizontal,
+		Items:     t.layoutItems,
+	}).LayoutWidgets(context, b, layouter)
+}
+
+func (t *TimePicker) Measure(context *guigui.Context, constraints guigui.Constraints) image.Point {
+	fw := t.fieldWidth(context)
+	cw := t.colonWidth(context)
+
+	w := 2*fw + cw
+	if t.secondsVisible {
+		w += fw + cw
+	}
+	h := t.hourInput.Measure(context, guigui.FixedWidthConstraints(fw)).Y
+	if t.twelveHour {
+		s := t.periodControl.Measure(context, guigui.Constraints{})
+		w += UnitSize(context)/4 + s.X
+		h = max(h, s.Y)
+	}
+	return image.Pt(w, h)
+}
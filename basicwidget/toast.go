@@ -0,0 +1,546 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package basicwidget
+
+import (
+	"image"
+	"slices"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/guigui-gui/guigui"
+	"github.com/guigui-gui/guigui/basicwidget/basicwidgetdraw"
+)
+
+// EnvKeyToaster is the environment key for obtaining a [*Toaster].
+//
+// An application typically puts a [Toaster] in its root widget and returns it for this key from the root widget's Env,
+// so that any widget can post a toast without owning the [Toaster]:
+//
+//	if v, ok := context.Env(widget, basicwidget.EnvKeyToaster); ok {
+//		v.(*basicwidget.Toaster).Post(basicwidget.Toast{Message: "Saved"})
+//	}
+var EnvKeyToaster guigui.EnvKey = guigui.GenerateEnvKey()
+
+// ToastPosition is the corner or the edge where a [Toaster] stacks toasts.
+type ToastPosition int
+
+const (
+	ToastPositionBottomEnd ToastPosition = iota
+	ToastPositionBottomCenter
+	ToastPositionBottomStart
+	ToastPositionTopEnd
+	ToastPositionTopCenter
+	ToastPositionTopStart
+)
+
+func (t ToastPosition) isTop() bool {
+	return t == ToastPositionTopEnd || t == ToastPositionTopCenter || t == ToastPositionTopStart
+}
+
+// ToastAction is an action button of a [Toast].
+type ToastAction struct {
+	// Text is the text of the button.
+	Text string
+
+	// Handler is called when the button is pressed. The toast is dismissed after Handler is called.
+	Handler func(context *guigui.Context)
+}
+
+// Toast is a notification posted to a [Toaster].
+type Toast struct {
+	// Message is the message of the toast.
+	Message string
+
+	// SemanticColor is the color of the toast.
+	SemanticColor basicwidgetdraw.SemanticColor
+
+	// Duration is the duration the toast is shown.
+	// The timer is paused while the cursor is on the toast.
+	// If Duration is 0, the toast is shown until it is dismissed.
+	Duration time.Duration
+
+	// Closable reports whether the toast has a close button.
+	Closable bool
+
+	// Actions are the action buttons of the toast.
+	Actions []ToastAction
+}
+
+const defaultToastMaxVisibleCount = 3
+
+// Toaster is a notification service that shows toasts stacked at a corner.
+//
+// A Toaster should be laid out with the bounds where toasts can be shown, typically the whole application bounds.
+// A Toaster doesn't obscure the widgets behind it, as toasts are shown in their own popups.
+//
+// Toasts exceeding the maximum visible count are queued, and shown when other toasts are dismissed.
+// A toast is dismissed when its timer expires, or when it is clicked, swiped, closed or its action button is pressed.
+//
+// See [EnvKeyToaster] to post toasts from any widget.
+type Toaster struct {
+	guigui.DefaultWidget
+
+	items           guigui.WidgetSlice[*toasterItem]
+	queue           []toasterEntry
+	position        ToastPosition
+	maxVisibleCount int
+	nextID          int
+
+	activeItems []*toasterItem
+}
+
+type toasterEntry struct {
+	id    int
+	toast Toast
+}
+
+// Post posts a toast and returns its ID.
+// The ID can be used to dismiss the toast by [Toaster.Dismiss].
+func (t *Toaster) Post(toast Toast) int {
+	t.nextID++
+	toast.Actions = slices.Clone(toast.Actions)
+	t.queue = append(t.queue, toasterEntry{
+		id:    t.nextID,
+		toast: toast,
+	})
+	t.showQueuedToasts()
+	guigui.RequestRebuild(t)
+	return t.nextID
+}
+
+// Dismiss dismisses the toast with the given ID.
+// Dismiss does nothing if the toast is already dismissed.
+func (t *Toaster) Dismiss(id int) {
+	for i, e := range t.queue {
+		if e.id == id {
+			t.queue = slices.Delete(t.queue, i, i+1)
+			return
+		}
+	}
+	for i := range t.items.Len() {
+		if item := t.items.At(i); item.id == id {
+			item.dismiss()
+			return
+		}
+	}
+}
+
+// DismissAll dismisses all the toasts including the queued ones.
+func (t *Toaster) DismissAll() {
+	t.queue = slices.Delete(t.queue, 0, len(t.queue))
+	for i := range t.items.Len() {
+		if item := t.items.At(i); item.id != 0 {
+			item.dismiss()
+		}
+	}
+}
+
+// Position returns the position where the toasts are stacked.
+func (t *Toaster) Position() ToastPosition {
+	return t.position
+}
+
+// SetPosition sets the position where the toasts are stacked.
+// The default position is [ToastPositionBottomEnd].
+func (t *Toaster) SetPosition(position ToastPosition) {
+	if t.position == position {
+		return
+	}
+	t.position = position
+	guigui.RequestRebuild(t)
+}
+
+// MaxVisibleCount returns the maximum number of toasts shown at the same time.
+func (t *Toaster) MaxVisibleCount() int {
+	if t.maxVisibleCount <= 0 {
+		return defaultToastMaxVisibleCount
+	}
+	return t.maxVisibleCount
+}
+
+// SetMaxVisibleCount sets the maximum number of toasts shown at the same time.
+// If count is 0 or less, the default count is used.
+func (t *Toaster) SetMaxVisibleCount(count int) {
+	if t.maxVisibleCount == count {
+		return
+	}
+	t.maxVisibleCount = count
+	t.showQueuedToasts()
+	guigui.RequestRebuild(t)
+}
+
+func (t *Toaster) activeItemCount() int {
+	var count int
+	for i := range t.items.Len() {
+		if t.items.At(i).id != 0 {
+			count++
+		}
+	}
+	return count
+}
+
+func (t *Toaster) showQueuedToasts() {
+	for len(t.queue) > 0 && t.activeItemCount() < t.MaxVisibleCount() {
+		e := t.queue[0]
+		t.queue = slices.Delete(t.queue, 0, 1)
+
+		// Find a free slot.
+		idx := -1
+		for i := range t.items.Len() {
+			if t.items.At(i).id == 0 {
+				idx = i
+				break
+			}
+		}
+		// If no free slot, add a new one.
+		if idx == -1 {
+			idx = t.items.Len()
+			t.items.SetLen(idx + 1)
+		}
+		t.items.At(idx).show(t, e.id, e.toast)
+	}
+}
+
+func (t *Toaster) WriteStateKey(w *guigui.StateKeyWriter) {
+	w.WriteInt(int(t.position))
+	w.WriteInt(t.MaxVisibleCount())
+	w.WriteInt(len(t.queue))
+	for i := range t.items.Len() {
+		item := t.items.At(i)
+		w.WriteInt(item.id)
+		w.WriteInt(item.swipeOffset)
+	}
+}
+
+func (t *Toaster) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
+	for i := range t.items.Len() {
+		if item := t.items.At(i); item.id != 0 {
+			adder.AddWidget(item)
+		}
+	}
+	return nil
+}
+
+func (t *Toaster) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
+	bounds := widgetBounds.Bounds()
+	u := UnitSize(context)
+	margin := u / 2
+	gap := u / 4
+	maxWidth := max(min(bounds.Dx()-2*margin, 16*u), 0)
+
+	// Stack the toasts from the edge. The newest toast is the nearest to the edge.
+	t.activeItems = slices.Delete(t.activeItems, 0, len(t.activeItems))
+	for i := range t.items.Len() {
+		if item := t.items.At(i); item.id != 0 {
+			t.activeItems = append(t.activeItems, item)
+		}
+	}
+	slices.SortFunc(t.activeItems, func(a, b *toasterItem) int {
+		return b.id - a.id
+	})
+
+	var offset int
+	for _, item := range t.activeItems {
+		w := min(item.content.Measure(context, guigui.Constraints{}).X, maxWidth)
+		h := item.content.Measure(context, guigui.FixedWidthConstraints(w)).Y
+
+		var x int
+		switch t.position {
+		case ToastPositionBottomEnd, ToastPositionTopEnd:
+			x = bounds.Max.X - margin - w
+		case ToastPositionBottomCenter, ToastPositionTopCenter:
+			x = bounds.Min.X + (bounds.Dx()-w)/2
+		case ToastPositionBottomStart, ToastPositionTopStart:
+			x = bounds.Min.X + margin
+		}
+		x += item.swipeOffset
+
+		var y int
+		if t.position.isTop() {
+			y = bounds.Min.Y + margin + offset
+		} else {
+			y = bounds.Max.Y - margin - offset - h
+		}
+		offset += h + gap
+
+		layouter.LayoutWidget(item, image.Rect(x, y, x+w, y+h))
+	}
+}
+
+func (t *Toaster) Tick(context *guigui.Context, widgetBounds *guigui.WidgetBounds) error {
+	// Free the slots of the toasts whose closing animations are finished.
+	var freed bool
+	for i := range t.items.Len() {
+		item := t.items.At(i)
+		if item.id == 0 || !item.dismissed || item.popup.IsOpen() {
+			continue
+		}
+		item.reset()
+		freed = true
+	}
+	if freed {
+		t.showQueuedToasts()
+		guigui.RequestRebuild(t)
+	}
+	return nil
+}
+
+type toasterItem struct {
+	guigui.DefaultWidget
+
+	popup   Popup
+	content toasterItemContent
+
+	toaster   *Toaster
+	id        int
+	toast     Toast
+	remaining time.Duration
+	dismissed bool
+
+	swipeOffset int
+}
+
+func (t *toasterItem) show(toaster *Toaster, id int, toast Toast) {
+	t.toaster = toaster
+	t.id = id
+	t.toast = toast
+	t.remaining = toast.Duration
+	t.dismissed = false
+	t.swipeOffset = 0
+	t.content.item = t
+	t.popup.SetOpen(true)
+}
+
+func (t *toasterItem) reset() {
+	t.id = 0
+	t.toast = Toast{}
+	t.remaining = 0
+	t.dismissed = false
+	t.swipeOffset = 0
+}
+
+func (t *toasterItem) dismiss() {
+	if t.dismissed {
+		return
+	}
+	t.dismissed = true
+	t.popup.SetOpen(false)
+}
+
+func (t *toasterItem) setSwipeOffset(offset int) {
+	if t.swipeOffset == offset {
+		return
+	}
+	t.swipeOffset = offset
+	guigui.RequestRebuild(t.toaster)
+}
+
+func (t *toasterItem) WriteStateKey(w *guigui.StateKeyWriter) {
+	w.WriteInt(t.id)
+	w.WriteBool(t.dismissed)
+}
+
+func (t *toasterItem) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
+	adder.AddWidget(&t.popup)
+
+	t.popup.SetContent(&t.content)
+	t.popup.SetModal(false)
+	t.popup.SetCloseByClickingOutside(false)
+	t.popup.SetBackgroundSemanticColor(t.toast.SemanticColor)
+
+	return nil
+}
+
+func (t *toasterItem) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
+	layouter.LayoutWidget(&t.popup, widgetBounds.Bounds())
+}
+
+type toasterItemContent struct {
+	guigui.DefaultWidget
+
+	item *toasterItem
+
+	text          Text
+	actionButtons guigui.WidgetSlice[*Button]
+	closeButton   Button
+
+	pressed          bool
+	pressStartX      int
+	swiping          bool
+	onActionsPressed []func(context *guigui.Context)
+	onClose          func(context *guigui.Context)
+
+	layout      guigui.LinearLayout
+	layoutItems []guigui.LinearLayoutItem
+}
+
+func (t *toasterItemContent) WriteStateKey(w *guigui.StateKeyWriter) {
+	toast := &t.item.toast
+	w.WriteString(toast.Message)
+	w.WriteInt(int(toast.SemanticColor))
+	w.WriteBool(toast.Closable)
+	w.WriteInt(len(toast.Actions))
+	for _, a := range toast.Actions {
+		w.WriteString(a.Text)
+	}
+}
+
+func (t *toasterItemContent) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
+	toast := &t.item.toast
+
+	adder.AddWidget(&t.text)
+	t.text.SetValue(toast.Message)
+	t.text.SetWrapMode(WrapModeWord)
+	t.text.SetVerticalAlign(VerticalAlignMiddle)
+	t.text.SetSemanticColor(toast.SemanticColor)
+
+	t.actionButtons.SetLen(len(toast.Actions))
+	t.onActionsPressed = adjustSliceSize(t.onActionsPressed, len(toast.Actions))
+	for i, action := range toast.Actions {
+		b := t.actionButtons.At(i)
+		adder.AddWidget(b)
+		b.SetText(action.Text)
+		b.SetSemanticColor(toast.SemanticColor)
+		if t.onActionsPressed[i] == nil {
+			t.onActionsPressed[i] = func(context *guigui.Context) {
+				actions := t.item.toast.Actions
+				if i < len(actions) && actions[i].Handler != nil {
+					actions[i].Handler(context)
+				}
+				t.item.dismiss()
+			}
+		}
+		b.OnUp(t.onActionsPressed[i])
+	}
+
+	if toast.Closable {
+		adder.AddWidget(&t.closeButton)
+		img, err := theResourceImages.Get("close", context.ColorMode())
+		if err != nil {
+			return err
+		}
+		t.closeButton.SetIcon(img)
+		t.closeButton.SetSemanticColor(toast.SemanticColor)
+		if t.onClose == nil {
+			t.onClose = func(context *guigui.Context) {
+				t.item.dismiss()
+			}
+		}
+		t.closeButton.OnUp(t.onClose)
+	}
+
+	return nil
+}
+
+func (t *toasterItemContent) buildLayout(context *guigui.Context) {
+	u := UnitSize(context)
+
+	t.layoutItems = slices.Delete(t.layoutItems, 0, len(t.layoutItems))
+	t.layoutItems = append(t.layoutItems, guigui.LinearLayoutItem{
+		Widget: &t.text,
+		Size:   guigui.FlexibleSize(1),
+	})
+	for i := range t.actionButtons.Len() {
+		t.layoutItems = append(t.layoutItems, guigui.LinearLayoutItem{
+			Widget: t.actionButtons.At(i),
+		})
+	}
+	if t.item.toast.Closable {
+		t.layoutItems = append(t.layoutItems, guigui.LinearLayoutItem{
+			Widget: &t.closeButton,
+			Size:   guigui.FixedSize(u),
+		})
+	}
+
+	t.layout = guigui.LinearLayout{
+		Direction: guigui.LayoutDirectionHorizontal,
+		Items:     t.layoutItems,
+		Gap:       u / 2,
+		Padding: guigui.Padding{
+			Start:  u / 2,
+			Top:    u / 4,
+			End:    u / 2,
+			Bottom: u / 4,
+		},
+	}
+}
+
+func (t *toasterItemContent) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
+	t.buildLayout(context)
+	t.layout.LayoutWidgets(context, widgetBounds.Bounds(), layouter)
+}
+
+func (t *toasterItemContent) Measure(context *guigui.Context, constraints guigui.Constraints) image.Point {
+	t.buildLayout(context)
+	return t.layout.Measure(context, constraints)
+}
+
+func (t *toasterItemContent) HandlePointingInput(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
+	if t.item.dismissed {
+		return guigui.HandleInputResult{}
+	}
+
+	if !t.pressed {
+		if widgetBounds.IsHitAtCursor() && guigui.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
+			t.pressed = true
+			t.swiping = false
+			t.pressStartX, _ = guigui.CursorPosition()
+			return guigui.HandleInputByWidget(t)
+		}
+		return guigui.HandleInputResult{}
+	}
+
+	x, _ := guigui.CursorPosition()
+	offset := x - t.pressStartX
+	if guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
+		if abs(offset) >= UnitSize(context)/4 {
+			t.swiping = true
+		}
+		if t.swiping {
+			t.item.setSwipeOffset(offset)
+		}
+		return guigui.HandleInputByWidget(t)
+	}
+
+	// The button is released.
+	t.pressed = false
+	if !t.swiping {
+		// Click to dismiss.
+		t.item.dismiss()
+		return guigui.HandleInputByWidget(t)
+	}
+	t.swiping = false
+	// Swipe to dismiss. If the swipe is not long enough, move the toast back.
+	if abs(offset) >= widgetBounds.Bounds().Dx()/3 {
+		t.item.dismiss()
+	} else {
+		t.item.setSwipeOffset(0)
+	}
+	return guigui.HandleInputByWidget(t)
+}
+
+func (t *toasterItemContent) CursorShape(context *guigui.Context, widgetBounds *guigui.WidgetBounds) (ebiten.CursorShapeType, bool) {
+	if t.item.dismissed || !widgetBounds.IsHitAtCursor() {
+		return 0, false
+	}
+	return ebiten.CursorShapePointer, true
+}
+
+func (t *toasterItemContent) Tick(context *guigui.Context, widgetBounds *guigui.WidgetBounds) error {
+	item := t.item
+	if item.dismissed || item.toast.Duration <= 0 {
+		return nil
+	}
+	// Pause the timer while the cursor is on the toast.
+	if widgetBounds.IsHitAtCursor() || t.pressed {
+		return nil
+	}
+	item.remaining -= time.Second / time.Duration(ebiten.TPS())
+	if item.remaining <= 0 {
+		item.dismiss()
+	}
+	return nil
+}
@@ -8,7 +8,6 @@ import (
 	"image/color"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
 
 	"github.com/guigui-gui/guigui"
 	"github.com/guigui-gui/guigui/basicwidget/basicwidgetdraw"
@@ -59,13 +58,13 @@ func toggleMaxCount() int {
 }
 
 func (t *Toggle) HandlePointingInput(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
-	if context.IsEnabled(t) && widgetBounds.IsHitAtCursor() && inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
+	if context.IsEnabled(t) && widgetBounds.IsHitAtCursor() && guigui.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
 		context.SetFocused(t, true)
 		t.pressed = true
 		t.SetValue(!t.value)
 		return guigui.HandleInputByWidget(t)
 	}
-	if !context.IsEnabled(t) || !ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
+	if !context.IsEnabled(t) || !guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
 		t.pressed = false
 	}
 	return guigui.HandleInputResult{}
@@ -162,11 +161,11 @@ func (t *Toggle) Draw(context *guigui.Context, widgetBounds *guigui.WidgetBounds
 }
 
 func (t *Toggle) canPress(context *guigui.Context, widgetBounds *guigui.WidgetBounds) bool {
-	return context.IsEnabled(t) && widgetBounds.IsHitAtCursor() && !ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft)
+	return context.IsEnabled(t) && widgetBounds.IsHitAtCursor() && !guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft)
 }
 
 func (t *Toggle) isActive(context *guigui.Context, widgetBounds *guigui.WidgetBounds) bool {
-	return context.IsEnabled(t) && widgetBounds.IsHitAtCursor() && ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft) && t.pressed
+	return context.IsEnabled(t) && widgetBounds.IsHitAtCursor() && guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft) && t.pressed
 }
 
 func (t *Toggle) Measure(context *guigui.Context, constraints guigui.Constraints) image.Point {
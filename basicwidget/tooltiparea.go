@@ -105,7 +105,7 @@ func (t *TooltipArea) Measure(context *guigui.Context, constraints guigui.Constr
 
 // HandlePointingInput implements [guigui.Widget.HandlePointingInput].
 func (t *TooltipArea) HandlePointingInput(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
-	cursorPos := image.Pt(ebiten.CursorPosition())
+	cursorPos := image.Pt(guigui.CursorPosition())
 	if cursorPos.In(widgetBounds.Bounds()) {
 		if !t.hovering {
 			t.hovering = true
@@ -0,0 +1,440 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package basicwidget
+
+import (
+	"image"
+	"slices"
+	"sync"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/guigui-gui/guigui"
+)
+
+var (
+	treeViewEventNodeSelected        guigui.EventKey = guigui.GenerateEventKey()
+	treeViewEventNodeExpanderToggled guigui.EventKey = guigui.GenerateEventKey()
+	treeViewEventNodeActivated       guigui.EventKey = guigui.GenerateEventKey()
+)
+
+// TreeViewNode is a node of a [TreeView].
+type TreeViewNode[T comparable] struct {
+	// Text is the text of the node.
+	Text string
+
+	// Content is the widget shown instead of the text if not nil.
+	Content guigui.Widget
+
+	// Disabled reports whether the node is disabled.
+	Disabled bool
+
+	// Value identifies the node.
+	// Value must be unique in the tree.
+	Value T
+}
+
+// TreeViewDataSource provides the nodes of a [TreeView].
+//
+// The methods are called in [guigui.Widget.Build] on every build, so they should be cheap.
+type TreeViewDataSource[T comparable] interface {
+	// AppendRootNodes appends the root nodes to nodes and returns the extended slice.
+	AppendRootNodes(nodes []TreeViewNode[T]) []TreeViewNode[T]
+
+	// HasChildren reports whether the node has child nodes.
+	// HasChildren is called for collapsed nodes too, so it should not load the child nodes.
+	HasChildren(value T) bool
+
+	// AppendChildNodes appends the child nodes of the node to nodes and returns the extended slice.
+	// AppendChildNodes is called only for expanded nodes.
+	//
+	// If the child nodes are not loaded yet, AppendChildNodes should start loading them asynchronously,
+	// and return false without appending anything.
+	// The tree view shows a loading placeholder until [TreeView.RefreshNode] is called for the node,
+	// and then calls AppendChildNodes again.
+	AppendChildNodes(nodes []TreeViewNode[T], value T) ([]TreeViewNode[T], bool)
+}
+
+// treeViewValue is the value of an item of the underlying list.
+type treeViewValue[T comparable] struct {
+	value T
+
+	// placeholder reports whether the item is a placeholder for the children of the node with value.
+	placeholder bool
+}
+
+type treeViewRow[T comparable] struct {
+	value       T
+	parentIndex int
+	hasChildren bool
+	placeholder bool
+}
+
+// TreeView is a widget to show hierarchical nodes provided by a [TreeViewDataSource].
+//
+// The expanded state is kept by node values, so it survives changes in the data source.
+// The tree view is flattened into a [List] internally and is virtualized in the same way.
+//
+// The Right key expands the selected node or selects its first child,
+// and the Left key collapses the selected node or selects its parent.
+type TreeView[T comparable] struct {
+	guigui.DefaultWidget
+
+	list         List[treeViewValue[T]]
+	loadingItems guigui.WidgetSlice[*treeViewLoadingItem]
+
+	dataSource       TreeViewDataSource[T]
+	expanded         map[T]struct{}
+	selectedValue    T
+	hasSelectedValue bool
+
+	// refreshedValues is the values of the nodes passed to RefreshNode.
+	// refreshedValues is guarded by refreshMutex, as RefreshNode can be called from any goroutine.
+	refreshedValues []T
+	refreshMutex    sync.Mutex
+
+	treeViewRows[T]
+
+	onItemSelected        func(context *guigui.Context, index int)
+	onItemExpanderToggled func(context *guigui.Context, index int, expanded bool)
+	onItemActivated       func(context *guigui.Context, index int)
+}
+
+// OnNodeSelected sets the event handler that is called when a node is selected by the user.
+func (t *TreeView[T]) OnNodeSelected(f func(context *guigui.Context, value T)) {
+	guigui.SetEventHandler(t, treeViewEventNodeSelected, f)
+}
+
+// OnNodeExpanderToggled sets the event handler that is called when a node is expanded or collapsed by the user.
+func (t *TreeView[T]) OnNodeExpanderToggled(f func(context *guigui.Context, value T, expanded bool)) {
+	guigui.SetEventHandler(t, treeViewEventNodeExpanderToggled, f)
+}
+
+// OnNodeActivated sets the event handler that is called when a node is activated, e.g. by a double click.
+func (t *TreeView[T]) OnNodeActivated(f func(context *guigui.Context, value T)) {
+	guigui.SetEventHandler(t, treeViewEventNodeActivated, f)
+}
+
+// SetDataSource sets the data source of the tree view.
+func (t *TreeView[T]) SetDataSource(dataSource TreeViewDataSource[T]) {
+	t.dataSource = dataSource
+}
+
+// SetStripeVisible sets whether the stripe is visible.
+func (t *TreeView[T]) SetStripeVisible(visible bool) {
+	t.list.SetStripeVisible(visible)
+}
+
+// IsNodeExpanded reports whether the node is expanded.
+func (t *TreeView[T]) IsNodeExpanded(value T) bool {
+	_, ok := t.expanded[value]
+	return ok
+}
+
+// SetNodeExpanded expands or collapses the node.
+func (t *TreeView[T]) SetNodeExpanded(value T, expanded bool) {
+	if t.IsNodeExpanded(value) == expanded {
+		return
+	}
+	if expanded {
+		if t.expanded == nil {
+			t.expanded = map[T]struct{}{}
+		}
+		t.expanded[value] = struct{}{}
+	} else {
+		delete(t.expanded, value)
+	}
+	guigui.RequestRebuild(t)
+}
+
+// RefreshNode notifies the tree view that the node or its child nodes are changed,
+// e.g. when the child nodes are loaded asynchronously.
+// The tree view queries the data source again at the next tick if the node is shown.
+//
+// RefreshNode can be called from any goroutine.
+func (t *TreeView[T]) RefreshNode(value T) {
+	t.refreshMutex.Lock()
+	defer t.refreshMutex.Unlock()
+	t.refreshedValues = append(t.refreshedValues, value)
+}
+
+// SelectedNode returns the value of the selected node.
+func (t *TreeView[T]) SelectedNode() (T, bool) {
+	return t.selectedValue, t.hasSelectedValue
+}
+
+// SelectNodeByValue selects the node.
+// The node is selected when it is shown, even if its ancestors are collapsed now.
+func (t *TreeView[T]) SelectNodeByValue(value T) {
+	if t.hasSelectedValue && t.selectedValue == value {
+		return
+	}
+	t.selectedValue = value
+	t.hasSelectedValue = true
+	guigui.RequestRebuild(t)
+}
+
+// UnselectNode clears the selection.
+func (t *TreeView[T]) UnselectNode() {
+	if !t.hasSelectedValue {
+		return
+	}
+	var zero T
+	t.selectedValue = zero
+	t.hasSelectedValue = false
+	guigui.RequestRebuild(t)
+}
+
+func (t *TreeView[T]) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
+	adder.AddWidget(&t.list)
+
+	t.buildRows()
+	t.list.SetItems(t.listItems)
+	if t.hasSelectedValue {
+		t.list.SelectItemByValue(treeViewValue[T]{value: t.selectedValue})
+	} else {
+		t.list.SelectItemByIndex(-1)
+	}
+
+	if t.onItemSelected == nil {
+		t.onItemSelected = func(context *guigui.Context, index int) {
+			if index < 0 || index >= len(t.rows) || t.rows[index].placeholder {
+				return
+			}
+			value := t.rows[index].value
+			if t.hasSelectedValue && t.selectedValue == value {
+				return
+			}
+			t.selectedValue = value
+			t.hasSelectedValue = true
+			guigui.DispatchEvent(t, treeViewEventNodeSelected, value)
+		}
+	}
+	t.list.OnItemSelected(t.onItemSelected)
+
+	if t.onItemExpanderToggled == nil {
+		t.onItemExpanderToggled = func(context *guigui.Context, index int, expanded bool) {
+			if index < 0 || index >= len(t.rows) || t.rows[index].placeholder {
+				return
+			}
+			t.setNodeExpandedByUser(t.rows[index].value, expanded)
+		}
+	}
+	t.list.OnItemExpanderToggled(t.onItemExpanderToggled)
+
+	if t.onItemActivated == nil {
+		t.onItemActivated = func(context *guigui.Context, index int) {
+			if index < 0 || index >= len(t.rows) || t.rows[index].placeholder {
+				return
+			}
+			guigui.DispatchEvent(t, treeViewEventNodeActivated, t.rows[index].value)
+		}
+	}
+	t.list.OnItemActivated(t.onItemActivated)
+
+	return nil
+}
+
+func (t *TreeView[T]) setNodeExpandedByUser(value T, expanded bool) {
+	if t.IsNodeExpanded(value) == expanded {
+		return
+	}
+	t.SetNodeExpanded(value, expanded)
+	guigui.DispatchEvent(t, treeViewEventNodeExpanderToggled, value, expanded)
+}
+
+// buildRows flattens the nodes from the data source into t.rows and t.listItems.
+func (t *TreeView[T]) buildRows() {
+	t.flatten(t.dataSource, t.IsNodeExpanded)
+
+	t.loadingItems.SetLen(len(t.loadingItemIndices))
+	for i, idx := range t.loadingItemIndices {
+		t.listItems[idx].Content = t.loadingItems.At(i)
+	}
+}
+
+// treeViewRows is the flattened rows of the nodes of a tree view.
+type treeViewRows[T comparable] struct {
+	rows      []treeViewRow[T]
+	listItems []ListItem[treeViewValue[T]]
+	tmpNodes  []TreeViewNode[T]
+
+	// loadingItemIndices is the indices of the list items of the loading placeholders.
+	loadingItemIndices []int
+}
+
+// flatten flattens the nodes of the data source with the expanded descendants.
+func (t *treeViewRows[T]) flatten(dataSource TreeViewDataSource[T], isExpanded func(value T) bool) {
+	t.rows = slices.Delete(t.rows, 0, len(t.rows))
+	t.listItems = slices.Delete(t.listItems, 0, len(t.listItems))
+	t.tmpNodes = slices.Delete(t.tmpNodes, 0, len(t.tmpNodes))
+	t.loadingItemIndices = t.loadingItemIndices[:0]
+
+	if dataSource != nil {
+		t.tmpNodes = dataSource.AppendRootNodes(t.tmpNodes)
+		t.appendRows(dataSource, isExpanded, 0, len(t.tmpNodes), 0, -1)
+	}
+}
+
+// hasAnyNode reports whether any of the nodes with values is in the rows.
+func (t *treeViewRows[T]) hasAnyNode(values []T) bool {
+	if len(values) == 0 {
+		return false
+	}
+	for _, row := range t.rows {
+		if !row.placeholder && slices.Contains(values, row.value) {
+			return true
+		}
+	}
+	return false
+}
+
+// appendRows appends the rows for t.tmpNodes[start:end] and their expanded descendants.
+func (t *treeViewRows[T]) appendRows(dataSource TreeViewDataSource[T], isExpanded func(value T) bool, start, end int, depth int, parentIndex int) {
+	for i := start; i < end; i++ {
+		node := t.tmpNodes[i]
+		hasChildren := dataSource.HasChildren(node.Value)
+		expanded := hasChildren && isExpanded(node.Value)
+
+		rowIndex := len(t.rows)
+		t.rows = append(t.rows, treeViewRow[T]{
+			value:       node.Value,
+			parentIndex: parentIndex,
+			hasChildren: hasChildren,
+		})
+		t.listItems = append(t.listItems, ListItem[treeViewValue[T]]{
+			Text:     node.Text,
+			Content:  node.Content,
+			Disabled: node.Disabled,
+			Value:    treeViewValue[T]{value: node.Value},
+			// IndentLevel 0 means a plain list item without an expander.
+			IndentLevel: depth + 1,
+			Collapsed:   !expanded,
+		})
+		if !hasChildren {
+			continue
+		}
+
+		if expanded {
+			childStart := len(t.tmpNodes)
+			var ok bool
+			t.tmpNodes, ok = dataSource.AppendChildNodes(t.tmpNodes, node.Value)
+			if ok {
+				t.appendRows(dataSource, isExpanded, childStart, len(t.tmpNodes), depth+1, rowIndex)
+				continue
+			}
+			t.tmpNodes = t.tmpNodes[:childStart]
+		}
+
+		// A collapsed node still needs a child item so that the list shows an expander.
+		// The child item is hidden as the node is collapsed.
+		// An expanded node whose child nodes are being loaded shows the child item as a loading placeholder.
+		if expanded {
+			t.loadingItemIndices = append(t.loadingItemIndices, len(t.listItems))
+		}
+		t.rows = append(t.rows, treeViewRow[T]{
+			value:       node.Value,
+			parentIndex: rowIndex,
+			placeholder: true,
+		})
+		t.listItems = append(t.listItems, ListItem[treeViewValue[T]]{
+			Unselectable: true,
+			Value: treeViewValue[T]{
+				value:       node.Value,
+				placeholder: true,
+			},
+			IndentLevel: depth + 2,
+		})
+	}
+}
+
+func (t *TreeView[T]) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
+	layouter.LayoutWidget(&t.list, widgetBounds.Bounds())
+}
+
+func (t *TreeView[T]) Measure(context *guigui.Context, constraints guigui.Constraints) image.Point {
+	return t.list.Measure(context, constraints)
+}
+
+// HandleButtonInput implements [guigui.Widget.HandleButtonInput].
+func (t *TreeView[T]) HandleButtonInput(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
+	left := isKeyRepeating(ebiten.KeyLeft)
+	right := isKeyRepeating(ebiten.KeyRight)
+	if !left && !right {
+		return guigui.HandleInputResult{}
+	}
+
+	index := t.list.SelectedItemIndex()
+	if index < 0 || index >= len(t.rows) {
+		return guigui.HandleInputResult{}
+	}
+	row := t.rows[index]
+
+	if right {
+		if !row.hasChildren {
+			return guigui.HandleInputResult{}
+		}
+		if !t.IsNodeExpanded(row.value) {
+			t.setNodeExpandedByUser(row.value, true)
+			return guigui.HandleInputByWidget(t)
+		}
+		if child := index + 1; child < len(t.rows) && !t.rows[child].placeholder {
+			t.selectRowByUser(child)
+		}
+		return guigui.HandleInputByWidget(t)
+	}
+
+	if row.hasChildren && t.IsNodeExpanded(row.value) {
+		t.setNodeExpandedByUser(row.value, false)
+		return guigui.HandleInputByWidget(t)
+	}
+	if row.parentIndex >= 0 {
+		t.selectRowByUser(row.parentIndex)
+	}
+	return guigui.HandleInputByWidget(t)
+}
+
+func (t *TreeView[T]) selectRowByUser(index int) {
+	// The list dispatches the item-selected event, which updates the selected value.
+	t.list.SelectItemByIndex(index)
+	t.list.EnsureItemVisibleByIndex(index)
+}
+
+func (t *TreeView[T]) Tick(context *guigui.Context, widgetBounds *guigui.WidgetBounds) error {
+	t.refreshMutex.Lock()
+	defer t.refreshMutex.Unlock()
+	if t.hasAnyNode(t.refreshedValues) {
+		guigui.RequestRebuild(t)
+	}
+	t.refreshedValues = slices.Delete(t.refreshedValues, 0, len(t.refreshedValues))
+	return nil
+}
+
+// treeViewLoadingItem is the placeholder shown while child nodes are being loaded.
+// The placeholder shows only a spinner so that it doesn't need a localized text.
+type treeViewLoadingItem struct {
+	guigui.DefaultWidget
+
+	spinner Spinner
+}
+
+func (t *treeViewLoadingItem) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
+	adder.AddWidget(&t.spinner)
+	return nil
+}
+
+func (t *treeViewLoadingItem) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
+	b := widgetBounds.Bounds()
+	h := LineHeight(context)
+	pt := image.Pt(b.Min.X, b.Min.Y+(b.Dy()-h)/2)
+	layouter.LayoutWidget(&t.spinner, image.Rectangle{
+		Min: pt,
+		Max: pt.Add(image.Pt(h, h)),
+	})
+}
+
+func (t *treeViewLoadingItem) Measure(context *guigui.Context, constraints guigui.Constraints) image.Point {
+	h := LineHeight(context)
+	return image.Pt(h, h)
+}
@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package basicwidget_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/guigui-gui/guigui/basicwidget"
+)
+
+type testTreeDataSource struct {
+	children map[int][]int
+	loading  map[int]bool
+}
+
+func (t *testTreeDataSource) appendNodes(nodes []basicwidget.TreeViewNode[int], values []int) []basicwidget.TreeViewNode[int] {
+	for _, v := range values {
+		nodes = append(nodes, basicwidget.TreeViewNode[int]{Value: v})
+	}
+	return nodes
+}
+
+func (t *testTreeDataSource) AppendRootNodes(nodes []basicwidget.TreeViewNode[int]) []basicwidget.TreeViewNode[int] {
+	return t.appendNodes(nodes, t.children[0])
+}
+
+func (t *testTreeDataSource) HasChildren(value int) bool {
+	return len(t.children[value]) > 0
+}
+
+func (t *testTreeDataSource) AppendChildNodes(nodes []basicwidget.TreeViewNode[int], value int) ([]basicwidget.TreeViewNode[int], bool) {
+	if t.loading[value] {
+		return nodes, false
+	}
+	return t.appendNodes(nodes, t.children[value]), true
+}
+
+func TestTreeViewItems(t *testing.T) {
+	type item = basicwidget.TreeViewTestItem[int]
+
+	children := map[int][]int{
+		0:  {1, 2, 3},
+		1:  {10, 11},
+		2:  {20},
+		10: {100},
+	}
+
+	testCases := []struct {
+		name     string
+		loading  map[int]bool
+		expanded []int
+		want     []item
+		wantLoad bool
+	}{
+		{
+			name: "collapsed",
+			want: []item{
+				{Value: 1, IndentLevel: 1, Collapsed: true},
+				{Value: 1, IndentLevel: 2, Placeholder: true},
+				{Value: 2, IndentLevel: 1, Collapsed: true},
+				{Value: 2, IndentLevel: 2, Placeholder: true},
+				{Value: 3, IndentLevel: 1, Collapsed: true},
+			},
+		},
+		{
+			name:     "expanded",
+			expanded: []int{1, 10},
+			want: []item{
+				{Value: 1, IndentLevel: 1},
+				{Value: 10, IndentLevel: 2},
+				{Value: 100, IndentLevel: 3, Collapsed: true},
+				{Value: 11, IndentLevel: 2, Collapsed: true},
+				{Value: 2, IndentLevel: 1, Collapsed: true},
+				{Value: 2, IndentLevel: 2, Placeholder: true},
+				{Value: 3, IndentLevel: 1, Collapsed: true},
+			},
+		},
+		{
+			name:     "expanded under collapsed",
+			expanded: []int{10},
+			want: []item{
+				{Value: 1, IndentLevel: 1, Collapsed: true},
+				{Value: 1, IndentLevel: 2, Placeholder: true},
+				{Value: 2, IndentLevel: 1, Collapsed: true},
+				{Value: 2, IndentLevel: 2, Placeholder: true},
+				{Value: 3, IndentLevel: 1, Collapsed: true},
+			},
+		},
+		{
+			name:     "loading",
+			loading:  map[int]bool{2: true},
+			expanded: []int{2},
+			want: []item{
+				{Value: 1, IndentLevel: 1, Collapsed: true},
+				{Value: 1, IndentLevel: 2, Placeholder: true},
+				{Value: 2, IndentLevel: 1},
+				{Value: 2, IndentLevel: 2, Placeholder: true},
+				{Value: 3, IndentLevel: 1, Collapsed: true},
+			},
+			wantLoad: true,
+		},
+		{
+			name:     "leaf expanded",
+			expanded: []int{3},
+			want: []item{
+				{Value: 1, IndentLevel: 1, Collapsed: true},
+				{Value: 1, IndentLevel: 2, Placeholder: true},
+				{Value: 2, IndentLevel: 1, Collapsed: true},
+				{Value: 2, IndentLevel: 2, Placeholder: true},
+				{Value: 3, IndentLevel: 1, Collapsed: true},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ds := &testTreeDataSource{
+				children: children,
+				loading:  tc.loading,
+			}
+			got, gotLoad := basicwidget.TreeViewItems(ds, tc.expanded)
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+			if gotLoad != tc.wantLoad {
+				t.Errorf("loading: got %v, want %v", gotLoad, tc.wantLoad)
+			}
+		})
+	}
+}
+
+func TestTreeViewHasAnyNode(t *testing.T) {
+	ds := &testTreeDataSource{
+		children: map[int][]int{
+			0: {1, 2},
+			1: {10, 11},
+			2: {20},
+		},
+		loading: map[int]bool{2: true},
+	}
+	testCases := []struct {
+		name     string
+		expanded []int
+		values   []int
+		want     bool
+	}{
+		{name: "none", expanded: []int{1}, want: false},
+		{name: "root", values: []int{2}, want: true},
+		{name: "collapsed child", values: []int{10}, want: false},
+		{name: "expanded child", expanded: []int{1}, values: []int{10}, want: true},
+		{name: "loading node", expanded: []int{2}, values: []int{2}, want: true},
+		{name: "missing", expanded: []int{1}, values: []int{99, 20}, want: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := basicwidget.TreeViewHasAnyNode(ds, tc.expanded, tc.values); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -6,8 +6,6 @@ package basicwidget
 import (
 	"slices"
 
-	"github.com/hajimehoshi/ebiten/v2"
-
 	"github.com/guigui-gui/guigui"
 )
 
@@ -47,10 +45,6 @@ func MoveItemsInSlice[T any](slice []T, from int, count int, to int) int {
 	return to
 }
 
-func doubleClickLimitInTicks() int {
-	return ebiten.TPS() / 2
-}
-
 func defaultIconSize(context *guigui.Context) int {
 	return LineHeight(context)
 }
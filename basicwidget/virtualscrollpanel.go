@@ -5,9 +5,9 @@ package basicwidget
 
 import (
 	"image"
+	"math"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
 
 	"github.com/guigui-gui/guigui"
 	"github.com/guigui-gui/guigui/basicwidget/basicwidgetdraw"
@@ -95,13 +95,24 @@ type virtualScrollPanel struct {
 	// Vertical scroll animation state. vAnimCount > 0 means an animation
 	// is in flight; it counts down from scrollAnimMaxCount() to 0. Each
 	// tick eases vAnimDelta into topItemOffset; the final tick snaps to
-	// (vAnimTargetIndex, vAnimTargetOffset).
+	// (vAnimTargetIndex, vAnimTargetOffset), or applies the rest of
+	// vAnimDelta when vAnimByDelta.
 	vAnimTargetIndex  int
 	vAnimTargetOffset int
 	vAnimDelta        int
 	vAnimAppliedDelta int
 	vAnimCount        int
 
+	// vAnimByDelta reports whether the vertical animation is by a pixel delta
+	// without a target item, e.g. by the wheel with smooth scrolling.
+	vAnimByDelta bool
+
+	// Horizontal scroll animation state. hAnimCount > 0 means an animation
+	// is in flight, in the same way as vAnimCount.
+	hAnimStartX  float64
+	hAnimTargetX float64
+	hAnimCount   int
+
 	scrollHBarCount int
 	scrollVBarCount int
 
@@ -128,6 +139,14 @@ type virtualScrollPanel struct {
 	lastWheelX float64
 	lastWheelY float64
 
+	// minOffsetX is the minimum horizontal offset computed at the most recent layout.
+	minOffsetX float64
+
+	// reachesBottom reports whether the last item's bottom edge was within
+	// the viewport at the most recent [virtualScrollPanel.layoutTopItem].
+	// This is used to hand wheel input over to an outer panel (scroll chaining).
+	reachesBottom bool
+
 	onceDraw bool
 }
 
@@ -149,6 +168,7 @@ func (p *virtualScrollPanel) scrollOffset() (float64, float64) {
 
 // forceSetScrollOffsetX sets the horizontal scroll offset.
 func (p *virtualScrollPanel) forceSetScrollOffsetX(x float64) {
+	p.hAnimCount = 0
 	if p.offsetX == x {
 		return
 	}
@@ -167,9 +187,10 @@ func (p *virtualScrollPanel) forceSetScrollOffset(x, _ float64) {
 
 // forceSetScrollOffsetByDelta adjusts the horizontal offset by dx and the
 // vertical position by dy pixels, without animation. Direct user input (wheel)
-// cancels any in-flight vertical animation.
+// cancels any in-flight animation.
 func (p *virtualScrollPanel) forceSetScrollOffsetByDelta(dx, dy float64) {
 	if dx != 0 {
+		p.hAnimCount = 0
 		if p.nextOffsetXSet && p.nextOffsetXIsDelta {
 			p.nextOffsetX += dx
 		} else {
@@ -190,6 +211,48 @@ func (p *virtualScrollPanel) forceSetScrollOffsetByDelta(dx, dy float64) {
 	}
 }
 
+// setScrollOffsetByDelta animates the horizontal offset by dx and the
+// vertical position by dy pixels, used by the wheel with smooth scrolling.
+// The deltas are added to the rest of in-flight animations, so that
+// consecutive wheel events accumulate like [panel.SetScrollOffsetByDelta].
+// Falls back to an instant change before the first Draw.
+func (p *virtualScrollPanel) setScrollOffsetByDelta(dx, dy float64) {
+	if !p.onceDraw {
+		p.forceSetScrollOffsetByDelta(dx, dy)
+		return
+	}
+	if dx != 0 {
+		target := p.offsetX
+		if p.hAnimCount > 0 {
+			target = p.hAnimTargetX
+		}
+		// Animation supersedes any pending instant change.
+		p.nextOffsetXSet = false
+		p.nextOffsetXIsDelta = false
+		p.nextOffsetX = 0
+		p.hAnimStartX = p.offsetX
+		p.hAnimTargetX = min(max(target+dx, p.minOffsetX), 0)
+		p.hAnimCount = scrollAnimMaxCount()
+	}
+	if dy != 0 {
+		var rest int
+		if p.vAnimCount > 0 {
+			rest = p.vAnimDelta - p.vAnimAppliedDelta
+		}
+		// Animation supersedes any pending instant change.
+		p.nextTopItemSet = false
+		p.nextTopItemIsDelta = false
+		p.nextDeltaY = 0
+		p.nextTopItemIndex = 0
+		p.nextTopItemOffset = 0
+		// A positive dy increases topItemOffset, while the animation subtracts vAnimDelta.
+		p.vAnimDelta = rest - int(math.Round(dy))
+		p.vAnimAppliedDelta = 0
+		p.vAnimByDelta = true
+		p.vAnimCount = scrollAnimMaxCount()
+	}
+}
+
 // setTopItem animates the vertical scroll position toward the given item
 // index and offset. Falls back to an instant set when no item-height
 // estimate is available yet, or before the first Draw.
@@ -203,7 +266,7 @@ func (p *virtualScrollPanel) setTopItem(index, offset int) {
 		p.nextTopItemOffset = offset
 		return
 	}
-	if p.vAnimCount > 0 && index == p.vAnimTargetIndex && offset == p.vAnimTargetOffset {
+	if p.vAnimCount > 0 && !p.vAnimByDelta && index == p.vAnimTargetIndex && offset == p.vAnimTargetOffset {
 		return
 	}
 	if index == p.topItemIndex && offset == p.topItemOffset {
@@ -231,9 +294,26 @@ func (p *virtualScrollPanel) setTopItem(index, offset int) {
 	p.vAnimTargetOffset = offset
 	p.vAnimDelta = targetScroll - currentScroll
 	p.vAnimAppliedDelta = 0
+	p.vAnimByDelta = false
 	p.vAnimCount = scrollAnimMaxCount()
 }
 
+// scrollByCursorNearEdges scrolls the content when the cursor is near the top or the bottom edge
+// of the visible bounds, e.g. while dragging items.
+func (p *virtualScrollPanel) scrollByCursorNearEdges(context *guigui.Context, visibleBounds image.Rectangle) {
+	_, y := guigui.CursorPosition()
+	var dy float64
+	if upperY := visibleBounds.Min.Y + UnitSize(context); y < upperY {
+		dy = float64(upperY-y) / 4
+	}
+	if lowerY := visibleBounds.Max.Y - UnitSize(context); y >= lowerY {
+		dy = float64(lowerY-y) / 4
+	}
+	if dy != 0 {
+		p.forceSetScrollOffsetByDelta(0, dy)
+	}
+}
+
 // topItem returns the current vertical scroll state.
 func (p *virtualScrollPanel) topItem() (int, int) {
 	return p.topItemIndex, p.topItemOffset
@@ -282,10 +362,10 @@ func (p *virtualScrollPanel) forceSetTopItem(index, offset int, cancelAnimation
 // For content without such effects, the wrapped function just returns the
 // real height.
 //
-// During a scroll animation the normalize walks substitute
-// estimatedItemHeight to avoid re-measuring every item the eased pixel
-// delta passes over (wrapped text shapes each line). The settling Layout
-// (vAnimCount == 0) goes through apparentItemHeight again.
+// During a scroll animation toward a target item the normalize walks
+// substitute estimatedItemHeight to avoid re-measuring every item the eased
+// pixel delta passes over (wrapped text shapes each line). The settling
+// Layout (vAnimCount == 0) goes through apparentItemHeight again.
 //
 // viewportInner is the content area height — panel bounds minus any padding
 // the content reserves (i.e. content.viewportPaddingY).
@@ -295,6 +375,7 @@ func (p *virtualScrollPanel) layoutTopItem(context *guigui.Context, viewportInne
 	offset = p.topItemOffset
 	if n == 0 {
 		idx, offset = 0, 0
+		p.reachesBottom = true
 		p.forceSetTopItem(idx, offset, false)
 		return
 	}
@@ -308,7 +389,9 @@ func (p *virtualScrollPanel) layoutTopItem(context *guigui.Context, viewportInne
 	}
 
 	measure := func(i int) int {
-		if p.vAnimCount > 0 && p.estimatedItemHeight > 0 {
+		// An animation by a pixel delta has no final snap to correct the
+		// estimation, so it uses the real heights like the instant scroll.
+		if p.vAnimCount > 0 && !p.vAnimByDelta && p.estimatedItemHeight > 0 {
 			return p.estimatedItemHeight
 		}
 		return apparentItemHeight(i)
@@ -341,6 +424,7 @@ func (p *virtualScrollPanel) layoutTopItem(context *guigui.Context, viewportInne
 			reachedEnd = true
 		}
 	}
+	p.reachesBottom = reachedEnd && y <= viewportInner
 	if reachedEnd {
 		if gap := viewportInner - y; gap > 0 {
 			offset += gap
@@ -435,19 +519,30 @@ func (p *virtualScrollPanel) Build(context *guigui.Context, adder *guigui.ChildA
 // applying vertical deltas to topItemOffset without virtual offset conversion.
 func (p *virtualScrollPanel) HandlePointingInput(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
 	// Handle scroll wheel.
+	p.lastWheelX = 0
+	p.lastWheelY = 0
 	if widgetBounds.IsHitAtCursor() {
-		wheelX, wheelY := adjustedWheel()
+		wheelX, wheelY := context.Wheel()
+		// Drop the wheel values in directions that can no longer scroll,
+		// so that an outer panel can scroll instead (scroll chaining).
+		if wheelX > 0 && p.offsetX >= 0 || wheelX < 0 && p.offsetX <= p.minOffsetX {
+			wheelX = 0
+		}
+		if wheelY > 0 && p.topItemIndex == 0 && p.topItemOffset >= 0 || wheelY < 0 && p.reachesBottom {
+			wheelY = 0
+		}
 		p.lastWheelX = wheelX
 		p.lastWheelY = wheelY
 		if wheelX != 0 || wheelY != 0 {
 			dx := wheelX * scrollWheelSpeed(context)
 			dy := wheelY * scrollWheelSpeed(context)
-			p.forceSetScrollOffsetByDelta(dx, dy)
+			if context.IsSmoothScrollingEnabled() {
+				p.setScrollOffsetByDelta(dx, dy)
+			} else {
+				p.forceSetScrollOffsetByDelta(dx, dy)
+			}
 			return guigui.HandleInputByWidget(p)
 		}
-	} else {
-		p.lastWheelX = 0
-		p.lastWheelY = 0
 	}
 
 	return guigui.HandleInputResult{}
@@ -472,8 +567,8 @@ func (p *virtualScrollPanel) Layout(context *guigui.Context, widgetBounds *guigu
 	}
 
 	// Adjust horizontal offset.
-	maxOffsetX := float64(min(bounds.Dx()-cw, 0))
-	p.offsetX = min(max(p.offsetX, maxOffsetX), 0)
+	p.minOffsetX = float64(min(bounds.Dx()-cw, 0))
+	p.offsetX = min(max(p.offsetX, p.minOffsetX), 0)
 
 	// Layout the content widget at the panel bounds with the horizontal offset.
 	// The content uses topItemIndex/topItemOffset to position items.
@@ -554,7 +649,7 @@ func (p *virtualScrollPanel) isHBarVisible(context *guigui.Context, widgetBounds
 	if !widgetBounds.IsHitAtCursor() {
 		return false
 	}
-	pt := image.Pt(ebiten.CursorPosition())
+	pt := image.Pt(guigui.CursorPosition())
 	return pt.In(p.horizontalBarBounds(context, widgetBounds))
 }
 
@@ -568,7 +663,7 @@ func (p *virtualScrollPanel) isVBarVisible(context *guigui.Context, widgetBounds
 	if !widgetBounds.IsHitAtCursor() {
 		return false
 	}
-	pt := image.Pt(ebiten.CursorPosition())
+	pt := image.Pt(guigui.CursorPosition())
 	return pt.In(p.verticalBarBounds(context, widgetBounds))
 }
 
@@ -596,8 +691,9 @@ func (p *virtualScrollPanel) Tick(context *guigui.Context, widgetBounds *guigui.
 	p.lastWheelY = 0
 
 	hChanged, vChanged := p.applyPendingScrollOffsetInTick()
-	if p.advanceScrollAnimation() {
-		vChanged = true
+	if h, v := p.advanceScrollAnimation(); h || v {
+		hChanged = hChanged || h
+		vChanged = vChanged || v
 	}
 	if hChanged && p.scrollHBar.isOnceDrawn() {
 		shouldShowHBar = true
@@ -638,7 +734,9 @@ func (p *virtualScrollPanel) Tick(context *guigui.Context, widgetBounds *guigui.
 	return nil
 }
 
-// advanceScrollAnimation advances the vertical scroll animation by one tick.
+// advanceScrollAnimation advances the scroll animations by one tick and
+// reports whether the horizontal and vertical positions changed, respectively.
+//
 // Each tick applies the eased increment of vAnimDelta to topItemOffset only;
 // topItemIndex is updated by the content's normalization between ticks using
 // real measured heights. This avoids visual jumps when items have
@@ -647,13 +745,38 @@ func (p *virtualScrollPanel) Tick(context *guigui.Context, widgetBounds *guigui.
 // not yet have advanced (or vice versa), producing a backward jump in the
 // rendered position. The final tick snaps (topItemIndex, topItemOffset) to
 // the exact target so any approximation in vAnimDelta (cross-index
-// animations using estH) lands cleanly.
-func (p *virtualScrollPanel) advanceScrollAnimation() bool {
+// animations using estH) lands cleanly. An animation by a pixel delta has
+// no target to snap to, and its final tick applies the rest of the delta.
+func (p *virtualScrollPanel) advanceScrollAnimation() (bool, bool) {
+	return p.advanceHScrollAnimation(), p.advanceVScrollAnimation()
+}
+
+func (p *virtualScrollPanel) advanceHScrollAnimation() bool {
+	if p.hAnimCount <= 0 {
+		return false
+	}
+	p.hAnimCount--
+	if p.hAnimCount <= 0 {
+		p.offsetX = p.hAnimTargetX
+		return true
+	}
+	max := scrollAnimMaxCount()
+	t := easeOutQuad(float64(max-p.hAnimCount) / float64(max))
+	p.offsetX = p.hAnimStartX + (p.hAnimTargetX-p.hAnimStartX)*t
+	return true
+}
+
+func (p *virtualScrollPanel) advanceVScrollAnimation() bool {
 	if p.vAnimCount <= 0 {
 		return false
 	}
 	p.vAnimCount--
 	if p.vAnimCount <= 0 {
+		if p.vAnimByDelta {
+			p.topItemOffset -= p.vAnimDelta - p.vAnimAppliedDelta
+			p.vAnimAppliedDelta = p.vAnimDelta
+			return true
+		}
 		p.topItemIndex = p.vAnimTargetIndex
 		p.topItemOffset = p.vAnimTargetOffset
 		return true
@@ -903,8 +1026,8 @@ func (s *virtualScrollVBar) HandlePointingInput(context *guigui.Context, widgetB
 	}
 	trackHeight := float64(bounds.Dy()) - 2*padding - barHeight
 
-	if !s.dragging && widgetBounds.IsHitAtCursor() && inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
-		x, y := ebiten.CursorPosition()
+	if !s.dragging && widgetBounds.IsHitAtCursor() && guigui.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
+		x, y := guigui.CursorPosition()
 		tb := s.thumbBounds
 		topIdx, topOff := s.panel.topItem()
 
@@ -938,12 +1061,12 @@ func (s *virtualScrollVBar) HandlePointingInput(context *guigui.Context, widgetB
 		}
 	}
 
-	if wheelX, wheelY := adjustedWheel(); wheelX != 0 || wheelY != 0 {
+	if wheelX, wheelY := context.Wheel(); wheelX != 0 || wheelY != 0 {
 		s.dragging = false
 	}
 
-	if s.dragging && ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
-		_, y := ebiten.CursorPosition()
+	if s.dragging && guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
+		_, y := guigui.CursorPosition()
 		dy := y - s.draggingStartPosition
 		if dy != 0 && trackHeight > 0 {
 			if s.panel.allHeightsMeasured {
@@ -1003,7 +1126,7 @@ func (s *virtualScrollVBar) HandlePointingInput(context *guigui.Context, widgetB
 		return guigui.HandleInputByWidget(s)
 	}
 
-	if s.dragging && !ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
+	if s.dragging && !guigui.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
 		s.dragging = false
 	}
 
@@ -271,3 +271,30 @@ func TestBottomFracIdx(t *testing.T) {
 		})
 	}
 }
+
+func TestVirtualScrollWheelAnimation(t *testing.T) {
+	testCases := []struct {
+		name   string
+		deltas []float64
+		want   int
+	}{
+		{name: "down", deltas: []float64{-30}, want: -30},
+		{name: "up", deltas: []float64{45}, want: 45},
+		{name: "accumulated", deltas: []float64{-30, 0, -30}, want: -60},
+		{name: "reversed", deltas: []float64{-30, 20}, want: -10},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			offsets := basicwidget.VirtualScrollWheelOffsets(tc.deltas, 60)
+			if got := offsets[len(offsets)-1]; got != tc.want {
+				t.Errorf("final offset: got %d, want %d (offsets: %v)", got, tc.want, offsets)
+			}
+			if tc.deltas[0] < 0 && offsets[0] >= 0 || tc.deltas[0] > 0 && offsets[0] <= 0 {
+				t.Errorf("the first tick should move toward the delta: %v", offsets)
+			}
+			if offsets[0] == tc.want && len(tc.deltas) == 1 {
+				t.Errorf("the offset should be animated: %v", offsets)
+			}
+		})
+	}
+}
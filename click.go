@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package guigui
+
+import (
+	"image"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Modifiers represents a set of modifier keys.
+type Modifiers int
+
+const (
+	// ModifierShift represents the Shift key.
+	ModifierShift Modifiers = 1 << iota
+
+	// ModifierControl represents the Control key.
+	ModifierControl
+
+	// ModifierAlt represents the Alt key, i.e. the Option key on macOS.
+	ModifierAlt
+
+	// ModifierMeta represents the Meta key, i.e. the Command key on macOS and the Windows key on Windows.
+	ModifierMeta
+)
+
+func currentModifiers() Modifiers {
+	var m Modifiers
+	if IsKeyPressed(ebiten.KeyShift) {
+		m |= ModifierShift
+	}
+	if IsKeyPressed(ebiten.KeyControl) {
+		m |= ModifierControl
+	}
+	if IsKeyPressed(ebiten.KeyAlt) {
+		m |= ModifierAlt
+	}
+	if IsKeyPressed(ebiten.KeyMeta) {
+		m |= ModifierMeta
+	}
+	return m
+}
+
+// Click represents a mouse button press recognized by Guigui.
+type Click struct {
+	// Button is the pressed mouse button.
+	Button ebiten.MouseButton
+
+	// Count is the number of consecutive clicks with the same button at almost the same position.
+	// Count is 1 for a single click, 2 for a double click, 3 for a triple click, and so on.
+	Count int
+
+	// Modifiers is the set of modifier keys pressed when the button was pressed.
+	Modifiers Modifiers
+
+	// Position is the cursor position in the screen coordinates when the button was pressed.
+	Position image.Point
+}
+
+// DoubleClickIntervalInTicks returns the maximum interval in ticks between two presses to be counted as consecutive clicks.
+func DoubleClickIntervalInTicks() int {
+	return ebiten.TPS() / 2
+}
+
+// clickSlop returns the maximum distance in pixels between two presses to be counted as consecutive clicks.
+func clickSlop(scale float64) int {
+	return int(4 * scale)
+}
+
+var clickButtons = [...]ebiten.MouseButton{
+	ebiten.MouseButtonLeft,
+	ebiten.MouseButtonRight,
+	ebiten.MouseButtonMiddle,
+}
+
+type clickRecognizer struct {
+	click       Click
+	clickTick   int64
+	justClicked bool
+}
+
+func (c *clickRecognizer) update(scale float64) {
+	c.justClicked = false
+	for _, button := range clickButtons {
+		if !IsMouseButtonJustPressed(button) {
+			continue
+		}
+		pos := image.Pt(CursorPosition())
+		tick := ebiten.Tick()
+		c.click = Click{
+			Button:    button,
+			Count:     nextClickCount(c.click, tick-c.clickTick, button, pos, int64(DoubleClickIntervalInTicks()), clickSlop(scale)),
+			Modifiers: currentModifiers(),
+			Position:  pos,
+		}
+		c.clickTick = tick
+		c.justClicked = true
+		return
+	}
+}
+
+// nextClickCount returns the click count of a press of the button at pos after the previous click prev.
+// elapsed is the number of ticks since the previous click.
+// The count is incremented if the press is within interval ticks and slop pixels from the previous click with the same button.
+func nextClickCount(prev Click, elapsed int64, button ebiten.MouseButton, pos image.Point, interval int64, slop int) int {
+	if prev.Count == 0 || prev.Button != button || elapsed >= interval {
+		return 1
+	}
+	d := pos.Sub(prev.Position)
+	if d.X < -slop || d.X > slop || d.Y < -slop || d.Y > slop {
+		return 1
+	}
+	return prev.Count + 1
+}
+
+// ClickAtCursor returns the click that has just started in the current tick
+// if the widget is hit at the cursor.
+//
+// ClickAtCursor is intended to be used in [Widget.HandlePointingInput].
+// Guigui counts consecutive clicks for the whole application, so widgets don't have to track the timing by themselves.
+func (w *WidgetBounds) ClickAtCursor() (Click, bool) {
+	r := &w.context.app.clickRecognizer
+	if !r.justClicked {
+		return Click{}, false
+	}
+	if !w.IsHitAtCursor() {
+		return Click{}, false
+	}
+	return r.click, true
+}
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package guigui_test
+
+import (
+	"image"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/guigui-gui/guigui"
+)
+
+func TestNextClickCount(t *testing.T) {
+	prev := guigui.Click{
+		Button:   ebiten.MouseButtonLeft,
+		Count:    1,
+		Position: image.Pt(100, 100),
+	}
+	const interval = 30
+	const slop = 4
+	testCases := []struct {
+		name    string
+		prev    guigui.Click
+		elapsed int64
+		button  ebiten.MouseButton
+		pos     image.Point
+		out     int
+	}{
+		{
+			name:    "first click",
+			prev:    guigui.Click{},
+			elapsed: 1,
+			button:  ebiten.MouseButtonLeft,
+			pos:     image.Pt(100, 100),
+			out:     1,
+		},
+		{
+			name:    "double click",
+			prev:    prev,
+			elapsed: 10,
+			button:  ebiten.MouseButtonLeft,
+			pos:     image.Pt(100, 100),
+			out:     2,
+		},
+		{
+			name: "triple click",
+			prev: guigui.Click{
+				Button:   ebiten.MouseButtonLeft,
+				Count:    2,
+				Position: image.Pt(100, 100),
+			},
+			elapsed: 10,
+			button:  ebiten.MouseButtonLeft,
+			pos:     image.Pt(101, 99),
+			out:     3,
+		},
+		{
+			name:    "within the slop",
+			prev:    prev,
+			elapsed: 10,
+			button:  ebiten.MouseButtonLeft,
+			pos:     image.Pt(104, 96),
+			out:     2,
+		},
+		{
+			name:    "out of the slop",
+			prev:    prev,
+			elapsed: 10,
+			button:  ebiten.MouseButtonLeft,
+			pos:     image.Pt(105, 100),
+			out:     1,
+		},
+		{
+			name:    "last tick of the interval",
+			prev:    prev,
+			elapsed: interval - 1,
+			button:  ebiten.MouseButtonLeft,
+			pos:     image.Pt(100, 100),
+			out:     2,
+		},
+		{
+			name:    "interval passed",
+			prev:    prev,
+			elapsed: interval,
+			button:  ebiten.MouseButtonLeft,
+			pos:     image.Pt(100, 100),
+			out:     1,
+		},
+		{
+			name:    "another button",
+			prev:    prev,
+			elapsed: 10,
+			button:  ebiten.MouseButtonRight,
+			pos:     image.Pt(100, 100),
+			out:     1,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := guigui.NextClickCount(tc.prev, tc.elapsed, tc.button, tc.pos, interval, slop); got != tc.out {
+				t.Errorf("got: %d, want: %d", got, tc.out)
+			}
+		})
+	}
+}
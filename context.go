@@ -49,12 +49,16 @@ type Context struct {
 	inBuild bool
 
 	appScaleMinus1       float64
+	wheelSpeedMinus1     float64
 	defaultColorWarnOnce sync.Once
 	locales              []language.Tag
 	allLocales           []language.Tag
 	frontLayer           int64
 	envSource            EnvSource
 
+	smoothScrollingEnabled   bool
+	appScaleShortcutsEnabled bool
+
 	defaultTickMethodCalled bool
 }
 
@@ -6,10 +6,11 @@ package main
 import (
 	"fmt"
 	"image"
-	"image/color"
 	"math/rand/v2"
 	"os"
 	"slices"
+	"sync"
+	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
 
@@ -17,64 +18,74 @@ import (
 	"github.com/guigui-gui/guigui/basicwidget"
 )
 
-const itemCount = 100000
-
-type itemWidget struct {
-	guigui.DefaultWidget
+const (
+	initialItemCount = 1000000
+	pageSize         = 1000
+)
 
-	text   basicwidget.Text
-	height int
+// dataSource is a data source of the list.
+// The items are loaded by pages asynchronously, and more items are appended when the list is scrolled to the end.
+type dataSource struct {
+	m            sync.Mutex
+	loadedPages  map[int]bool
+	loadingPages map[int]bool
 
-	layoutItems []guigui.LinearLayoutItem
+	itemHeightScales []int
 }
 
-func (w *itemWidget) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
-	adder.AddWidget(&w.text)
-	return nil
+func (d *dataSource) ItemCount() int {
+	return len(d.itemHeightScales)
 }
 
-func (w *itemWidget) layout(context *guigui.Context) guigui.LinearLayout {
-	w.layoutItems = slices.Delete(w.layoutItems, 0, len(w.layoutItems))
-	w.layoutItems = append(w.layoutItems, guigui.LinearLayoutItem{
-		Widget: &w.text,
-		Size:   guigui.FlexibleSize(1),
-	})
-	return guigui.LinearLayout{
-		Direction: guigui.LayoutDirectionHorizontal,
-		Items:     w.layoutItems,
-		Padding:   basicwidget.ListItemTextPadding(context),
-	}
-}
+func (d *dataSource) ItemAt(index int) (basicwidget.ListItem[int], bool) {
+	page := index / pageSize
 
-func (w *itemWidget) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
-	var clr color.Color
-	if v, ok := context.Env(w, basicwidget.EnvKeyListItemColorType); ok {
-		if ct, ok := v.(basicwidget.ListItemColorType); ok {
-			clr = ct.TextColor(context)
+	d.m.Lock()
+	defer d.m.Unlock()
+	if !d.loadedPages[page] {
+		if !d.loadingPages[page] {
+			if d.loadingPages == nil {
+				d.loadingPages = map[int]bool{}
+			}
+			d.loadingPages[page] = true
+			// Emulate a slow loading.
+			go func() {
+				time.Sleep(200 * time.Millisecond)
+				d.m.Lock()
+				defer d.m.Unlock()
+				delete(d.loadingPages, page)
+				if d.loadedPages == nil {
+					d.loadedPages = map[int]bool{}
+				}
+				d.loadedPages[page] = true
+			}()
 		}
+		return basicwidget.ListItem[int]{}, false
 	}
-	w.text.SetColor(clr)
-	w.layout(context).LayoutWidgets(context, widgetBounds.Bounds(), layouter)
-}
 
-func (w *itemWidget) Measure(context *guigui.Context, constraints guigui.Constraints) image.Point {
-	s := w.layout(context).Measure(context, constraints)
-	if w.height > 0 {
-		s.Y = w.height
-	}
-	return s
+	return basicwidget.ListItem[int]{
+		Text:  fmt.Sprintf("Item %d", index+1),
+		Value: index,
+	}, true
 }
 
-func (w *itemWidget) WriteStateKey(sw *guigui.StateKeyWriter) {
-	sw.WriteInt(w.height)
+// ItemHeightAt implements [basicwidget.ItemHeightProvider].
+func (d *dataSource) ItemHeightAt(context *guigui.Context, index int) int {
+	return basicwidget.UnitSize(context) * d.itemHeightScales[index]
 }
 
-func (w *itemWidget) SetValue(text string) {
-	w.text.SetValue(text)
+// IndexByValue implements [basicwidget.ValueIndexProvider].
+func (d *dataSource) IndexByValue(value int) int {
+	if value < 0 || value >= d.ItemCount() {
+		return -1
+	}
+	return value
 }
 
-func (w *itemWidget) SetHeight(height int) {
-	w.height = height
+func (d *dataSource) appendItems(count int) {
+	for range count {
+		d.itemHeightScales = append(d.itemHeightScales, 1+rand.IntN(5))
+	}
 }
 
 type Root struct {
@@ -85,34 +96,33 @@ type Root struct {
 	randomizeButton              basicwidget.Button
 	randomizeAboveViewportButton basicwidget.Button
 
-	itemWidgets guigui.WidgetSlice[*itemWidget]
-	items       []basicwidget.ListItem[int]
+	dataSource dataSource
 
-	itemHeightScales [itemCount]int
+	// heightsVersion is incremented when the item heights are changed.
+	heightsVersion int
 
 	layoutItems []guigui.LinearLayoutItem
 }
 
 func (r *Root) WriteStateKey(w *guigui.StateKeyWriter) {
-	// Build conditionally repopulates r.items when the slice is empty, so
-	// tracking its length is enough to trigger a rebuild when the Randomize
-	// handlers clear it.
-	w.WriteInt(len(r.items))
+	w.WriteInt(r.heightsVersion)
 }
 
 func (r *Root) randomizeHeights() {
-	for i := range itemCount {
-		r.itemHeightScales[i] = 1 + rand.IntN(5)
+	for i := range r.dataSource.itemHeightScales {
+		r.dataSource.itemHeightScales[i] = 1 + rand.IntN(5)
 	}
+	r.heightsVersion++
 }
 
 func (r *Root) randomizeHeightsAboveViewport() {
-	for i := range itemCount {
+	for i := range r.dataSource.itemHeightScales {
 		if r.list.Widget().IsItemInViewport(i) {
 			break
 		}
-		r.itemHeightScales[i] = 1 + rand.IntN(5)
+		r.dataSource.itemHeightScales[i] = 1 + rand.IntN(5)
 	}
+	r.heightsVersion++
 }
 
 func (r *Root) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
@@ -121,43 +131,22 @@ func (r *Root) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
 	adder.AddWidget(&r.randomizeButton)
 	adder.AddWidget(&r.randomizeAboveViewportButton)
 
-	u := basicwidget.UnitSize(context)
-
 	r.randomizeButton.SetText("Randomize Heights")
 	r.randomizeButton.OnUp(func(context *guigui.Context) {
 		r.randomizeHeights()
-		// Clear the items so that they will be recreated with new heights in the next Build.
-		// WriteStateKey covers len(r.items), so clearing triggers a rebuild automatically.
-		r.items = slices.Delete(r.items, 0, len(r.items))
 	})
 
 	r.randomizeAboveViewportButton.SetText("Randomize Heights Above Viewport")
 	r.randomizeAboveViewportButton.OnUp(func(context *guigui.Context) {
 		r.randomizeHeightsAboveViewport()
-		r.items = slices.Delete(r.items, 0, len(r.items))
 	})
 
-	r.itemWidgets.SetLen(itemCount)
-
-	// In usual cases, List.SetItems should be called every Build for simplicity.
-	// However, this might be a bottleneck when the number of items is huge, so only call it when necessary in this example.
-	if len(r.items) == 0 {
-		for i := range itemCount {
-			scale := r.itemHeightScales[i]
-			if scale == 0 {
-				scale = 1
-			}
-			w := r.itemWidgets.At(i)
-			w.SetValue(fmt.Sprintf("Item %d", i+1))
-			w.SetHeight(u * scale)
-			r.items = append(r.items, basicwidget.ListItem[int]{
-				Content: w,
-				Value:   i,
-			})
-		}
-		r.list.Widget().SetItems(r.items)
-	}
+	// The list queries only the items around the viewport, so a huge number of items doesn't matter.
+	r.list.Widget().SetDataSource(&r.dataSource)
 	r.list.Widget().SetStripeVisible(true)
+	r.list.Widget().OnEndReached(func(context *guigui.Context) {
+		r.dataSource.appendItems(pageSize)
+	})
 
 	return nil
 }
@@ -196,7 +185,7 @@ func (r *Root) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds
 
 func main() {
 	r := &Root{}
-	r.randomizeHeights()
+	r.dataSource.appendItems(initialItemCount)
 
 	op := &guigui.RunOptions{
 		Title:         "Big List",
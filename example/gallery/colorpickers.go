@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package main
+
+import (
+	"image/color"
+	"slices"
+
+	"github.com/guigui-gui/guigui"
+	"github.com/guigui-gui/guigui/basicwidget"
+)
+
+type ColorPickers struct {
+	guigui.DefaultWidget
+
+	form            basicwidget.Form
+	colorPickerText basicwidget.Text
+	colorPicker     basicwidget.ColorPicker
+	colorButtonText basicwidget.Text
+	colorButton     basicwidget.ColorButton
+
+	configForm         basicwidget.Form
+	alphaEnabledText   basicwidget.Text
+	alphaEnabledToggle basicwidget.Toggle
+	enabledText        basicwidget.Text
+	enabledToggle      basicwidget.Toggle
+
+	layoutItems []guigui.LinearLayoutItem
+}
+
+func (c *ColorPickers) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
+	adder.AddWidget(&c.form)
+	adder.AddWidget(&c.configForm)
+
+	v, ok := context.Env(c, modelKeyModel)
+	if !ok {
+		return nil
+	}
+	model := v.(*Model)
+
+	c.colorPickerText.SetValue("Color picker")
+	c.colorPicker.OnValueChanged(func(context *guigui.Context, value color.Color, committed bool) {
+		model.ColorPickers().SetColor(value)
+	})
+	c.colorPicker.SetAlphaEnabled(model.ColorPickers().AlphaEnabled())
+	c.colorPicker.SetValue(model.ColorPickers().Color())
+	context.SetEnabled(&c.colorPicker, model.ColorPickers().Enabled())
+
+	c.colorButtonText.SetValue("Color button")
+	c.colorButton.OnValueChanged(func(context *guigui.Context, value color.Color, committed bool) {
+		model.ColorPickers().SetColor(value)
+	})
+	c.colorButton.ColorPicker().SetAlphaEnabled(model.ColorPickers().AlphaEnabled())
+	c.colorButton.SetValue(model.ColorPickers().Color())
+	context.SetEnabled(&c.colorButton, model.ColorPickers().Enabled())
+
+	c.form.SetItems([]basicwidget.FormItem{
+		{
+			PrimaryWidget:   &c.colorPickerText,
+			SecondaryWidget: &c.colorPicker,
+		},
+		{
+			PrimaryWidget:   &c.colorButtonText,
+			SecondaryWidget: &c.colorButton,
+		},
+	})
+
+	// Configurations
+	c.alphaEnabledText.SetValue("Alpha")
+	c.alphaEnabledToggle.OnValueChanged(func(context *guigui.Context, value bool) {
+		model.ColorPickers().SetAlphaEnabled(value)
+	})
+	c.alphaEnabledToggle.SetValue(model.ColorPickers().AlphaEnabled())
+	c.enabledText.SetValue("Enabled")
+	c.enabledToggle.OnValueChanged(func(context *guigui.Context, value bool) {
+		model.ColorPickers().SetEnabled(value)
+	})
+	c.enabledToggle.SetValue(model.ColorPickers().Enabled())
+
+	c.configForm.SetItems([]basicwidget.FormItem{
+		{
+			PrimaryWidget:   &c.alphaEnabledText,
+			SecondaryWidget: &c.alphaEnabledToggle,
+		},
+		{
+			PrimaryWidget:   &c.enabledText,
+			SecondaryWidget: &c.enabledToggle,
+		},
+	})
+
+	return nil
+}
+
+func (c *ColorPickers) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
+	u := basicwidget.UnitSize(context)
+	c.layoutItems = slices.Delete(c.layoutItems, 0, len(c.layoutItems))
+	c.layoutItems = append(c.layoutItems,
+		guigui.LinearLayoutItem{
+			Widget: &c.form,
+		},
+		guigui.LinearLayoutItem{
+			Size: guigui.FlexibleSize(1),
+		},
+		guigui.LinearLayoutItem{
+			Widget: &c.configForm,
+		},
+	)
+	(guigui.LinearLayout{
+		Direction: guigui.LayoutDirectionVertical,
+		Items:     c.layoutItems,
+		Gap:       u / 2,
+		Padding: guigui.Padding{
+			Start:  u / 2,
+			Top:    u / 2,
+			End:    u / 2,
+			Bottom: u / 2,
+		},
+	}).LayoutWidgets(context, widgetBounds.Bounds(), layouter)
+}
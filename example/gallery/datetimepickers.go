@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package main
+
+import (
+	"slices"
+	"time"
+
+	"github.com/guigui-gui/guigui"
+	"github.com/guigui-gui/guigui/basicwidget"
+)
+
+type DateTimePickers struct {
+	guigui.DefaultWidget
+
+	form                basicwidget.Form
+	calendarText        basicwidget.Text
+	calendar            basicwidget.Calendar
+	datePickerText      basicwidget.Text
+	datePicker          guigui.WidgetWithSize[*basicwidget.DatePicker]
+	dateRangePickerText basicwidget.Text
+	dateRangePicker     guigui.WidgetWithSize[*basicwidget.DatePicker]
+	timePickerText      basicwidget.Text
+	timePicker          basicwidget.TimePicker
+
+	configForm    basicwidget.Form
+	enabledText   basicwidget.Text
+	enabledToggle basicwidget.Toggle
+
+	layoutItems []guigui.LinearLayoutItem
+}
+
+func (d *DateTimePickers) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
+	adder.AddWidget(&d.form)
+	adder.AddWidget(&d.configForm)
+
+	v, ok := context.Env(d, modelKeyModel)
+	if !ok {
+		return nil
+	}
+	model := v.(*Model)
+
+	u := basicwidget.UnitSize(context)
+	width := 8 * u
+	today := time.Now()
+	isWeekend := func(date time.Time) bool {
+		return date.Weekday() == time.Saturday || date.Weekday() == time.Sunday
+	}
+
+	d.calendarText.SetValue("Calendar (No weekends, within 90 days)")
+	d.calendar.OnValueChanged(func(context *guigui.Context, value time.Time) {
+		model.DateTimePickers().SetDate(value)
+	})
+	d.calendar.SetMinimumValue(today)
+	d.calendar.SetMaximumValue(today.AddDate(0, 0, 90))
+	d.calendar.SetDisabledDateFunc(isWeekend)
+	d.calendar.SetValue(model.DateTimePickers().Date())
+	context.SetEnabled(&d.calendar, model.DateTimePickers().Enabled())
+
+	d.datePickerText.SetValue("Date picker")
+	d.datePicker.Widget().OnValueChanged(func(context *guigui.Context, value time.Time) {
+		model.DateTimePickers().SetDate(value)
+	})
+	d.datePicker.Widget().SetValue(model.DateTimePickers().Date())
+	context.SetEnabled(&d.datePicker, model.DateTimePickers().Enabled())
+	d.datePicker.SetFixedWidth(width)
+
+	d.dateRangePickerText.SetValue("Date range picker")
+	d.dateRangePicker.Widget().OnValueRangeChanged(func(context *guigui.Context, start, end time.Time) {
+		model.DateTimePickers().SetDateRange(start, end)
+	})
+	d.dateRangePicker.Widget().SetRangeSelectionEnabled(true)
+	d.dateRangePicker.Widget().SetValueRange(model.DateTimePickers().DateRange())
+	context.SetEnabled(&d.dateRangePicker, model.DateTimePickers().Enabled())
+	d.dateRangePicker.SetFixedWidth(width + 4*u)
+
+	d.timePickerText.SetValue("Time picker")
+	d.timePicker.OnValueChanged(func(context *guigui.Context, hour, minute, second int, committed bool) {
+		model.DateTimePickers().SetTime(hour, minute, second)
+	})
+	d.timePicker.SetSecondsVisible(true)
+	d.timePicker.SetValue(model.DateTimePickers().Time())
+	context.SetEnabled(&d.timePicker, model.DateTimePickers().Enabled())
+
+	d.form.SetItems([]basicwidget.FormItem{
+		{
+			PrimaryWidget:   &d.calendarText,
+			SecondaryWidget: &d.calendar,
+		},
+		{
+			PrimaryWidget:   &d.datePickerText,
+			SecondaryWidget: &d.datePicker,
+		},
+		{
+			PrimaryWidget:   &d.dateRangePickerText,
+			SecondaryWidget: &d.dateRangePicker,
+		},
+		{
+			PrimaryWidget:   &d.timePickerText,
+			SecondaryWidget: &d.timePicker,
+		},
+	})
+
+	// Configurations
+	d.enabledText.SetValue("Enabled")
+	d.enabledToggle.OnValueChanged(func(context *guigui.Context, value bool) {
+		model.DateTimePickers().SetEnabled(value)
+	})
+	d.enabledToggle.SetValue(model.DateTimePickers().Enabled())
+
+	d.configForm.SetItems([]basicwidget.FormItem{
+		{
+			PrimaryWidget:   &d.enabledText,
+			SecondaryWidget: &d.enabledToggle,
+		},
+	})
+
+	return nil
+}
+
+func (d *DateTimePickers) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
+	u := basicwidget.UnitSize(context)
+	d.layoutItems = slices.Delete(d.layoutItems, 0, len(d.layoutItems))
+	d.layoutItems = append(d.layoutItems,
+		guigui.LinearLayoutItem{
+			Widget: &d.form,
+		},
+		guigui.LinearLayoutItem{
+			Size: guigui.FlexibleSize(1),
+		},
+		guigui.LinearLayoutItem{
+			Widget: &d.configForm,
+		},
+	)
+	(guigui.LinearLayout{
+		Direction: guigui.LayoutDirectionVertical,
+		Items:     d.layoutItems,
+		Gap:       u / 2,
+		Padding: guigui.Padding{
+			Start:  u / 2,
+			Top:    u / 2,
+			End:    u / 2,
+			Bottom: u / 2,
+		},
+	}).LayoutWidgets(context, widgetBounds.Bounds(), layouter)
+}
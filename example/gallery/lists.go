@@ -4,8 +4,12 @@
 package main
 
 import (
+	"fmt"
+	"image/color"
 	"slices"
 
+	"github.com/hajimehoshi/ebiten/v2"
+
 	"github.com/guigui-gui/guigui"
 	"github.com/guigui-gui/guigui/basicwidget"
 )
@@ -18,7 +22,9 @@ type Lists struct {
 	listText      basicwidget.Text
 	list          guigui.WidgetWithSize[*basicwidget.List[int]]
 	treeText      basicwidget.Text
-	tree          guigui.WidgetWithSize[*basicwidget.List[int]]
+	tree          guigui.WidgetWithSize[*basicwidget.TreeView[int]]
+	gridText      basicwidget.Text
+	grid          guigui.WidgetWithSize[*basicwidget.GridView[int]]
 
 	jumpForm         basicwidget.Form
 	indexText        basicwidget.Text
@@ -34,13 +40,18 @@ type Lists struct {
 	showFooterToggle     basicwidget.Toggle
 	multiSelectionText   basicwidget.Text
 	multiSelectionToggle basicwidget.Toggle
+	checkboxText         basicwidget.Text
+	checkboxToggle       basicwidget.Toggle
 	movableText          basicwidget.Text
 	movableToggle        basicwidget.Toggle
 	enabledText          basicwidget.Text
 	enabledToggle        basicwidget.Toggle
 
-	listItems []basicwidget.ListItem[int]
-	treeItems []basicwidget.ListItem[int]
+	listItems  []basicwidget.ListItem[int]
+	gridItems  []basicwidget.GridViewItem[int]
+	gridImages []*ebiten.Image
+
+	onTreeNodeLoaded func(value int)
 
 	layoutItems []guigui.LinearLayoutItem
 }
@@ -84,11 +95,15 @@ func (l *Lists) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
 		}
 		list.SelectItemsByIndices(indices)
 	})
+	list.OnItemCheckToggled(func(context *guigui.Context, index int, checked bool) {
+		model.Lists().SetListItemChecked(index, checked)
+	})
 
 	l.listItems = slices.Delete(l.listItems, 0, len(l.listItems))
 	l.listItems = model.Lists().AppendListItems(l.listItems)
 	list.SetItems(l.listItems)
 	list.SetMultiSelection(model.Lists().MultiSelection())
+	list.SetCheckboxSelection(model.Lists().CheckboxSelection())
 	context.SetEnabled(&l.list, model.Lists().Enabled())
 	l.list.SetFixedHeight(6 * u)
 
@@ -96,27 +111,57 @@ func (l *Lists) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
 	l.treeText.SetValue("Tree view")
 	tree := l.tree.Widget()
 	tree.SetStripeVisible(model.Lists().IsStripeVisible())
-	if model.Lists().IsHeaderVisible() {
-		tree.SetHeaderHeight(u)
-	} else {
-		tree.SetHeaderHeight(0)
-	}
-	if model.Lists().IsFooterVisible() {
-		tree.SetFooterHeight(u)
-	} else {
-		tree.SetFooterHeight(0)
+	tree.SetDataSource(model.Lists().TreeDataSource())
+	if l.onTreeNodeLoaded == nil {
+		// The tree view is captured here, as the function is called from another goroutine.
+		l.onTreeNodeLoaded = func(value int) {
+			tree.RefreshNode(value)
+		}
 	}
-	tree.OnItemExpanderToggled(func(context *guigui.Context, index int, expanded bool) {
-		model.Lists().SetTreeItemExpanded(index, expanded)
-	})
-
-	l.treeItems = slices.Delete(l.treeItems, 0, len(l.treeItems))
-	l.treeItems = model.Lists().AppendTreeItems(l.treeItems)
-	tree.SetItems(l.treeItems)
-	tree.SetMultiSelection(model.Lists().MultiSelection())
+	model.Lists().OnTreeNodeLoaded(l.onTreeNodeLoaded)
 	context.SetEnabled(&l.tree, model.Lists().Enabled())
 	l.tree.SetFixedHeight(6 * u)
 
+	// Grid
+	l.gridText.SetValue("Grid view")
+	if l.gridImages == nil {
+		for _, clr := range []color.RGBA{
+			{0xe5, 0x73, 0x73, 0xff},
+			{0xff, 0xb7, 0x4d, 0xff},
+			{0xff, 0xf1, 0x76, 0xff},
+			{0x81, 0xc7, 0x84, 0xff},
+			{0x4f, 0xc3, 0xf7, 0xff},
+			{0x95, 0x75, 0xcd, 0xff},
+		} {
+			img := ebiten.NewImage(16, 16)
+			img.Fill(clr)
+			l.gridImages = append(l.gridImages, img)
+		}
+	}
+	grid := l.grid.Widget()
+	grid.OnItemsMoved(func(context *guigui.Context, from, count, to int) {
+		idx := model.Lists().MoveGridItems(from, count, to)
+		var indices []int
+		for i := range count {
+			indices = append(indices, idx+i)
+		}
+		grid.SelectItemsByIndices(indices)
+	})
+	l.gridItems = slices.Delete(l.gridItems, 0, len(l.gridItems))
+	for _, v := range model.Lists().GridValues() {
+		l.gridItems = append(l.gridItems, basicwidget.GridViewItem[int]{
+			Image:   l.gridImages[v%len(l.gridImages)],
+			Text:    fmt.Sprintf("Item %d", v+1),
+			Movable: model.Lists().Movable(),
+			Value:   v,
+		})
+	}
+	grid.SetItems(l.gridItems)
+	grid.SetItemWidth(3 * u)
+	grid.SetMultiSelection(model.Lists().MultiSelection())
+	context.SetEnabled(&l.grid, model.Lists().Enabled())
+	l.grid.SetFixedHeight(8 * u)
+
 	l.listForm.SetItems([]basicwidget.FormItem{
 		{
 			PrimaryWidget:   &l.listText,
@@ -126,6 +171,10 @@ func (l *Lists) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
 			PrimaryWidget:   &l.treeText,
 			SecondaryWidget: &l.tree,
 		},
+		{
+			PrimaryWidget:   &l.gridText,
+			SecondaryWidget: &l.grid,
+		},
 	})
 
 	// Jump to index
@@ -169,9 +218,13 @@ func (l *Lists) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
 	l.multiSelectionToggle.OnValueChanged(func(context *guigui.Context, value bool) {
 		model.Lists().SetMultiSelection(value)
 		list.SetMultiSelection(value)
-		// Tree does not support multi-selection for now (or at least unrelated for this task)
 	})
 	l.multiSelectionToggle.SetValue(model.Lists().MultiSelection())
+	l.checkboxText.SetValue("Checkboxes")
+	l.checkboxToggle.OnValueChanged(func(context *guigui.Context, value bool) {
+		model.Lists().SetCheckboxSelection(value)
+	})
+	l.checkboxToggle.SetValue(model.Lists().CheckboxSelection())
 	l.movableText.SetValue("Enable to move items")
 	l.movableToggle.SetValue(model.Lists().Movable())
 	l.movableToggle.OnValueChanged(func(context *guigui.Context, value bool) {
@@ -200,6 +253,10 @@ func (l *Lists) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
 			PrimaryWidget:   &l.multiSelectionText,
 			SecondaryWidget: &l.multiSelectionToggle,
 		},
+		{
+			PrimaryWidget:   &l.checkboxText,
+			SecondaryWidget: &l.checkboxToggle,
+		},
 		{
 			PrimaryWidget:   &l.movableText,
 			SecondaryWidget: &l.movableToggle,
@@ -35,9 +35,13 @@ type Root struct {
 	textInputs        TextInputs
 	numberInputs      NumberInputs
 	sliders           Sliders
+	progressBars      ProgressBars
 	lists             Lists
 	selects           Selects
 	comboboxes        Comboboxes
+	dateTimePickers   DateTimePickers
+	colorPickers      ColorPickers
+	tabViews          TabViews
 	tables            Tables
 	popups            Popups
 	tooltips          TooltipAreas
@@ -78,12 +82,20 @@ func (r *Root) contentWidget() guigui.Widget {
 		return &r.numberInputs
 	case "sliders":
 		return &r.sliders
+	case "progressbars":
+		return &r.progressBars
 	case "lists":
 		return &r.lists
 	case "selects":
 		return &r.selects
 	case "comboboxes":
 		return &r.comboboxes
+	case "datetimepickers":
+		return &r.dateTimePickers
+	case "colorpickers":
+		return &r.colorPickers
+	case "tabviews":
+		return &r.tabViews
 	case "tables":
 		return &r.tables
 	case "popups":
@@ -4,10 +4,14 @@
 package main
 
 import (
+	"errors"
 	"fmt"
+	"image/color"
 	"iter"
 	"math/big"
 	"slices"
+	"sync"
+	"time"
 
 	"github.com/guigui-gui/guigui/basicwidget"
 )
@@ -23,9 +27,13 @@ type Model struct {
 	textInputs        TextInputsModel
 	numberInputs      NumberInputsModel
 	sliders           SlidersModel
+	progressBars      ProgressBarsModel
 	lists             ListsModel
 	selects           SelectsModel
 	comboboxes        ComboboxesModel
+	dateTimePickers   DateTimePickersModel
+	colorPickers      ColorPickersModel
+	tabViews          TabViewsModel
 	tables            TablesModel
 	popups            PopupsModel
 }
@@ -73,10 +81,26 @@ func (m *Model) Sliders() *SlidersModel {
 	return &m.sliders
 }
 
+func (m *Model) ProgressBars() *ProgressBarsModel {
+	return &m.progressBars
+}
+
 func (m *Model) Lists() *ListsModel {
 	return &m.lists
 }
 
+func (m *Model) DateTimePickers() *DateTimePickersModel {
+	return &m.dateTimePickers
+}
+
+func (m *Model) ColorPickers() *ColorPickersModel {
+	return &m.colorPickers
+}
+
+func (m *Model) TabViews() *TabViewsModel {
+	return &m.tabViews
+}
+
 func (m *Model) Selects() *SelectsModel {
 	return &m.selects
 }
@@ -369,6 +393,7 @@ type NumberInputsModel struct {
 	numberInputValue1 big.Int
 	numberInputValue2 uint64
 	numberInputValue3 int
+	numberInputValue4 float64
 
 	uneditable bool
 	disabled   bool
@@ -422,8 +447,19 @@ func (n *NumberInputsModel) SetNumberInputValue3(value int) {
 	n.numberInputValue3 = value
 }
 
+func (n *NumberInputsModel) NumberInputValue4() float64 {
+	return n.numberInputValue4
+}
+
+func (n *NumberInputsModel) SetNumberInputValue4(value float64) {
+	n.numberInputValue4 = value
+}
+
 type SlidersModel struct {
 	sliderValue int
+	rangeLow    int
+	rangeHigh   int
+	rangeSet    bool
 	disabled    bool
 }
 
@@ -443,14 +479,29 @@ func (s *SlidersModel) SetSliderValue(value int) {
 	s.sliderValue = value
 }
 
+func (s *SlidersModel) SliderRange() (low, high int) {
+	if !s.rangeSet {
+		return -50, 50
+	}
+	return s.rangeLow, s.rangeHigh
+}
+
+func (s *SlidersModel) SetSliderRange(low, high int) {
+	s.rangeLow = low
+	s.rangeHigh = high
+	s.rangeSet = true
+}
+
 type ListsModel struct {
-	listItems []basicwidget.ListItem[int]
-	treeItems []basicwidget.ListItem[int]
+	listItems      []basicwidget.ListItem[int]
+	treeDataSource treeDataSource
+	gridValues     []int
 
 	stripeVisible  bool
 	headerVisible  bool
 	footerVisible  bool
 	multiSelection bool
+	checkbox       bool
 	unmovable      bool
 	disabled       bool
 }
@@ -479,42 +530,48 @@ func (l *ListsModel) ListItemCount() int {
 	return len(l.listItems)
 }
 
-func (l *ListsModel) ensureTreeItems() {
-	if l.treeItems != nil {
-		return
-	}
-	l.treeItems = []basicwidget.ListItem[int]{
-		{Text: "Item 1", Value: 1, IndentLevel: 1},
-		{Text: "Item 2", Value: 2, IndentLevel: 1},
-		{Text: "Item 3", Value: 3, IndentLevel: 2},
-		{Text: "Item 4", Value: 4, IndentLevel: 2},
-		{Text: "Item 5", Value: 5, IndentLevel: 3},
-		{Text: "Item 6", Value: 6, IndentLevel: 3},
-		{Text: "Item 7", Value: 7, IndentLevel: 1},
-		{Text: "Item 8", Value: 8, IndentLevel: 2},
-		{Text: "Item 9", Value: 9, IndentLevel: 2},
-		{Text: "Item 10", Value: 10, IndentLevel: 3},
-		{Text: "Item 11", Value: 11, IndentLevel: 3},
-		{Text: "Item 12", Value: 12, IndentLevel: 1},
-	}
+// TreeDataSource returns the data source of the tree view.
+func (l *ListsModel) TreeDataSource() basicwidget.TreeViewDataSource[int] {
+	return &l.treeDataSource
 }
 
-func (l *ListsModel) AppendTreeItems(items []basicwidget.ListItem[int]) []basicwidget.ListItem[int] {
-	l.ensureTreeItems()
-	// TODO: Enable to move items.
-	return append(items, l.treeItems...)
+// OnTreeNodeLoaded sets the function that is called when the child nodes of a tree node are loaded.
+// f is called from the goroutine loading the child nodes.
+func (l *ListsModel) OnTreeNodeLoaded(f func(value int)) {
+	l.treeDataSource.m.Lock()
+	defer l.treeDataSource.m.Unlock()
+	l.treeDataSource.onLoaded = f
+}
+
+func (l *ListsModel) SetListItemChecked(index int, checked bool) {
+	if index < 0 || index >= len(l.listItems) {
+		return
+	}
+	l.listItems[index].Checked = checked
 }
 
 func (l *ListsModel) MoveListItems(from int, count int, to int) int {
 	return basicwidget.MoveItemsInSlice(l.listItems, from, count, to)
 }
 
-func (l *ListsModel) SetTreeItemExpanded(index int, expanded bool) {
-	l.ensureTreeItems()
-	if index < 0 || index >= len(l.treeItems) {
+func (l *ListsModel) ensureGridValues() {
+	if l.gridValues != nil {
 		return
 	}
-	l.treeItems[index].Collapsed = !expanded
+	for i := range 10000 {
+		l.gridValues = append(l.gridValues, i)
+	}
+}
+
+// GridValues returns the values of the grid view items in the displayed order.
+func (l *ListsModel) GridValues() []int {
+	l.ensureGridValues()
+	return l.gridValues
+}
+
+func (l *ListsModel) MoveGridItems(from int, count int, to int) int {
+	l.ensureGridValues()
+	return basicwidget.MoveItemsInSlice(l.gridValues, from, count, to)
 }
 
 func (l *ListsModel) IsStripeVisible() bool {
@@ -549,6 +606,14 @@ func (l *ListsModel) SetMultiSelection(multi bool) {
 	l.multiSelection = multi
 }
 
+func (l *ListsModel) CheckboxSelection() bool {
+	return l.checkbox
+}
+
+func (l *ListsModel) SetCheckboxSelection(checkbox bool) {
+	l.checkbox = checkbox
+}
+
 func (l *ListsModel) Movable() bool {
 	return !l.unmovable
 }
@@ -566,7 +631,8 @@ func (l *ListsModel) SetEnabled(enabled bool) {
 }
 
 type SelectsModel struct {
-	selectItems []basicwidget.SelectItem[int]
+	selectItems           []basicwidget.SelectItem[int]
+	filterableSelectItems []basicwidget.SelectItem[int]
 
 	disabled bool
 }
@@ -583,6 +649,24 @@ func (s *SelectsModel) AppendSelectItems(items []basicwidget.SelectItem[int]) []
 	return append(items, s.selectItems...)
 }
 
+func (s *SelectsModel) AppendFilterableSelectItems(items []basicwidget.SelectItem[int]) []basicwidget.SelectItem[int] {
+	if s.filterableSelectItems == nil {
+		for i, name := range []string{
+			"Argentina", "Australia", "Austria", "Belgium", "Brazil", "Canada", "Chile", "China",
+			"Côte d'Ivoire", "Denmark", "Egypt", "Finland", "France", "Germany", "Greece", "Iceland",
+			"India", "Indonesia", "Ireland", "Italy", "Japan", "Kenya", "Mexico", "Netherlands",
+			"New Zealand", "Norway", "Peru", "Poland", "Portugal", "Réunion", "Spain", "Sweden",
+			"Switzerland", "Thailand", "Türkiye", "United Kingdom", "United States", "Vietnam",
+		} {
+			s.filterableSelectItems = append(s.filterableSelectItems, basicwidget.SelectItem[int]{
+				Text:  name,
+				Value: i,
+			})
+		}
+	}
+	return append(items, s.filterableSelectItems...)
+}
+
 func (s *SelectsModel) Enabled() bool {
 	return !s.disabled
 }
@@ -626,18 +710,21 @@ func (c *ComboboxesModel) SetEnabled(enabled bool) {
 }
 
 type TableItem struct {
-	ID     int
-	Name   string
-	Amount int
-	Cost   int
+	ID       int
+	Name     string
+	Amount   int
+	Cost     int
+	Category string
 }
 
 type TablesModel struct {
 	tableItems []TableItem
 
-	footerVisible bool
-	unmovable     bool
-	disabled      bool
+	footerVisible     bool
+	unmovable         bool
+	disabled          bool
+	firstColumnFrozen bool
+	grouped           bool
 }
 
 func (t *TablesModel) ensureTableItems() {
@@ -645,16 +732,16 @@ func (t *TablesModel) ensureTableItems() {
 		return
 	}
 	t.tableItems = []TableItem{
-		{ID: 1, Name: "Apple", Amount: 3, Cost: 120},
-		{ID: 2, Name: "Banana", Amount: 6, Cost: 50},
-		{ID: 3, Name: "Cherry", Amount: 15, Cost: 200},
-		{ID: 4, Name: "Grape", Amount: 10, Cost: 175},
-		{ID: 5, Name: "Mango", Amount: 2, Cost: 250},
-		{ID: 6, Name: "Orange", Amount: 4, Cost: 110},
-		{ID: 7, Name: "Kiwi", Amount: 5, Cost: 160},
-		{ID: 8, Name: "Peach", Amount: 3, Cost: 180},
-		{ID: 9, Name: "Lemon", Amount: 7, Cost: 90},
-		{ID: 10, Name: "Pineapple", Amount: 1, Cost: 300},
+		{ID: 1, Name: "Apple", Amount: 3, Cost: 120, Category: "Pome"},
+		{ID: 2, Name: "Banana", Amount: 6, Cost: 50, Category: "Tropical"},
+		{ID: 3, Name: "Cherry", Amount: 15, Cost: 200, Category: "Stone"},
+		{ID: 4, Name: "Grape", Amount: 10, Cost: 175, Category: "Berry"},
+		{ID: 5, Name: "Mango", Amount: 2, Cost: 250, Category: "Tropical"},
+		{ID: 6, Name: "Orange", Amount: 4, Cost: 110, Category: "Citrus"},
+		{ID: 7, Name: "Kiwi", Amount: 5, Cost: 160, Category: "Berry"},
+		{ID: 8, Name: "Peach", Amount: 3, Cost: 180, Category: "Stone"},
+		{ID: 9, Name: "Lemon", Amount: 7, Cost: 90, Category: "Citrus"},
+		{ID: 10, Name: "Pineapple", Amount: 1, Cost: 300, Category: "Tropical"},
 	}
 }
 
@@ -673,6 +760,38 @@ func (t *TablesModel) MoveTableItems(from int, count int, to int) int {
 	return basicwidget.MoveItemsInSlice(t.tableItems, from, count, to)
 }
 
+// SetTableItemName sets the name of the item with the ID.
+func (t *TablesModel) SetTableItemName(id int, name string) error {
+	t.ensureTableItems()
+	if name == "" {
+		return errors.New("name must not be empty")
+	}
+	idx := slices.IndexFunc(t.tableItems, func(item TableItem) bool {
+		return item.ID == id
+	})
+	if idx < 0 {
+		return fmt.Errorf("item %d not found", id)
+	}
+	t.tableItems[idx].Name = name
+	return nil
+}
+
+// SetTableItemAmount sets the amount of the item with the ID.
+func (t *TablesModel) SetTableItemAmount(id int, amount int) error {
+	t.ensureTableItems()
+	if amount < 0 {
+		return errors.New("amount must not be negative")
+	}
+	idx := slices.IndexFunc(t.tableItems, func(item TableItem) bool {
+		return item.ID == id
+	})
+	if idx < 0 {
+		return fmt.Errorf("item %d not found", id)
+	}
+	t.tableItems[idx].Amount = amount
+	return nil
+}
+
 func (t *TablesModel) IsFooterVisible() bool {
 	return t.footerVisible
 }
@@ -697,6 +816,22 @@ func (t *TablesModel) SetEnabled(enabled bool) {
 	t.disabled = !enabled
 }
 
+func (t *TablesModel) FirstColumnFrozen() bool {
+	return t.firstColumnFrozen
+}
+
+func (t *TablesModel) SetFirstColumnFrozen(frozen bool) {
+	t.firstColumnFrozen = frozen
+}
+
+func (t *TablesModel) Grouped() bool {
+	return t.grouped
+}
+
+func (t *TablesModel) SetGrouped(grouped bool) {
+	t.grouped = grouped
+}
+
 type PopupsModel struct {
 	modeless bool
 }
@@ -708,3 +843,261 @@ func (p *PopupsModel) Modal() bool {
 func (p *PopupsModel) SetModal(modal bool) {
 	p.modeless = !modal
 }
+
+type DateTimePickersModel struct {
+	date       time.Time
+	rangeStart time.Time
+	rangeEnd   time.Time
+	hour       int
+	minute     int
+	second     int
+	disabled   bool
+}
+
+func (d *DateTimePickersModel) Enabled() bool {
+	return !d.disabled
+}
+
+func (d *DateTimePickersModel) SetEnabled(enabled bool) {
+	d.disabled = !enabled
+}
+
+func (d *DateTimePickersModel) Date() time.Time {
+	return d.date
+}
+
+func (d *DateTimePickersModel) SetDate(date time.Time) {
+	d.date = date
+}
+
+func (d *DateTimePickersModel) DateRange() (start, end time.Time) {
+	return d.rangeStart, d.rangeEnd
+}
+
+func (d *DateTimePickersModel) SetDateRange(start, end time.Time) {
+	d.rangeStart = start
+	d.rangeEnd = end
+}
+
+func (d *DateTimePickersModel) Time() (hour, minute, second int) {
+	return d.hour, d.minute, d.second
+}
+
+func (d *DateTimePickersModel) SetTime(hour, minute, second int) {
+	d.hour = hour
+	d.minute = minute
+	d.second = second
+}
+
+type ColorPickersModel struct {
+	color        color.Color
+	alphaEnabled bool
+	disabled     bool
+}
+
+func (c *ColorPickersModel) Enabled() bool {
+	return !c.disabled
+}
+
+func (c *ColorPickersModel) SetEnabled(enabled bool) {
+	c.disabled = !enabled
+}
+
+func (c *ColorPickersModel) AlphaEnabled() bool {
+	return c.alphaEnabled
+}
+
+func (c *ColorPickersModel) SetAlphaEnabled(enabled bool) {
+	c.alphaEnabled = enabled
+}
+
+func (c *ColorPickersModel) Color() color.Color {
+	if c.color == nil {
+		return color.NRGBA{R: 0x21, G: 0x96, B: 0xf3, A: 0xff}
+	}
+	return c.color
+}
+
+func (c *ColorPickersModel) SetColor(clr color.Color) {
+	c.color = clr
+}
+
+type TabViewsTab struct {
+	ID    int
+	Title string
+}
+
+type TabViewsModel struct {
+	tabs       []TabViewsTab
+	nextID     int
+	selectedID int
+	position   basicwidget.TabPosition
+	disabled   bool
+}
+
+func (t *TabViewsModel) ensureTabs() {
+	if t.nextID > 0 {
+		return
+	}
+	for range 5 {
+		t.AddTab()
+	}
+	t.selectedID = t.tabs[0].ID
+}
+
+func (t *TabViewsModel) Tabs() []TabViewsTab {
+	t.ensureTabs()
+	return t.tabs
+}
+
+// AddTab adds a new tab and returns its ID.
+func (t *TabViewsModel) AddTab() int {
+	t.nextID++
+	t.tabs = append(t.tabs, TabViewsTab{
+		ID:    t.nextID,
+		Title: fmt.Sprintf("Document %d", t.nextID),
+	})
+	return t.nextID
+}
+
+func (t *TabViewsModel) CloseTab(index int) {
+	t.ensureTabs()
+	if index < 0 || index >= len(t.tabs) {
+		return
+	}
+	t.tabs = slices.Delete(t.tabs, index, index+1)
+}
+
+func (t *TabViewsModel) MoveTabs(from int, count int, to int) int {
+	t.ensureTabs()
+	return basicwidget.MoveItemsInSlice(t.tabs, from, count, to)
+}
+
+func (t *TabViewsModel) SelectedID() int {
+	t.ensureTabs()
+	return t.selectedID
+}
+
+func (t *TabViewsModel) SetSelectedID(id int) {
+	t.selectedID = id
+}
+
+func (t *TabViewsModel) Position() basicwidget.TabPosition {
+	return t.position
+}
+
+func (t *TabViewsModel) SetPosition(position basicwidget.TabPosition) {
+	t.position = position
+}
+
+func (t *TabViewsModel) Enabled() bool {
+	return !t.disabled
+}
+
+func (t *TabViewsModel) SetEnabled(enabled bool) {
+	t.disabled = !enabled
+}
+
+type ProgressBarsModel struct {
+	value         int
+	valueSet      bool
+	indeterminate bool
+	disabled      bool
+}
+
+// Value returns the progress in percent.
+func (p *ProgressBarsModel) Value() int {
+	if !p.valueSet {
+		return 40
+	}
+	return p.value
+}
+
+func (p *ProgressBarsModel) SetValue(value int) {
+	p.value = value
+	p.valueSet = true
+}
+
+func (p *ProgressBarsModel) Indeterminate() bool {
+	return p.indeterminate
+}
+
+func (p *ProgressBarsModel) SetIndeterminate(indeterminate bool) {
+	p.indeterminate = indeterminate
+}
+
+func (p *ProgressBarsModel) Enabled() bool {
+	return !p.disabled
+}
+
+func (p *ProgressBarsModel) SetEnabled(enabled bool) {
+	p.disabled = !enabled
+}
+
+// treeChildren is the children of the tree nodes. The key 0 is for the root nodes.
+var treeChildren = map[int][]int{
+	0:  {1, 2, 7, 12},
+	2:  {3, 4},
+	4:  {5, 6},
+	7:  {8, 9},
+	9:  {10, 11},
+	12: {13, 14, 15},
+}
+
+// treeLazyNode is the node whose children are loaded asynchronously.
+const treeLazyNode = 12
+
+type treeDataSource struct {
+	m        sync.Mutex
+	loading  bool
+	loaded   bool
+	onLoaded func(value int)
+}
+
+func appendTreeNodes(nodes []basicwidget.TreeViewNode[int], values []int) []basicwidget.TreeViewNode[int] {
+	for _, v := range values {
+		text := fmt.Sprintf("Item %d", v)
+		if v == treeLazyNode {
+			text += " (Lazy)"
+		}
+		nodes = append(nodes, basicwidget.TreeViewNode[int]{
+			Text:  text,
+			Value: v,
+		})
+	}
+	return nodes
+}
+
+func (t *treeDataSource) AppendRootNodes(nodes []basicwidget.TreeViewNode[int]) []basicwidget.TreeViewNode[int] {
+	return appendTreeNodes(nodes, treeChildren[0])
+}
+
+func (t *treeDataSource) HasChildren(value int) bool {
+	return len(treeChildren[value]) > 0
+}
+
+func (t *treeDataSource) AppendChildNodes(nodes []basicwidget.TreeViewNode[int], value int) ([]basicwidget.TreeViewNode[int], bool) {
+	if value == treeLazyNode {
+		t.m.Lock()
+		defer t.m.Unlock()
+		if !t.loaded {
+			if !t.loading {
+				t.loading = true
+				// Emulate a slow loading.
+				go func() {
+					time.Sleep(time.Second)
+					t.m.Lock()
+					t.loading = false
+					t.loaded = true
+					f := t.onLoaded
+					t.m.Unlock()
+					if f != nil {
+						f(value)
+					}
+				}()
+			}
+			return nodes, false
+		}
+	}
+	return appendTreeNodes(nodes, treeChildren[value]), true
+}
@@ -22,6 +22,8 @@ type NumberInputs struct {
 	numberInput2     guigui.WidgetWithSize[*basicwidget.NumberInput]
 	numberInput3Text basicwidget.Text
 	numberInput3     guigui.WidgetWithSize[*basicwidget.NumberInput]
+	numberInput4Text basicwidget.Text
+	numberInput4     guigui.WidgetWithSize[*basicwidget.NumberInput]
 	configForm       basicwidget.Form
 	editableText     basicwidget.Text
 	editableToggle   basicwidget.Toggle
@@ -87,6 +89,23 @@ func (n *NumberInputs) Build(context *guigui.Context, adder *guigui.ChildAdder)
 	context.SetEnabled(&n.numberInput3, model.NumberInputs().Enabled())
 	n.numberInput3.SetFixedWidth(width)
 
+	n.numberInput4Text.SetValue("Number input (Decimal, Range: [0, 10000], Step: 0.25)")
+	n.numberInput4.Widget().OnValueChangedFloat64(func(context *guigui.Context, value float64, committed bool) {
+		if !committed {
+			return
+		}
+		model.NumberInputs().SetNumberInputValue4(value)
+	})
+	n.numberInput4.Widget().SetFractionDigits(2)
+	n.numberInput4.Widget().SetDigitGroupingEnabled(true)
+	n.numberInput4.Widget().SetMinimumValue(0)
+	n.numberInput4.Widget().SetMaximumValue(10000)
+	n.numberInput4.Widget().SetStepFloat64(0.25)
+	n.numberInput4.Widget().SetValueFloat64(model.NumberInputs().NumberInputValue4())
+	n.numberInput4.Widget().SetEditable(model.NumberInputs().Editable())
+	context.SetEnabled(&n.numberInput4, model.NumberInputs().Enabled())
+	n.numberInput4.SetFixedWidth(width)
+
 	n.numberInputForm.SetItems([]basicwidget.FormItem{
 		{
 			PrimaryWidget:   &n.numberInput1Text,
@@ -100,6 +119,10 @@ func (n *NumberInputs) Build(context *guigui.Context, adder *guigui.ChildAdder)
 			PrimaryWidget:   &n.numberInput3Text,
 			SecondaryWidget: &n.numberInput3,
 		},
+		{
+			PrimaryWidget:   &n.numberInput4Text,
+			SecondaryWidget: &n.numberInput4,
+		},
 	})
 
 	// Configurations
@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package main
+
+import (
+	"slices"
+
+	"github.com/guigui-gui/guigui"
+	"github.com/guigui-gui/guigui/basicwidget"
+	"github.com/guigui-gui/guigui/basicwidget/basicwidgetdraw"
+)
+
+type ProgressBars struct {
+	guigui.DefaultWidget
+
+	form                    basicwidget.Form
+	progressBarText         basicwidget.Text
+	progressBar             guigui.WidgetWithSize[*basicwidget.ProgressBar]
+	dangerProgressBarText   basicwidget.Text
+	dangerProgressBar       guigui.WidgetWithSize[*basicwidget.ProgressBar]
+	circularProgressBarText basicwidget.Text
+	circularProgressBar     basicwidget.ProgressBar
+	spinnerText             basicwidget.Text
+	spinner                 basicwidget.Spinner
+
+	configForm          basicwidget.Form
+	valueText           basicwidget.Text
+	valueSlider         basicwidget.Slider
+	indeterminateText   basicwidget.Text
+	indeterminateToggle basicwidget.Toggle
+	enabledText         basicwidget.Text
+	enabledToggle       basicwidget.Toggle
+
+	layoutItems []guigui.LinearLayoutItem
+}
+
+func (p *ProgressBars) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
+	adder.AddWidget(&p.form)
+	adder.AddWidget(&p.configForm)
+
+	v, ok := context.Env(p, modelKeyModel)
+	if !ok {
+		return nil
+	}
+	model := v.(*Model)
+
+	u := basicwidget.UnitSize(context)
+	value := float64(model.ProgressBars().Value()) / 100
+	indeterminate := model.ProgressBars().Indeterminate()
+	enabled := model.ProgressBars().Enabled()
+
+	p.progressBarText.SetValue("Progress bar")
+	p.progressBar.Widget().SetValue(value)
+	p.progressBar.Widget().SetIndeterminate(indeterminate)
+	p.progressBar.SetFixedWidth(6 * u)
+	context.SetEnabled(&p.progressBar, enabled)
+
+	p.dangerProgressBarText.SetValue("Progress bar (Danger)")
+	p.dangerProgressBar.Widget().SetValue(value)
+	p.dangerProgressBar.Widget().SetIndeterminate(indeterminate)
+	p.dangerProgressBar.Widget().SetSemanticColor(basicwidgetdraw.SemanticColorDanger)
+	p.dangerProgressBar.SetFixedWidth(6 * u)
+	context.SetEnabled(&p.dangerProgressBar, enabled)
+
+	p.circularProgressBarText.SetValue("Circular progress bar")
+	p.circularProgressBar.SetStyle(basicwidget.ProgressBarStyleCircular)
+	p.circularProgressBar.SetValue(value)
+	p.circularProgressBar.SetIndeterminate(indeterminate)
+	context.SetEnabled(&p.circularProgressBar, enabled)
+
+	p.spinnerText.SetValue("Spinner")
+	context.SetEnabled(&p.spinner, enabled)
+
+	p.form.SetItems([]basicwidget.FormItem{
+		{
+			PrimaryWidget:   &p.progressBarText,
+			SecondaryWidget: &p.progressBar,
+		},
+		{
+			PrimaryWidget:   &p.dangerProgressBarText,
+			SecondaryWidget: &p.dangerProgressBar,
+		},
+		{
+			PrimaryWidget:   &p.circularProgressBarText,
+			SecondaryWidget: &p.circularProgressBar,
+		},
+		{
+			PrimaryWidget:   &p.spinnerText,
+			SecondaryWidget: &p.spinner,
+		},
+	})
+
+	// Configurations
+	p.valueText.SetValue("Value")
+	p.valueSlider.OnValueChanged(func(context *guigui.Context, value int) {
+		model.ProgressBars().SetValue(value)
+	})
+	p.valueSlider.SetMinimumValue(0)
+	p.valueSlider.SetMaximumValue(100)
+	p.valueSlider.SetValue(model.ProgressBars().Value())
+	context.SetEnabled(&p.valueSlider, !indeterminate)
+	p.indeterminateText.SetValue("Indeterminate")
+	p.indeterminateToggle.OnValueChanged(func(context *guigui.Context, value bool) {
+		model.ProgressBars().SetIndeterminate(value)
+	})
+	p.indeterminateToggle.SetValue(indeterminate)
+	p.enabledText.SetValue("Enabled")
+	p.enabledToggle.OnValueChanged(func(context *guigui.Context, value bool) {
+		model.ProgressBars().SetEnabled(value)
+	})
+	p.enabledToggle.SetValue(enabled)
+
+	p.configForm.SetItems([]basicwidget.FormItem{
+		{
+			PrimaryWidget:   &p.valueText,
+			SecondaryWidget: &p.valueSlider,
+		},
+		{
+			PrimaryWidget:   &p.indeterminateText,
+			SecondaryWidget: &p.indeterminateToggle,
+		},
+		{
+			PrimaryWidget:   &p.enabledText,
+			SecondaryWidget: &p.enabledToggle,
+		},
+	})
+
+	return nil
+}
+
+func (p *ProgressBars) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
+	u := basicwidget.UnitSize(context)
+	p.layoutItems = slices.Delete(p.layoutItems, 0, len(p.layoutItems))
+	p.layoutItems = append(p.layoutItems,
+		guigui.LinearLayoutItem{
+			Widget: &p.form,
+		},
+		guigui.LinearLayoutItem{
+			Size: guigui.FlexibleSize(1),
+		},
+		guigui.LinearLayoutItem{
+			Widget: &p.configForm,
+		},
+	)
+	(guigui.LinearLayout{
+		Direction: guigui.LayoutDirectionVertical,
+		Items:     p.layoutItems,
+		Gap:       u / 2,
+		Padding: guigui.Padding{
+			Start:  u / 2,
+			Top:    u / 2,
+			End:    u / 2,
+			Bottom: u / 2,
+		},
+	}).LayoutWidgets(context, widgetBounds.Bounds(), layouter)
+}
@@ -21,6 +21,8 @@ type Selects struct {
 	select1     basicwidget.Select[int]
 	select2Text basicwidget.Text
 	select2     basicwidget.Select[int]
+	select3Text basicwidget.Text
+	select3     basicwidget.Select[int]
 
 	configForm    basicwidget.Form
 	enabledText   basicwidget.Text
@@ -29,6 +31,7 @@ type Selects struct {
 	select1Items       []basicwidget.SelectItem[int]
 	select2Items       []basicwidget.SelectItem[int]
 	select2ItemWidgets []selectItem
+	select3Items       []basicwidget.SelectItem[int]
 
 	layoutItems []guigui.LinearLayoutItem
 }
@@ -94,6 +97,17 @@ func (s *Selects) Build(context *guigui.Context, adder *guigui.ChildAdder) error
 		s.select2.SelectItemByIndex(0)
 	}
 
+	// Select (Filter)
+	s.select3Text.SetValue("Select with a filter")
+	s.select3Items = slices.Delete(s.select3Items, 0, len(s.select3Items))
+	s.select3Items = model.Selects().AppendFilterableSelectItems(s.select3Items)
+	s.select3.SetItems(s.select3Items)
+	s.select3.SetFilterVisible(true)
+	context.SetEnabled(&s.select3, model.Selects().Enabled())
+	if s.select3.SelectedItemIndex() < 0 {
+		s.select3.SelectItemByIndex(0)
+	}
+
 	s.listForm.SetItems([]basicwidget.FormItem{
 		{
 			PrimaryWidget:   &s.select1Text,
@@ -103,6 +117,10 @@ func (s *Selects) Build(context *guigui.Context, adder *guigui.ChildAdder) error
 			PrimaryWidget:   &s.select2Text,
 			SecondaryWidget: &s.select2,
 		},
+		{
+			PrimaryWidget:   &s.select3Text,
+			SecondaryWidget: &s.select3,
+		},
 	})
 
 	// Config form
@@ -96,6 +96,10 @@ func (s *sidebarContent) Build(context *guigui.Context, adder *guigui.ChildAdder
 			Text:  "Sliders",
 			Value: "sliders",
 		},
+		{
+			Text:  "Progress Bars",
+			Value: "progressbars",
+		},
 		{
 			Text:  "Lists",
 			Value: "lists",
@@ -108,6 +112,18 @@ func (s *sidebarContent) Build(context *guigui.Context, adder *guigui.ChildAdder
 			Text:  "Comboboxes",
 			Value: "comboboxes",
 		},
+		{
+			Text:  "Date & Time Pickers",
+			Value: "datetimepickers",
+		},
+		{
+			Text:  "Color Pickers",
+			Value: "colorpickers",
+		},
+		{
+			Text:  "Tab Views",
+			Value: "tabviews",
+		},
 		{
 			Text:  "Tables",
 			Value: "tables",
@@ -22,6 +22,12 @@ type Sliders struct {
 	sliderWithSnaps                  guigui.WidgetWithSize[*basicwidget.Slider]
 	sliderWithSnapsNoRestrictionText basicwidget.Text
 	sliderWithSnapsNoRestriction     guigui.WidgetWithSize[*basicwidget.Slider]
+	rangeSliderText                  basicwidget.Text
+	rangeSlider                      guigui.WidgetWithSize[*basicwidget.Slider]
+	sliderWithLabelsText             basicwidget.Text
+	sliderWithLabels                 guigui.WidgetWithSize[*basicwidget.Slider]
+	verticalSliderText               basicwidget.Text
+	verticalSlider                   guigui.WidgetWithSize[*basicwidget.Slider]
 
 	configForm    basicwidget.Form
 	enabledText   basicwidget.Text
@@ -80,6 +86,44 @@ func (s *Sliders) Build(context *guigui.Context, adder *guigui.ChildAdder) error
 	context.SetEnabled(&s.sliderWithSnapsNoRestriction, model.Sliders().Enabled())
 	s.sliderWithSnapsNoRestriction.SetFixedWidth(width)
 
+	s.rangeSliderText.SetValue("Range slider (Range: [-100, 100])")
+	s.rangeSlider.Widget().OnValueRangeChanged(func(context *guigui.Context, low, high int) {
+		model.Sliders().SetSliderRange(low, high)
+	})
+	s.rangeSlider.Widget().SetRangeEnabled(true)
+	s.rangeSlider.Widget().SetMinimumValue(-100)
+	s.rangeSlider.Widget().SetMaximumValue(100)
+	s.rangeSlider.Widget().SetValueRange(model.Sliders().SliderRange())
+	context.SetEnabled(&s.rangeSlider, model.Sliders().Enabled())
+	s.rangeSlider.SetFixedWidth(width)
+
+	s.sliderWithLabelsText.SetValue("Slider with tick labels (Range: [-100, 100], Step: 10)")
+	s.sliderWithLabels.Widget().OnValueChanged(func(context *guigui.Context, value int) {
+		model.Sliders().SetSliderValue(value)
+	})
+	s.sliderWithLabels.Widget().SetMinimumValue(-100)
+	s.sliderWithLabels.Widget().SetMaximumValue(100)
+	s.sliderWithLabels.Widget().SetStep(10)
+	s.sliderWithLabels.Widget().SetSnapOnly(true)
+	s.sliderWithLabels.Widget().SetTickLabelsVisible(true)
+	s.sliderWithLabels.Widget().SetValue(model.Sliders().SliderValue())
+	context.SetEnabled(&s.sliderWithLabels, model.Sliders().Enabled())
+	s.sliderWithLabels.SetFixedWidth(width)
+
+	s.verticalSliderText.SetValue("Vertical range slider (Range: [-100, 100], Step: 25)")
+	s.verticalSlider.Widget().OnValueRangeChanged(func(context *guigui.Context, low, high int) {
+		model.Sliders().SetSliderRange(low, high)
+	})
+	s.verticalSlider.Widget().SetDirection(basicwidget.SliderDirectionVertical)
+	s.verticalSlider.Widget().SetRangeEnabled(true)
+	s.verticalSlider.Widget().SetMinimumValue(-100)
+	s.verticalSlider.Widget().SetMaximumValue(100)
+	s.verticalSlider.Widget().SetStep(25)
+	s.verticalSlider.Widget().SetTickLabelsVisible(true)
+	s.verticalSlider.Widget().SetValueRange(model.Sliders().SliderRange())
+	context.SetEnabled(&s.verticalSlider, model.Sliders().Enabled())
+	s.verticalSlider.SetFixedHeight(6 * u)
+
 	s.sliderForm.SetItems([]basicwidget.FormItem{
 		{
 			PrimaryWidget:   &s.sliderText,
@@ -97,6 +141,18 @@ func (s *Sliders) Build(context *guigui.Context, adder *guigui.ChildAdder) error
 			PrimaryWidget:   &s.sliderWithSnapsNoRestrictionText,
 			SecondaryWidget: &s.sliderWithSnapsNoRestriction,
 		},
+		{
+			PrimaryWidget:   &s.rangeSliderText,
+			SecondaryWidget: &s.rangeSlider,
+		},
+		{
+			PrimaryWidget:   &s.sliderWithLabelsText,
+			SecondaryWidget: &s.sliderWithLabels,
+		},
+		{
+			PrimaryWidget:   &s.verticalSliderText,
+			SecondaryWidget: &s.verticalSlider,
+		},
 	})
 
 	// Configurations
@@ -24,6 +24,10 @@ type Tables struct {
 	movableToggle    basicwidget.Toggle
 	enabledText      basicwidget.Text
 	enabledToggle    basicwidget.Toggle
+	frozenText       basicwidget.Text
+	frozenToggle     basicwidget.Toggle
+	groupedText      basicwidget.Text
+	groupedToggle    basicwidget.Toggle
 
 	tableRows []basicwidget.TableRow[int]
 
@@ -47,23 +51,51 @@ func (t *Tables) Build(context *guigui.Context, adder *guigui.ChildAdder) error
 			HeaderTextHorizontalAlign: basicwidget.HorizontalAlignRight,
 			Width:                     guigui.FlexibleSize(1),
 			MinWidth:                  2 * u,
+			Sortable:                  true,
+			Resizable:                 true,
+			Movable:                   true,
+			Aggregate:                 basicwidget.TableAggregateTypeCount,
 		},
 		{
 			HeaderText: "Name",
 			Width:      guigui.FlexibleSize(2),
 			MinWidth:   4 * u,
+			Sortable:   true,
+			Resizable:  true,
+			Movable:    true,
+			Editor:     basicwidget.TableCellEditorTypeTextInput,
 		},
 		{
 			HeaderText:                "Amount",
 			HeaderTextHorizontalAlign: basicwidget.HorizontalAlignRight,
 			Width:                     guigui.FlexibleSize(1),
 			MinWidth:                  2 * u,
+			Sortable:                  true,
+			Resizable:                 true,
+			Movable:                   true,
+			Hideable:                  true,
+			Editor:                    basicwidget.TableCellEditorTypeNumberInput,
+			Aggregate:                 basicwidget.TableAggregateTypeSum,
 		},
 		{
 			HeaderText:                "Cost",
 			HeaderTextHorizontalAlign: basicwidget.HorizontalAlignRight,
 			Width:                     guigui.FlexibleSize(1),
 			MinWidth:                  2 * u,
+			Sortable:                  true,
+			Resizable:                 true,
+			Movable:                   true,
+			Hideable:                  true,
+			Aggregate:                 basicwidget.TableAggregateTypeSum,
+		},
+		{
+			HeaderText: "Category",
+			Width:      guigui.FlexibleSize(1),
+			MinWidth:   3 * u,
+			Sortable:   true,
+			Resizable:  true,
+			Movable:    true,
+			Hideable:   true,
 		},
 	})
 
@@ -75,7 +107,7 @@ func (t *Tables) Build(context *guigui.Context, adder *guigui.ChildAdder) error
 		t.tableRows = slices.Delete(t.tableRows, newNum, len(t.tableRows))
 	}
 
-	const n = 4
+	const n = 5
 	for i, item := range model.Tables().TableItems() {
 		t.tableRows[i].Movable = model.Tables().Movable()
 		t.tableRows[i].Value = item.ID
@@ -98,6 +130,8 @@ func (t *Tables) Build(context *guigui.Context, adder *guigui.ChildAdder) error
 		t.tableRows[i].Cells[3].Text = fmt.Sprintf("%d.%02d", item.Cost/100, item.Cost%100)
 		t.tableRows[i].Cells[3].TextStyle.HorizontalAlign = basicwidget.HorizontalAlignRight
 		t.tableRows[i].Cells[3].TextStyle.Tabular = true
+
+		t.tableRows[i].Cells[4].Text = item.Category
 	}
 	t.table.SetItems(t.tableRows)
 	if model.Tables().IsFooterVisible() {
@@ -106,10 +140,33 @@ func (t *Tables) Build(context *guigui.Context, adder *guigui.ChildAdder) error
 		t.table.SetFooterHeight(0)
 	}
 	context.SetEnabled(&t.table, model.Tables().Enabled())
+	if model.Tables().FirstColumnFrozen() {
+		t.table.SetFrozenColumnCount(1, 0)
+	} else {
+		t.table.SetFrozenColumnCount(0, 0)
+	}
+	if model.Tables().Grouped() {
+		t.table.SetGroupColumnIndex(4)
+	} else {
+		t.table.SetGroupColumnIndex(-1)
+	}
 	t.table.OnItemsMoved(func(context *guigui.Context, from, count, to int) {
 		idx := model.Tables().MoveTableItems(from, count, to)
 		t.table.SelectItemByIndex(idx)
 	})
+	t.table.OnCellEditCommitted(func(context *guigui.Context, rowIndex, columnIndex int, value basicwidget.TableCellValue) error {
+		row, ok := t.table.ItemByIndex(rowIndex)
+		if !ok {
+			return nil
+		}
+		switch columnIndex {
+		case 1:
+			return model.Tables().SetTableItemName(row.Value, value.Text)
+		case 2:
+			return model.Tables().SetTableItemAmount(row.Value, value.Number)
+		}
+		return nil
+	})
 
 	// Configurations
 	t.showFooterText.SetValue("Show footer")
@@ -126,6 +183,16 @@ func (t *Tables) Build(context *guigui.Context, adder *guigui.ChildAdder) error
 		model.Tables().SetEnabled(value)
 	})
 	t.enabledToggle.SetValue(model.Tables().Enabled())
+	t.frozenText.SetValue("Freeze the first column")
+	t.frozenToggle.OnValueChanged(func(context *guigui.Context, value bool) {
+		model.Tables().SetFirstColumnFrozen(value)
+	})
+	t.frozenToggle.SetValue(model.Tables().FirstColumnFrozen())
+	t.groupedText.SetValue("Group by category")
+	t.groupedToggle.OnValueChanged(func(context *guigui.Context, value bool) {
+		model.Tables().SetGrouped(value)
+	})
+	t.groupedToggle.SetValue(model.Tables().Grouped())
 
 	t.configForm.SetItems([]basicwidget.FormItem{
 		{
@@ -140,6 +207,14 @@ func (t *Tables) Build(context *guigui.Context, adder *guigui.ChildAdder) error
 			PrimaryWidget:   &t.enabledText,
 			SecondaryWidget: &t.enabledToggle,
 		},
+		{
+			PrimaryWidget:   &t.frozenText,
+			SecondaryWidget: &t.frozenToggle,
+		},
+		{
+			PrimaryWidget:   &t.groupedText,
+			SecondaryWidget: &t.groupedToggle,
+		},
 	})
 
 	return nil
@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package main
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/guigui-gui/guigui"
+	"github.com/guigui-gui/guigui/basicwidget"
+)
+
+type TabViews struct {
+	guigui.DefaultWidget
+
+	tabView      basicwidget.TabView[int]
+	pages        guigui.WidgetSlice[*basicwidget.Text]
+	tabViewItems []basicwidget.TabViewItem[int]
+
+	configForm      basicwidget.Form
+	positionText    basicwidget.Text
+	positionControl basicwidget.SegmentedControl[basicwidget.TabPosition]
+	addTabText      basicwidget.Text
+	addTabButton    basicwidget.Button
+	enabledText     basicwidget.Text
+	enabledToggle   basicwidget.Toggle
+
+	layoutItems []guigui.LinearLayoutItem
+}
+
+func (t *TabViews) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
+	adder.AddWidget(&t.tabView)
+	adder.AddWidget(&t.configForm)
+
+	v, ok := context.Env(t, modelKeyModel)
+	if !ok {
+		return nil
+	}
+	model := v.(*Model)
+
+	tabs := model.TabViews().Tabs()
+	t.pages.SetLen(len(tabs))
+	t.tabViewItems = slices.Delete(t.tabViewItems, 0, len(t.tabViewItems))
+	for i, tab := range tabs {
+		page := t.pages.At(i)
+		page.SetValue(fmt.Sprintf("This is the content of %s.", tab.Title))
+		page.SetHorizontalAlign(basicwidget.HorizontalAlignCenter)
+		page.SetVerticalAlign(basicwidget.VerticalAlignMiddle)
+		t.tabViewItems = append(t.tabViewItems, basicwidget.TabViewItem[int]{
+			Text:     tab.Title,
+			Content:  page,
+			Closable: true,
+			Movable:  true,
+			Value:    tab.ID,
+		})
+	}
+	t.tabView.SetItems(t.tabViewItems)
+	t.tabView.SelectItemByValue(model.TabViews().SelectedID())
+	t.tabView.SetTabPosition(model.TabViews().Position())
+	t.tabView.OnItemSelected(func(context *guigui.Context, index int) {
+		item, ok := t.tabView.ItemByIndex(index)
+		if !ok {
+			return
+		}
+		model.TabViews().SetSelectedID(item.Value)
+	})
+	t.tabView.OnItemClosed(func(context *guigui.Context, index int) {
+		item, ok := t.tabView.ItemByIndex(index)
+		if !ok {
+			return
+		}
+		model.TabViews().CloseTab(index)
+		if item.Value != model.TabViews().SelectedID() {
+			return
+		}
+		// Select the next tab of the closed tab.
+		if tabs := model.TabViews().Tabs(); len(tabs) > 0 {
+			model.TabViews().SetSelectedID(tabs[min(index, len(tabs)-1)].ID)
+		}
+	})
+	t.tabView.OnItemsMoved(func(context *guigui.Context, from, count, to int) {
+		model.TabViews().MoveTabs(from, count, to)
+	})
+	context.SetEnabled(&t.tabView, model.TabViews().Enabled())
+
+	// Configurations
+	t.positionText.SetValue("Tab position")
+	t.positionControl.SetItems([]basicwidget.SegmentedControlItem[basicwidget.TabPosition]{
+		{
+			Text:  "Top",
+			Value: basicwidget.TabPositionTop,
+		},
+		{
+			Text:  "Bottom",
+			Value: basicwidget.TabPositionBottom,
+		},
+		{
+			Text:  "Start",
+			Value: basicwidget.TabPositionStart,
+		},
+		{
+			Text:  "End",
+			Value: basicwidget.TabPositionEnd,
+		},
+	})
+	t.positionControl.SelectItemByValue(model.TabViews().Position())
+	t.positionControl.OnItemSelected(func(context *guigui.Context, index int) {
+		item, ok := t.positionControl.ItemByIndex(index)
+		if !ok {
+			return
+		}
+		model.TabViews().SetPosition(item.Value)
+	})
+
+	t.addTabText.SetValue("Add a tab")
+	t.addTabButton.SetText("Add")
+	t.addTabButton.OnUp(func(context *guigui.Context) {
+		model.TabViews().SetSelectedID(model.TabViews().AddTab())
+	})
+	context.SetEnabled(&t.addTabButton, model.TabViews().Enabled())
+
+	t.enabledText.SetValue("Enabled")
+	t.enabledToggle.OnValueChanged(func(context *guigui.Context, value bool) {
+		model.TabViews().SetEnabled(value)
+	})
+	t.enabledToggle.SetValue(model.TabViews().Enabled())
+
+	t.configForm.SetItems([]basicwidget.FormItem{
+		{
+			PrimaryWidget:   &t.positionText,
+			SecondaryWidget: &t.positionControl,
+		},
+		{
+			PrimaryWidget:   &t.addTabText,
+			SecondaryWidget: &t.addTabButton,
+		},
+		{
+			PrimaryWidget:   &t.enabledText,
+			SecondaryWidget: &t.enabledToggle,
+		},
+	})
+
+	return nil
+}
+
+func (t *TabViews) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
+	u := basicwidget.UnitSize(context)
+	t.layoutItems = slices.Delete(t.layoutItems, 0, len(t.layoutItems))
+	t.layoutItems = append(t.layoutItems,
+		guigui.LinearLayoutItem{
+			Widget: &t.tabView,
+			Size:   guigui.FlexibleSize(1),
+		},
+		guigui.LinearLayoutItem{
+			Widget: &t.configForm,
+		},
+	)
+	(guigui.LinearLayout{
+		Direction: guigui.LayoutDirectionVertical,
+		Items:     t.layoutItems,
+		Gap:       u / 2,
+		Padding: guigui.Padding{
+			Start:  u / 2,
+			Top:    u / 2,
+			End:    u / 2,
+			Bottom: u / 2,
+		},
+	}).LayoutWidgets(context, widgetBounds.Bounds(), layouter)
+}
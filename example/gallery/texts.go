@@ -7,7 +7,6 @@ import (
 	"slices"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
 
 	"github.com/guigui-gui/guigui"
 	"github.com/guigui-gui/guigui/basicwidget"
@@ -222,7 +221,7 @@ func (t *Texts) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
 		if !t.sampleText.IsEditable() {
 			return guigui.HandleInputResult{}
 		}
-		if inpututil.IsKeyJustPressed(ebiten.KeyTab) {
+		if guigui.IsKeyJustPressed(ebiten.KeyTab) {
 			t.sampleText.ReplaceValueAtSelection("\t")
 			return guigui.HandleInputByWidget(&t.sampleText)
 		}
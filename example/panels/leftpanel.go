@@ -18,9 +18,6 @@ type LeftPanel struct {
 func (l *LeftPanel) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
 	adder.AddWidget(&l.panel)
 	l.panel.SetStyle(basicwidget.PanelStyleSide)
-	l.panel.SetBorders(basicwidget.PanelBorders{
-		End: true,
-	})
 	l.panel.SetContent(&l.content)
 	return nil
 }
@@ -29,11 +29,11 @@ type Root struct {
 	leftPanel    LeftPanel
 	contentPanel ContentPanel
 	rightPanel   RightPanel
+	splitPanel   basicwidget.SplitPanel
 
 	model Model
 
-	mainLayoutItems  []guigui.LinearLayoutItem
-	panelLayoutItems []guigui.LinearLayoutItem
+	layoutItems []guigui.LinearLayoutItem
 }
 
 func (r *Root) Env(context *guigui.Context, key guigui.EnvKey, source *guigui.EnvSource) (any, bool) {
@@ -48,67 +48,64 @@ func (r *Root) Env(context *guigui.Context, key guigui.EnvKey, source *guigui.En
 func (r *Root) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
 	adder.AddWidget(&r.background)
 	adder.AddWidget(&r.toolbar)
-	adder.AddWidget(&r.leftPanel)
-	adder.AddWidget(&r.contentPanel)
-	adder.AddWidget(&r.rightPanel)
+	adder.AddWidget(&r.splitPanel)
+
+	r.splitPanel.SetPanes([]basicwidget.SplitPanelPane{
+		{
+			Widget:      &r.leftPanel,
+			Collapsible: true,
+		},
+		{
+			Widget:   &r.contentPanel,
+			Flexible: true,
+		},
+		{
+			Widget:      &r.rightPanel,
+			Collapsible: true,
+		},
+	})
+	r.splitPanel.SetPaneSizes(r.model.PaneSizes())
+	r.splitPanel.SetPaneCollapsed(0, !r.model.IsLeftPanelOpen())
+	r.splitPanel.SetPaneCollapsed(2, !r.model.IsRightPanelOpen())
+	r.splitPanel.OnPaneSizesChanged(func(context *guigui.Context, sizes []int) {
+		r.model.SetPaneSizes(sizes)
+	})
+	r.splitPanel.OnPaneCollapsedChanged(func(context *guigui.Context, index int, collapsed bool) {
+		switch index {
+		case 0:
+			r.model.SetLeftPanelOpen(!collapsed)
+		case 2:
+			r.model.SetRightPanelOpen(!collapsed)
+		}
+	})
+
 	return nil
 }
 
 func (r *Root) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
 	layouter.LayoutWidget(&r.background, widgetBounds.Bounds())
 
-	r.mainLayoutItems = slices.Delete(r.mainLayoutItems, 0, len(r.mainLayoutItems))
-	r.mainLayoutItems = append(r.mainLayoutItems,
+	r.layoutItems = slices.Delete(r.layoutItems, 0, len(r.layoutItems))
+	r.layoutItems = append(r.layoutItems,
 		guigui.LinearLayoutItem{
 			Widget: &r.toolbar,
 			Size:   guigui.FixedSize(r.toolbar.Measure(context, guigui.Constraints{}).Y),
 		},
 		guigui.LinearLayoutItem{
-			Size: guigui.FlexibleSize(1),
-		},
-	)
-	mainLayout := guigui.LinearLayout{
-		Direction: guigui.LayoutDirectionVertical,
-		Items:     r.mainLayoutItems,
-	}
-	mainLayout.LayoutWidgets(context, widgetBounds.Bounds(), layouter)
-
-	boundsArr := mainLayout.AppendItemBounds(nil, context, widgetBounds.Bounds())
-	bounds := boundsArr[1]
-	bounds.Min.X -= r.model.DefaultPanelWidth(context)
-	bounds.Min.X += r.model.LeftPanelWidth(context)
-	bounds.Max.X += r.model.DefaultPanelWidth(context)
-	bounds.Max.X -= r.model.RightPanelWidth(context)
-	r.panelLayoutItems = slices.Delete(r.panelLayoutItems, 0, len(r.panelLayoutItems))
-	r.panelLayoutItems = append(r.panelLayoutItems,
-		guigui.LinearLayoutItem{
-			Widget: &r.leftPanel,
-			Size:   guigui.FixedSize(r.model.DefaultPanelWidth(context)),
-		},
-		guigui.LinearLayoutItem{
-			Widget: &r.contentPanel,
+			Widget: &r.splitPanel,
 			Size:   guigui.FlexibleSize(1),
 		},
-		guigui.LinearLayoutItem{
-			Widget: &r.rightPanel,
-			Size:   guigui.FixedSize(r.model.DefaultPanelWidth(context)),
-		},
 	)
 	(guigui.LinearLayout{
-		Direction: guigui.LayoutDirectionHorizontal,
-		Items:     r.panelLayoutItems,
-	}).LayoutWidgets(context, bounds, layouter)
+		Direction: guigui.LayoutDirectionVertical,
+		Items:     r.layoutItems,
+	}).LayoutWidgets(context, widgetBounds.Bounds(), layouter)
 }
 
 func (r *Root) WriteStateKey(w *guigui.StateKeyWriter) {
 	r.model.writeStateKey(w)
 }
 
-func (r *Root) Tick(context *guigui.Context, widgetBounds *guigui.WidgetBounds) error {
-	r.model.Tick()
-	return nil
-}
-
 func main() {
 	op := &guigui.RunOptions{
 		Title:      "Panels",
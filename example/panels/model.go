@@ -5,123 +5,55 @@ package main
 
 import (
 	"github.com/guigui-gui/guigui"
-	"github.com/guigui-gui/guigui/basicwidget"
-	"github.com/hajimehoshi/ebiten/v2"
 )
 
 type Model struct {
-	leftOpening       bool
-	leftClosing       bool
-	leftClosingCount  int
-	rightOpening      bool
-	rightClosing      bool
-	rightClosingCount int
-}
-
-func panelMaxClosingCount() int {
-	return ebiten.TPS() / 10
+	leftPanelClosed  bool
+	rightPanelClosed bool
+	paneSizes        []int
 }
 
 // writeStateKey writes the model state that affects the widget tree's
-// layout (panel widths) into w. Callers invoke this from their own
-// WriteStateKey to trigger rebuilds when the panel animation advances.
+// layout (panel visibility and sizes) into w. Callers invoke this from their own
+// WriteStateKey to trigger rebuilds when the panels are toggled or resized.
 func (m *Model) writeStateKey(w *guigui.StateKeyWriter) {
-	w.WriteInt(m.leftClosingCount)
-	w.WriteInt(m.rightClosingCount)
-}
-
-func (m *Model) Tick() {
-	if m.leftOpening && m.leftClosingCount > 0 {
-		m.leftClosingCount--
-		if m.leftClosingCount == 0 {
-			m.leftOpening = false
-		}
-	}
-	if m.leftClosing && m.leftClosingCount < panelMaxClosingCount() {
-		m.leftClosingCount++
-		if m.leftClosingCount == panelMaxClosingCount() {
-			m.leftClosing = false
-		}
-	}
-	if m.rightOpening && m.rightClosingCount > 0 {
-		m.rightClosingCount--
-		if m.rightClosingCount == 0 {
-			m.rightOpening = false
-		}
-	}
-	if m.rightClosing && m.rightClosingCount < panelMaxClosingCount() {
-		m.rightClosingCount++
-		if m.rightClosingCount == panelMaxClosingCount() {
-			m.rightClosing = false
-		}
+	w.WriteBool(m.leftPanelClosed)
+	w.WriteBool(m.rightPanelClosed)
+	w.WriteInt(len(m.paneSizes))
+	for _, size := range m.paneSizes {
+		w.WriteInt(size)
 	}
 }
 
-func (m *Model) DefaultPanelWidth(context *guigui.Context) int {
-	u := basicwidget.UnitSize(context)
-	return 8 * u
-}
+// defaultPanelWidth is the default width of the left and the right panels in device-independent pixels.
+// This is 8 units of basicwidget.UnitSize at the scale 1.
+const defaultPanelWidth = 192
 
 func (m *Model) IsLeftPanelOpen() bool {
-	return m.leftClosingCount == 0 && !m.leftOpening && !m.leftClosing
+	return !m.leftPanelClosed
 }
 
 func (m *Model) SetLeftPanelOpen(open bool) {
-	if open {
-		if m.leftOpening {
-			return
-		}
-		if m.leftClosingCount == 0 {
-			return
-		}
-		m.leftOpening = true
-		m.leftClosing = false
-		return
-	}
-	if m.leftClosing {
-		return
-	}
-	if m.leftClosingCount == panelMaxClosingCount() {
-		return
-	}
-	m.leftClosing = true
-	m.leftOpening = false
-}
-
-func (m *Model) LeftPanelWidth(context *guigui.Context) int {
-	fullWidth := m.DefaultPanelWidth(context)
-	rate := float64(m.leftClosingCount) / float64(panelMaxClosingCount())
-	return int(float64(fullWidth) * (1 - rate))
+	m.leftPanelClosed = !open
 }
 
 func (m *Model) IsRightPanelOpen() bool {
-	return m.rightClosingCount == 0 && !m.rightOpening && !m.rightClosing
+	return !m.rightPanelClosed
 }
 
 func (m *Model) SetRightPanelOpen(open bool) {
-	if open {
-		if m.rightOpening {
-			return
-		}
-		if m.rightClosingCount == 0 {
-			return
-		}
-		m.rightOpening = true
-		m.rightClosing = false
-		return
-	}
-	if m.rightClosing {
-		return
-	}
-	if m.rightClosingCount == panelMaxClosingCount() {
-		return
+	m.rightPanelClosed = !open
+}
+
+// PaneSizes returns the sizes of the left panel, the content panel and the right panel in device-independent pixels.
+func (m *Model) PaneSizes() []int {
+	if m.paneSizes == nil {
+		// The content panel takes the remaining space, so its size doesn't matter.
+		m.paneSizes = []int{defaultPanelWidth, 0, defaultPanelWidth}
 	}
-	m.rightClosing = true
-	m.rightOpening = false
+	return m.paneSizes
 }
 
-func (m *Model) RightPanelWidth(context *guigui.Context) int {
-	fullWidth := m.DefaultPanelWidth(context)
-	rate := float64(m.rightClosingCount) / float64(panelMaxClosingCount())
-	return int(float64(fullWidth) * (1 - rate))
+func (m *Model) SetPaneSizes(sizes []int) {
+	m.paneSizes = append(m.paneSizes[:0], sizes...)
 }
@@ -18,9 +18,6 @@ type RightPanel struct {
 func (r *RightPanel) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
 	adder.AddWidget(&r.panel)
 	r.panel.SetStyle(basicwidget.PanelStyleSide)
-	r.panel.SetBorders(basicwidget.PanelBorders{
-		Start: true,
-	})
 	r.panel.SetContent(&r.content)
 	return nil
 }
@@ -9,7 +9,6 @@ import (
 	"slices"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
 
 	"github.com/guigui-gui/guigui"
 	"github.com/guigui-gui/guigui/basicwidget"
@@ -122,21 +121,21 @@ func (c *findDialogContent) Build(context *guigui.Context, adder *guigui.ChildAd
 		guigui.DispatchEvent(c.dialog, findDialogEventQueryChanged, text)
 	})
 	c.queryInput.OnHandleButtonInput(func(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
-		if inpututil.IsKeyJustPressed(ebiten.KeyEnter) {
-			if ebiten.IsKeyPressed(ebiten.KeyShift) {
+		if guigui.IsKeyJustPressed(ebiten.KeyEnter) {
+			if guigui.IsKeyPressed(ebiten.KeyShift) {
 				guigui.DispatchEvent(c.dialog, findDialogEventFindPrev, c.queryInput.Value())
 			} else {
 				guigui.DispatchEvent(c.dialog, findDialogEventFindNext, c.queryInput.Value())
 			}
 			return guigui.HandleInputByWidget(&c.queryInput)
 		}
-		if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		if guigui.IsKeyJustPressed(ebiten.KeyEscape) {
 			c.dialog.popup.SetOpen(false)
 			return guigui.HandleInputByWidget(&c.queryInput)
 		}
 		// Cmd/Ctrl+F toggles: when the popup is already open, treat the same
 		// shortcut as a close.
-		if cmdPressed() && inpututil.IsKeyJustPressed(ebiten.KeyF) {
+		if cmdPressed() && guigui.IsKeyJustPressed(ebiten.KeyF) {
 			c.dialog.popup.SetOpen(false)
 			return guigui.HandleInputByWidget(&c.queryInput)
 		}
@@ -14,10 +14,10 @@ import (
 	"slices"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
 
 	"github.com/guigui-gui/guigui"
 	"github.com/guigui-gui/guigui/basicwidget"
+	"github.com/guigui-gui/guigui/basicwidget/basicwidgetdraw"
 	_ "github.com/guigui-gui/guigui/basicwidget/cjkfont"
 )
 
@@ -29,8 +29,8 @@ type Root struct {
 	editor        editor
 	statusBar     statusBar
 	findDialog    findDialog
-	confirmDialog confirmDialog
-	infoDialog    infoDialog
+	confirmDialog basicwidget.Dialog
+	infoDialog    basicwidget.Dialog
 
 	doc           Document
 	initialPath   string
@@ -64,6 +64,13 @@ const (
 	confirmKindOpen
 )
 
+// The buttons of the confirm dialog, in the order of [basicwidget.DialogResult.ButtonIndex].
+const (
+	confirmButtonSave = iota
+	confirmButtonDontSave
+	confirmButtonCancel
+)
+
 func (r *Root) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
 	adder.AddWidget(&r.background)
 	adder.AddWidget(&r.menubar)
@@ -108,13 +115,34 @@ func (r *Root) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
 		context.SetFocused(&r.editor, true)
 	})
 
-	r.confirmDialog.OnClose(func(context *guigui.Context, result confirmResult) {
+	// The in-app modal "you have unsaved changes" prompt. Using Guigui widgets
+	// keeps everything on the main goroutine, sidestepping the macOS
+	// dispatch-queue timing issue that affects native dialogs called from a
+	// non-main goroutine.
+	r.confirmDialog.SetTitle("You have unsaved changes.")
+	r.confirmDialog.SetMessage("Do you want to save the changes?")
+	r.confirmDialog.SetSemanticColor(basicwidgetdraw.SemanticColorWarning)
+	r.confirmDialog.SetButtons([]basicwidget.DialogButton{
+		confirmButtonSave: {
+			Text:    "Save",
+			Default: true,
+		},
+		confirmButtonDontSave: {
+			Text: "Don't save",
+		},
+		confirmButtonCancel: {
+			Text:   "Cancel",
+			Cancel: true,
+		},
+	})
+	r.confirmDialog.OnClose(func(context *guigui.Context, result basicwidget.DialogResult) {
 		kind := r.confirmKind
 		r.confirmKind = confirmKindNone
-		if result == confirmResultCancel {
+		// Closing without any button, e.g. by Escape, is a cancellation.
+		if result.ButtonIndex != confirmButtonSave && result.ButtonIndex != confirmButtonDontSave {
 			return
 		}
-		save := result == confirmResultSave
+		save := result.ButtonIndex == confirmButtonSave
 		switch kind {
 		case confirmKindExit:
 			r.handleConfirmExit(save)
@@ -125,6 +153,17 @@ func (r *Root) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
 		}
 	})
 
+	r.infoDialog.SetTitle("Text Editor")
+	r.infoDialog.SetMessage("Guigui example")
+	r.infoDialog.SetSemanticColor(basicwidgetdraw.SemanticColorInfo)
+	r.infoDialog.SetButtons([]basicwidget.DialogButton{
+		{
+			Text:    "OK",
+			Default: true,
+			Cancel:  true,
+		},
+	})
+
 	r.menubar.SetCanSave(r.doc.Path() != "")
 	r.menubar.SetCanUndo(r.editor.CanUndo())
 	r.menubar.SetCanRedo(r.editor.CanRedo())
@@ -170,7 +209,7 @@ func (r *Root) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
 		r.wrapMode = wrapMode
 	})
 	r.menubar.OnAbout(func(context *guigui.Context) {
-		r.infoDialog.Open()
+		r.infoDialog.SetOpen(true)
 	})
 
 	start, _ := r.editor.Selection()
@@ -251,7 +290,6 @@ func (r *Root) Tick(context *guigui.Context, widgetBounds *guigui.WidgetBounds)
 		}
 		if !r.confirmDialog.IsOpen() {
 			r.confirmKind = confirmKindExit
-			r.confirmDialog.SetMessage("You have unsaved changes.")
 			r.confirmDialog.SetOpen(true)
 		}
 	}
@@ -321,7 +359,6 @@ func (r *Root) drainDialogs() error {
 func (r *Root) actionNew() {
 	if r.doc.IsDirty() {
 		r.confirmKind = confirmKindNew
-		r.confirmDialog.SetMessage("You have unsaved changes.")
 		r.confirmDialog.SetOpen(true)
 		return
 	}
@@ -351,7 +388,6 @@ func (r *Root) doNew() {
 func (r *Root) actionOpen() {
 	if r.doc.IsDirty() {
 		r.confirmKind = confirmKindOpen
-		r.confirmDialog.SetMessage("You have unsaved changes.")
 		r.confirmDialog.SetOpen(true)
 		return
 	}
@@ -415,13 +451,13 @@ func (r *Root) handleHotkeys(context *guigui.Context, widgetBounds *guigui.Widge
 		return guigui.HandleInputResult{}
 	}
 	switch {
-	case inpututil.IsKeyJustPressed(ebiten.KeyN):
+	case guigui.IsKeyJustPressed(ebiten.KeyN):
 		r.actionNew()
-	case inpututil.IsKeyJustPressed(ebiten.KeyO):
+	case guigui.IsKeyJustPressed(ebiten.KeyO):
 		r.actionOpen()
-	case inpututil.IsKeyJustPressed(ebiten.KeyS):
+	case guigui.IsKeyJustPressed(ebiten.KeyS):
 		r.actionSave()
-	case inpututil.IsKeyJustPressed(ebiten.KeyF):
+	case guigui.IsKeyJustPressed(ebiten.KeyF):
 		// Toggle: Cmd+F can fire on the editor side even when the popup is
 		// already shown (the popup doesn't auto-grab focus on Open).
 		r.findDialog.SetOpen(!r.findDialog.IsOpen())
@@ -582,9 +618,9 @@ func (s *substringSearcher) Write(p []byte) (int, error) {
 
 func cmdPressed() bool {
 	if runtime.GOOS == "darwin" {
-		return ebiten.IsKeyPressed(ebiten.KeyMeta)
+		return guigui.IsKeyPressed(ebiten.KeyMeta)
 	}
-	return ebiten.IsKeyPressed(ebiten.KeyControl)
+	return guigui.IsKeyPressed(ebiten.KeyControl)
 }
 
 func hotkey(key string) string {
@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"image"
 	"os"
-	"slices"
 	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
@@ -17,189 +16,41 @@ import (
 	"github.com/guigui-gui/guigui/basicwidget/basicwidgetdraw"
 )
 
-type Toast struct {
-	guigui.DefaultWidget
-
-	popup   basicwidget.Popup
-	content toastContent
-
-	openedAt      time.Time
-	duration      time.Duration
-	message       string
-	semanticColor basicwidgetdraw.SemanticColor
-}
-
-func (t *Toast) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
-	adder.AddWidget(&t.popup)
-
-	t.content.OnClose(func(context *guigui.Context) {
-		t.popup.SetOpen(false)
-	})
-	t.content.SetText(t.message)
-	t.content.SetSemanticColor(t.semanticColor)
-	t.popup.SetContent(&t.content)
-	t.popup.SetModal(false)
-	t.popup.SetCloseByClickingOutside(false)
-	t.popup.SetBackgroundSemanticColor(t.semanticColor)
-
-	return nil
-}
-
-func (t *Toast) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
-	layouter.LayoutWidget(&t.popup, widgetBounds.Bounds())
-}
-
-func (t *Toast) Tick(context *guigui.Context, widgetBounds *guigui.WidgetBounds) error {
-	if t.popup.IsOpen() && t.duration > 0 {
-		// Check if the cursor is on the toast by a simple geometric check.
-		// IsHitAtCursor is not suitable here because the popup content is in a higher layer,
-		// which blocks the Toast widget from being considered "hit".
-		// TODO: There might be a need for an API to check another widget's hit test (e.g., WidgetBounds.IsWidgetHitAtCursor),
-		// but this has not been decided yet.
-		if image.Pt(ebiten.CursorPosition()).In(widgetBounds.VisibleBounds()) {
-			// Reset the timer while the cursor is on the toast.
-			t.openedAt = time.Now()
-		} else if time.Since(t.openedAt) >= t.duration {
-			t.popup.SetOpen(false)
-		}
-	}
-	return nil
-}
-
-func (t *Toast) Measure(context *guigui.Context, constraints guigui.Constraints) image.Point {
-	return t.content.Measure(context, constraints)
-}
-
-func (t *Toast) IsOpen() bool {
-	return t.popup.IsOpen()
-}
-
-func (t *Toast) SetMessage(message string) {
-	t.message = message
-	t.content.text.SetValue(message)
-}
-
-func (t *Toast) SetHasCloseButton(hasCloseButton bool) {
-	t.content.hasCloseButton = hasCloseButton
-}
-
-func (t *Toast) SetDuration(duration time.Duration) {
-	t.duration = duration
-}
-
-func (t *Toast) SetSemanticColor(semanticColor basicwidgetdraw.SemanticColor) {
-	t.semanticColor = semanticColor
-}
-
-func (t *Toast) SetOpen(open bool) {
-	if open {
-		t.openedAt = time.Now()
-	}
-	t.popup.SetOpen(open)
-}
-
-var toastContentEventClose guigui.EventKey = guigui.GenerateEventKey()
-
-type toastContent struct {
-	guigui.DefaultWidget
-
-	text        basicwidget.Text
-	closeButton basicwidget.Button
-
-	hasCloseButton bool
-
-	linearLayout      guigui.LinearLayout
-	linearLayoutItems []guigui.LinearLayoutItem
-}
-
-func (t *toastContent) SetText(text string) {
-	t.text.SetValue(text)
-}
-
-func (t *toastContent) SetSemanticColor(semanticColor basicwidgetdraw.SemanticColor) {
-	t.text.SetSemanticColor(semanticColor)
-	t.closeButton.SetSemanticColor(semanticColor)
-}
-
-func (t *toastContent) OnClose(f func(context *guigui.Context)) {
-	guigui.SetEventHandler(t, toastContentEventClose, f)
-}
-
-func (t *toastContent) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
-	adder.AddWidget(&t.text)
-	if t.hasCloseButton {
-		adder.AddWidget(&t.closeButton)
-	}
-
-	t.text.SetVerticalAlign(basicwidget.VerticalAlignMiddle)
-	t.closeButton.SetText("Close")
-	t.closeButton.OnUp(func(context *guigui.Context) {
-		guigui.DispatchEvent(t, toastContentEventClose)
-	})
-
-	return nil
-}
-
-func (t *toastContent) buildLayout(context *guigui.Context) {
-	u := basicwidget.UnitSize(context)
-
-	t.linearLayoutItems = slices.Delete(t.linearLayoutItems, 0, len(t.linearLayoutItems))
-	t.linearLayoutItems = append(t.linearLayoutItems, guigui.LinearLayoutItem{
-		Widget: &t.text,
-		Size:   guigui.FlexibleSize(1),
-	})
-	if t.hasCloseButton {
-		t.linearLayoutItems = append(t.linearLayoutItems, guigui.LinearLayoutItem{
-			Widget: &t.closeButton,
-		})
-	}
-
-	t.linearLayout = guigui.LinearLayout{
-		Direction: guigui.LayoutDirectionHorizontal,
-		Items:     t.linearLayoutItems,
-		Gap:       u / 2,
-		Padding: guigui.Padding{
-			Start:  u / 2,
-			Top:    u / 4,
-			End:    u / 2,
-			Bottom: u / 4,
-		},
-	}
-}
-
-func (t *toastContent) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
-	t.buildLayout(context)
-	t.linearLayout.LayoutWidgets(context, widgetBounds.Bounds(), layouter)
-}
-
-func (t *toastContent) Measure(context *guigui.Context, constraints guigui.Constraints) image.Point {
-	t.buildLayout(context)
-	return t.linearLayout.Measure(context, constraints)
-}
-
 type Root struct {
 	guigui.DefaultWidget
 
-	background           basicwidget.Background
+	background basicwidget.Background
+	form       basicwidget.Form
+	toaster    basicwidget.Toaster
+
+	semanticColorText    basicwidget.Text
 	semanticColorControl basicwidget.SegmentedControl[basicwidgetdraw.SemanticColor]
+	positionText         basicwidget.Text
+	positionControl      basicwidget.SegmentedControl[basicwidget.ToastPosition]
+	showToastText        basicwidget.Text
 	showToastButton      basicwidget.Button
+	dismissAllText       basicwidget.Text
+	dismissAllButton     basicwidget.Button
 
-	toasts        guigui.WidgetSlice[*Toast]
-	bottomOffsets []int
+	toastCounter  int
+	semanticColor basicwidgetdraw.SemanticColor
+}
 
-	toastCounter     int
-	nextBottomOffset int
-	semanticColor    basicwidgetdraw.SemanticColor
+func (r *Root) Env(context *guigui.Context, key guigui.EnvKey, source *guigui.EnvSource) (any, bool) {
+	switch key {
+	case basicwidget.EnvKeyToaster:
+		return &r.toaster, true
+	default:
+		return nil, false
+	}
 }
 
 func (r *Root) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
 	adder.AddWidget(&r.background)
-	adder.AddWidget(&r.semanticColorControl)
-	adder.AddWidget(&r.showToastButton)
-	for i := range r.toasts.Len() {
-		adder.AddWidget(r.toasts.At(i))
-	}
+	adder.AddWidget(&r.form)
+	adder.AddWidget(&r.toaster)
 
+	r.semanticColorText.SetValue("Color")
 	r.semanticColorControl.SetItems([]basicwidget.SegmentedControlItem[basicwidgetdraw.SemanticColor]{
 		{Text: "Base", Value: basicwidgetdraw.SemanticColorBase},
 		{Text: "Accent", Value: basicwidgetdraw.SemanticColorAccent},
@@ -215,108 +66,101 @@ func (r *Root) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
 		}
 	})
 
-	r.showToastButton.SetText("Show Toast")
+	r.positionText.SetValue("Position")
+	r.positionControl.SetItems([]basicwidget.SegmentedControlItem[basicwidget.ToastPosition]{
+		{Text: "Top start", Value: basicwidget.ToastPositionTopStart},
+		{Text: "Top end", Value: basicwidget.ToastPositionTopEnd},
+		{Text: "Bottom start", Value: basicwidget.ToastPositionBottomStart},
+		{Text: "Bottom end", Value: basicwidget.ToastPositionBottomEnd},
+	})
+	r.positionControl.SelectItemByValue(r.toaster.Position())
+	r.positionControl.OnItemSelected(func(context *guigui.Context, index int) {
+		if item, ok := r.positionControl.ItemByIndex(index); ok {
+			r.toaster.SetPosition(item.Value)
+		}
+	})
+
+	r.showToastText.SetValue("Toast")
+	r.showToastButton.SetText("Show")
 	r.showToastButton.OnDown(func(context *guigui.Context) {
 		r.showToast(context)
 	})
 
+	r.dismissAllText.SetValue("All toasts")
+	r.dismissAllButton.SetText("Dismiss")
+	r.dismissAllButton.OnDown(func(context *guigui.Context) {
+		r.toaster.DismissAll()
+	})
+
+	r.form.SetItems([]basicwidget.FormItem{
+		{
+			PrimaryWidget:   &r.semanticColorText,
+			SecondaryWidget: &r.semanticColorControl,
+		},
+		{
+			PrimaryWidget:   &r.positionText,
+			SecondaryWidget: &r.positionControl,
+		},
+		{
+			PrimaryWidget:   &r.showToastText,
+			SecondaryWidget: &r.showToastButton,
+		},
+		{
+			PrimaryWidget:   &r.dismissAllText,
+			SecondaryWidget: &r.dismissAllButton,
+		},
+	})
+
 	return nil
 }
 
 func (r *Root) showToast(context *guigui.Context) {
-	r.toastCounter++
-	hasCloseButton := r.toastCounter%2 == 0
-
-	// Find a free slot.
-	idx := -1
-	for i := range r.toasts.Len() {
-		if !r.toasts.At(i).IsOpen() {
-			idx = i
-			break
-		}
+	// Any widget in the tree can get the toaster from the environment.
+	v, ok := context.Env(&r.showToastButton, basicwidget.EnvKeyToaster)
+	if !ok {
+		return
 	}
+	toaster := v.(*basicwidget.Toaster)
 
-	// If no free slot, add a new one.
-	if idx == -1 {
-		idx = r.toasts.Len()
-		r.toasts.SetLen(idx + 1)
+	r.toastCounter++
+	toast := basicwidget.Toast{
+		Message:       fmt.Sprintf("Toast #%d", r.toastCounter),
+		SemanticColor: r.semanticColor,
+		Duration:      3 * time.Second,
+		Closable:      r.toastCounter%2 == 0,
 	}
-
-	t := r.toasts.At(idx)
-	t.SetMessage(fmt.Sprintf("Toast #%d", r.toastCounter))
-	t.SetHasCloseButton(hasCloseButton)
-	t.SetDuration(3 * time.Second)
-	t.SetSemanticColor(r.semanticColor)
-
-	// Grow the offsets slice if needed.
-	if len(r.bottomOffsets) <= idx {
-		r.bottomOffsets = slices.Grow(r.bottomOffsets, idx+1-len(r.bottomOffsets))[:idx+1]
+	if r.toastCounter%3 == 0 {
+		n := r.toastCounter
+		toast.Actions = []basicwidget.ToastAction{
+			{
+				Text: "Undo",
+				Handler: func(context *guigui.Context) {
+					toaster.Post(basicwidget.Toast{
+						Message:  fmt.Sprintf("Toast #%d was undone", n),
+						Duration: 3 * time.Second,
+					})
+				},
+			},
+		}
 	}
-	r.bottomOffsets[idx] = r.nextBottomOffset
-
-	u := basicwidget.UnitSize(context)
-	gap := u / 4
-	contentHeight := t.Measure(context, guigui.Constraints{}).Y
-	r.nextBottomOffset += contentHeight + gap
-
-	t.SetOpen(true)
+	toaster.Post(toast)
 }
 
 func (r *Root) Layout(context *guigui.Context, widgetBounds *guigui.WidgetBounds, layouter *guigui.ChildLayouter) {
-	layouter.LayoutWidget(&r.background, widgetBounds.Bounds())
+	bounds := widgetBounds.Bounds()
+	layouter.LayoutWidget(&r.background, bounds)
+	layouter.LayoutWidget(&r.toaster, bounds)
 
 	u := basicwidget.UnitSize(context)
-	appBounds := context.AppBounds()
-
-	// Position the segmented control and button at the top-left with some padding.
-	topLeft := appBounds.Min.Add(image.Pt(u, u))
-	controlSize := r.semanticColorControl.Measure(context, guigui.Constraints{})
-	layouter.LayoutWidget(&r.semanticColorControl, image.Rectangle{
-		Min: topLeft,
-		Max: topLeft.Add(controlSize),
-	})
-
-	buttonTop := topLeft.Y + controlSize.Y + u/2
-	buttonSize := r.showToastButton.Measure(context, guigui.Constraints{})
-	layouter.LayoutWidget(&r.showToastButton, image.Rectangle{
-		Min: image.Pt(topLeft.X, buttonTop),
-		Max: image.Pt(topLeft.X+buttonSize.X, buttonTop+buttonSize.Y),
-	})
-
-	// Position each toast based on its assigned bottom offset.
-	// Toasts stay at their assigned position even when others close.
-	margin := u / 2
-	baseY := appBounds.Max.Y - margin
-
-	allClosed := true
-	for i := range r.toasts.Len() {
-		t := r.toasts.At(i)
-		if !t.IsOpen() {
-			continue
-		}
-		allClosed = false
-
-		contentSize := t.Measure(context, guigui.Constraints{})
-		bottomY := baseY - r.bottomOffsets[i]
-		toastBounds := image.Rectangle{
-			Min: image.Pt(appBounds.Max.X-margin-contentSize.X, bottomY-contentSize.Y),
-			Max: image.Pt(appBounds.Max.X-margin, bottomY),
-		}
-		layouter.LayoutWidget(t, toastBounds)
-	}
-
-	// Reset when all toasts are closed.
-	if allClosed {
-		r.toasts.SetLen(0)
-		r.bottomOffsets = r.bottomOffsets[:0]
-		r.nextBottomOffset = 0
-	}
+	formBounds := bounds.Inset(u / 2)
+	formBounds.Max.Y = formBounds.Min.Y + r.form.Measure(context, guigui.FixedWidthConstraints(formBounds.Dx())).Y
+	layouter.LayoutWidget(&r.form, formBounds)
 }
 
 func main() {
 	op := &guigui.RunOptions{
 		Title:         "Toast",
-		WindowMinSize: image.Pt(400, 300),
+		WindowMinSize: image.Pt(600, 400),
 		RunGameOptions: &ebiten.RunGameOptions{
 			ApplePressAndHoldEnabled: true,
 		},
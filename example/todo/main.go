@@ -10,7 +10,6 @@ import (
 	"slices"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
 
 	"github.com/guigui-gui/guigui"
 	"github.com/guigui-gui/guigui/basicwidget"
@@ -53,7 +52,7 @@ func (r *Root) Build(context *guigui.Context, adder *guigui.ChildAdder) error {
 	adder.AddWidget(&r.tasksPanel)
 
 	r.textInput.OnHandleButtonInput(func(context *guigui.Context, widgetBounds *guigui.WidgetBounds) guigui.HandleInputResult {
-		if inpututil.IsKeyJustPressed(ebiten.KeyEnter) {
+		if guigui.IsKeyJustPressed(ebiten.KeyEnter) {
 			r.tryCreateTask(r.textInput.Value())
 			return guigui.HandleInputByWidget(&r.textInput)
 		}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package guigui
+
+import (
+	"image"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+type InputRecord = inputRecord
+
+type InputRecordTouch = inputRecordTouch
+
+// RecordInputSession writes the records to an input session file at path, as the record mode does.
+func RecordInputSession(path string, records []InputRecord) error {
+	if err := theInputSession.startRecording(path); err != nil {
+		return err
+	}
+	for i := range records {
+		theInputSession.writeRecord(&records[i])
+	}
+	f := theInputSession.recordFile
+	theInputSession.recordFile = nil
+	theInputSession.encoder = nil
+	return f.Close()
+}
+
+// ReplayInputSession replays the input session file at path, and calls f at each tick.
+// The input functions like [CursorPosition] return the replayed state in f.
+func ReplayInputSession(path string, f func()) error {
+	if err := theInputSession.startReplaying(path); err != nil {
+		return err
+	}
+	s := &theApp.inputState
+	orig := *s
+	defer func() {
+		*s = orig
+	}()
+	for theInputSession.readRecord(&s.record) {
+		s.applyRecord(&s.record)
+		f()
+	}
+	return nil
+}
+
+func NextClickCount(prev Click, elapsed int64, button ebiten.MouseButton, pos image.Point, interval int64, slop int) int {
+	return nextClickCount(prev, elapsed, button, pos, interval, slop)
+}
+
+func NextAppScaleLevel(scale float64) float64 {
+	return nextAppScaleLevel(scale)
+}
+
+func PrevAppScaleLevel(scale float64) float64 {
+	return prevAppScaleLevel(scale)
+}
+
+func TextCompositionFromSelection(text string, start, end int) TextComposition {
+	return textCompositionFromSelection(text, start, end)
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package guigui
+
+import (
+	"image"
+	"io/fs"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+var widgetEventFilesDropped EventKey = GenerateEventKey()
+
+// OnFilesDropped sets the event handler that is called when files are dropped onto the widget from outside the application.
+//
+// Registering a handler declares that the widget accepts file drops.
+// Files are delivered to the topmost visible and enabled widget under the cursor that accepts file drops.
+// files is a virtual file system that includes only the dropped files and/or directories at its root directory.
+// position is the drop point in the screen coordinates.
+//
+// Like other event handlers, the handler must be set during every Build.
+func OnFilesDropped(widget Widget, f func(context *Context, files fs.FS, position image.Point)) {
+	SetEventHandler(widget, widgetEventFilesDropped, f)
+}
+
+// IsFileDropHovered reports whether the widget is the one that would receive files dropped at the current cursor position.
+//
+// Ebitengine doesn't report files dragged over the window before they are dropped.
+// As files are usually dragged from another application, IsFileDropHovered reports true only while the window is not focused.
+// This is a hint for a hover feedback, and a widget might receive files even when IsFileDropHovered is false.
+func (c *Context) IsFileDropHovered(widget Widget) bool {
+	return c.app.fileDropHoveredWidget != nil && areWidgetsSame(c.app.fileDropHoveredWidget, widget)
+}
+
+func (a *app) fileDropTargetAtCursor() Widget {
+	for _, wl := range a.maybeHitWidgets {
+		widgetState := wl.widget.widgetState()
+		if !widgetState.hasEventHandler(widgetEventFilesDropped) {
+			continue
+		}
+		if !widgetState.isEnabled() {
+			continue
+		}
+		if !a.isWidgetHitAtCursor(wl.widget) {
+			continue
+		}
+		return wl.widget
+	}
+	return nil
+}
+
+func (a *app) updateFileDropHoveredWidget() {
+	var widget Widget
+	if !ebiten.IsFocused() && image.Pt(a.inputState.cursorX, a.inputState.cursorY).In(a.bounds()) {
+		widget = a.fileDropTargetAtCursor()
+	}
+	if areWidgetsSame(a.fileDropHoveredWidget, widget) {
+		return
+	}
+	if a.fileDropHoveredWidget != nil {
+		RequestRebuild(a.fileDropHoveredWidget)
+	}
+	a.fileDropHoveredWidget = widget
+	if a.fileDropHoveredWidget != nil {
+		RequestRebuild(a.fileDropHoveredWidget)
+	}
+}
+
+func (a *app) handleDroppedFiles() Widget {
+	files := a.inputState.droppedFiles
+	if files == nil {
+		return nil
+	}
+	widget := a.fileDropTargetAtCursor()
+	if widget == nil {
+		return nil
+	}
+	DispatchEvent(widget, widgetEventFilesDropped, files, image.Pt(a.inputState.cursorX, a.inputState.cursorY))
+	return widget
+}
@@ -4,39 +4,157 @@
 package guigui
 
 import (
+	"io/fs"
+
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/inpututil"
 )
 
+type inputTouch struct {
+	id       ebiten.TouchID
+	x, y     int
+	duration int
+}
+
 type inputState struct {
-	touchIDs         []ebiten.TouchID
+	touches          []inputTouch
+	prevTouches      []inputTouch
 	anyMousePressed  bool
 	anyTouch         bool
 	wheelX, wheelY   float64
 	cursorX, cursorY int
 	pressedKeys      []ebiten.Key
 	justReleasedKeys []ebiten.Key
+	droppedFiles     fs.FS
+
+	keyPressDurations         [ebiten.KeyMax + 1]int
+	justReleasedKeyFlags      [ebiten.KeyMax + 1]bool
+	mouseButtonPressDurations [ebiten.MouseButtonMax + 1]int
+	justReleasedMouseButtons  [ebiten.MouseButtonMax + 1]bool
+
+	outsideWidth, outsideHeight float64
+	deviceScale                 float64
 
 	prevAnyMousePressed      bool
 	prevAnyTouch             bool
 	prevCursorX, prevCursorY int
+
+	record   inputRecord
+	touchIDs []ebiten.TouchID
 }
 
-func (s *inputState) update() {
+// update updates the input state for the current tick.
+//
+// The state is read from Ebitengine, or from the input session file in the replay mode.
+// In the record mode, the state is written to the input session file.
+func (s *inputState) update(outsideWidth, outsideHeight float64) {
 	s.prevAnyMousePressed = s.anyMousePressed
 	s.prevAnyTouch = s.anyTouch
 	s.prevCursorX = s.cursorX
 	s.prevCursorY = s.cursorY
 
-	s.anyMousePressed = ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft) ||
-		ebiten.IsMouseButtonPressed(ebiten.MouseButtonRight) ||
-		ebiten.IsMouseButtonPressed(ebiten.MouseButtonMiddle)
+	if !theInputSession.readRecord(&s.record) {
+		s.readRecordFromEbitengine(outsideWidth, outsideHeight)
+		// Dropped files are not recorded, and are available only in the live input.
+		s.droppedFiles = ebiten.DroppedFiles()
+	} else {
+		s.droppedFiles = nil
+	}
+	theInputSession.writeRecord(&s.record)
+
+	s.applyRecord(&s.record)
+}
+
+func (s *inputState) readRecordFromEbitengine(outsideWidth, outsideHeight float64) {
+	r := &s.record
+	r.CursorX, r.CursorY = ebiten.CursorPosition()
+	r.WheelX, r.WheelY = ebiten.Wheel()
+	r.PressedKeys = inpututil.AppendPressedKeys(r.PressedKeys[:0])
+	r.JustReleasedKeys = inpututil.AppendJustReleasedKeys(r.JustReleasedKeys[:0])
+	r.PressedMouseButtons = 0
+	r.JustReleasedMouseButtons = 0
+	for b := ebiten.MouseButton(0); b <= ebiten.MouseButtonMax; b++ {
+		if ebiten.IsMouseButtonPressed(b) {
+			r.PressedMouseButtons |= 1 << b
+		}
+		if inpututil.IsMouseButtonJustReleased(b) {
+			r.JustReleasedMouseButtons |= 1 << b
+		}
+	}
+	r.Touches = r.Touches[:0]
 	s.touchIDs = ebiten.AppendTouchIDs(s.touchIDs[:0])
-	s.anyTouch = len(s.touchIDs) > 0
-	s.wheelX, s.wheelY = ebiten.Wheel()
-	s.cursorX, s.cursorY = ebiten.CursorPosition()
-	s.pressedKeys = inpututil.AppendPressedKeys(s.pressedKeys[:0])
-	s.justReleasedKeys = inpututil.AppendJustReleasedKeys(s.justReleasedKeys[:0])
+	for _, id := range s.touchIDs {
+		x, y := ebiten.TouchPosition(id)
+		r.Touches = append(r.Touches, inputRecordTouch{
+			ID: id,
+			X:  x,
+			Y:  y,
+		})
+	}
+	r.OutsideWidth = outsideWidth
+	r.OutsideHeight = outsideHeight
+	r.DeviceScale = deviceScaleFactor()
+}
+
+func (s *inputState) applyRecord(r *inputRecord) {
+	s.cursorX, s.cursorY = r.CursorX, r.CursorY
+	s.wheelX, s.wheelY = r.WheelX, r.WheelY
+	s.pressedKeys = append(s.pressedKeys[:0], r.PressedKeys...)
+	s.justReleasedKeys = append(s.justReleasedKeys[:0], r.JustReleasedKeys...)
+	s.prevTouches, s.touches = s.touches, s.prevTouches[:0]
+	for _, t := range r.Touches {
+		// Calculate the durations from the touches in the previous tick, as well as the keys.
+		duration := 1
+		for _, p := range s.prevTouches {
+			if p.id == t.ID {
+				duration = p.duration + 1
+				break
+			}
+		}
+		s.touches = append(s.touches, inputTouch{
+			id:       t.ID,
+			x:        t.X,
+			y:        t.Y,
+			duration: duration,
+		})
+	}
+	s.anyTouch = len(s.touches) > 0
+	s.outsideWidth = r.OutsideWidth
+	s.outsideHeight = r.OutsideHeight
+	s.deviceScale = r.DeviceScale
+
+	// Calculate the durations from the pressed states, so that the durations are reproduced in the replay mode.
+	var keyPressed [ebiten.KeyMax + 1]bool
+	for _, k := range s.pressedKeys {
+		if 0 <= k && k <= ebiten.KeyMax {
+			keyPressed[k] = true
+		}
+	}
+	for k := range s.keyPressDurations {
+		if keyPressed[k] {
+			s.keyPressDurations[k]++
+		} else {
+			s.keyPressDurations[k] = 0
+		}
+	}
+	clear(s.justReleasedKeyFlags[:])
+	for _, k := range s.justReleasedKeys {
+		if 0 <= k && k <= ebiten.KeyMax {
+			s.justReleasedKeyFlags[k] = true
+		}
+	}
+
+	for b := range s.mouseButtonPressDurations {
+		if r.PressedMouseButtons&(1<<b) != 0 {
+			s.mouseButtonPressDurations[b]++
+		} else {
+			s.mouseButtonPressDurations[b] = 0
+		}
+		s.justReleasedMouseButtons[b] = r.JustReleasedMouseButtons&(1<<b) != 0
+	}
+	s.anyMousePressed = s.mouseButtonPressDurations[ebiten.MouseButtonLeft] > 0 ||
+		s.mouseButtonPressDurations[ebiten.MouseButtonRight] > 0 ||
+		s.mouseButtonPressDurations[ebiten.MouseButtonMiddle] > 0
 }
 
 func (s *inputState) isButtonActive() bool {
@@ -52,3 +170,116 @@ func (s *inputState) isPointingActive(layoutChanged bool) bool {
 		(!s.anyTouch && s.prevAnyTouch) ||
 		s.wheelX != 0 || s.wheelY != 0
 }
+
+// The functions below are counterparts of Ebitengine's input functions.
+// Widgets should use them instead of Ebitengine's ones, so that a recorded input session can be replayed.
+
+// CursorPosition returns the cursor position in the current tick.
+//
+// CursorPosition is the counterpart of [ebiten.CursorPosition].
+func CursorPosition() (x, y int) {
+	return theApp.inputState.cursorX, theApp.inputState.cursorY
+}
+
+// IsKeyPressed reports whether the key is pressed in the current tick.
+//
+// IsKeyPressed is the counterpart of [ebiten.IsKeyPressed].
+func IsKeyPressed(key ebiten.Key) bool {
+	return KeyPressDuration(key) > 0
+}
+
+// IsKeyJustPressed reports whether the key is just pressed in the current tick.
+//
+// IsKeyJustPressed is the counterpart of [inpututil.IsKeyJustPressed].
+func IsKeyJustPressed(key ebiten.Key) bool {
+	return KeyPressDuration(key) == 1
+}
+
+// IsKeyJustReleased reports whether the key is just released in the current tick.
+//
+// IsKeyJustReleased is the counterpart of [inpututil.IsKeyJustReleased].
+func IsKeyJustReleased(key ebiten.Key) bool {
+	if key < 0 || key > ebiten.KeyMax {
+		return false
+	}
+	return theApp.inputState.justReleasedKeyFlags[key]
+}
+
+// KeyPressDuration returns how many ticks the key has been pressed.
+//
+// KeyPressDuration is the counterpart of [inpututil.KeyPressDuration].
+func KeyPressDuration(key ebiten.Key) int {
+	if key < 0 || key > ebiten.KeyMax {
+		return 0
+	}
+	return theApp.inputState.keyPressDurations[key]
+}
+
+// IsMouseButtonPressed reports whether the mouse button is pressed in the current tick.
+//
+// IsMouseButtonPressed is the counterpart of [ebiten.IsMouseButtonPressed].
+func IsMouseButtonPressed(button ebiten.MouseButton) bool {
+	return MouseButtonPressDuration(button) > 0
+}
+
+// IsMouseButtonJustPressed reports whether the mouse button is just pressed in the current tick.
+//
+// IsMouseButtonJustPressed is the counterpart of [inpututil.IsMouseButtonJustPressed].
+func IsMouseButtonJustPressed(button ebiten.MouseButton) bool {
+	return MouseButtonPressDuration(button) == 1
+}
+
+// IsMouseButtonJustReleased reports whether the mouse button is just released in the current tick.
+//
+// IsMouseButtonJustReleased is the counterpart of [inpututil.IsMouseButtonJustReleased].
+func IsMouseButtonJustReleased(button ebiten.MouseButton) bool {
+	if button < 0 || button > ebiten.MouseButtonMax {
+		return false
+	}
+	return theApp.inputState.justReleasedMouseButtons[button]
+}
+
+// MouseButtonPressDuration returns how many ticks the mouse button has been pressed.
+//
+// MouseButtonPressDuration is the counterpart of [inpututil.MouseButtonPressDuration].
+func MouseButtonPressDuration(button ebiten.MouseButton) int {
+	if button < 0 || button > ebiten.MouseButtonMax {
+		return 0
+	}
+	return theApp.inputState.mouseButtonPressDurations[button]
+}
+
+// AppendTouchIDs appends the IDs of the touches in the current tick to touches, and returns the extended slice.
+//
+// AppendTouchIDs is the counterpart of [ebiten.AppendTouchIDs].
+func AppendTouchIDs(touches []ebiten.TouchID) []ebiten.TouchID {
+	for _, t := range theApp.inputState.touches {
+		touches = append(touches, t.id)
+	}
+	return touches
+}
+
+// TouchPosition returns the position of the touch in the current tick.
+// TouchPosition returns (0, 0) if the touch doesn't exist.
+//
+// TouchPosition is the counterpart of [ebiten.TouchPosition].
+func TouchPosition(id ebiten.TouchID) (x, y int) {
+	for _, t := range theApp.inputState.touches {
+		if t.id == id {
+			return t.x, t.y
+		}
+	}
+	return 0, 0
+}
+
+// TouchPressDuration returns how many ticks the touch has been pressed.
+//
+// TouchPressDuration is the counterpart of [inpututil.TouchPressDuration].
+func TouchPressDuration(id ebiten.TouchID) int {
+	for _, t := range theApp.inputState.touches {
+		if t.id == id {
+			return t.duration
+		}
+	}
+	return 0
+}
@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package guigui_test
+
+import (
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/guigui-gui/guigui"
+)
+
+type inputTick struct {
+	cursorX, cursorY int
+	touches          []guigui.InputRecordTouch
+	touchDurations   []int
+	keyADuration     int
+	mouseDuration    int
+}
+
+func TestInputSessionRoundTrip(t *testing.T) {
+	records := []guigui.InputRecord{
+		{
+			CursorX:             10,
+			CursorY:             20,
+			PressedKeys:         []ebiten.Key{ebiten.KeyA},
+			PressedMouseButtons: 1 << ebiten.MouseButtonLeft,
+			Touches:             []guigui.InputRecordTouch{{ID: 1, X: 3, Y: 4}},
+		},
+		{
+			CursorX:     11,
+			CursorY:     21,
+			PressedKeys: []ebiten.Key{ebiten.KeyA},
+			Touches:     []guigui.InputRecordTouch{{ID: 1, X: 5, Y: 6}, {ID: 2, X: 7, Y: 8}},
+		},
+		{
+			CursorX:          11,
+			CursorY:          21,
+			JustReleasedKeys: []ebiten.Key{ebiten.KeyA},
+			Touches:          []guigui.InputRecordTouch{{ID: 2, X: 9, Y: 10}},
+		},
+		{},
+	}
+	want := []inputTick{
+		{
+			cursorX:        10,
+			cursorY:        20,
+			touches:        []guigui.InputRecordTouch{{ID: 1, X: 3, Y: 4}},
+			touchDurations: []int{1},
+			keyADuration:   1,
+			mouseDuration:  1,
+		},
+		{
+			cursorX:        11,
+			cursorY:        21,
+			touches:        []guigui.InputRecordTouch{{ID: 1, X: 5, Y: 6}, {ID: 2, X: 7, Y: 8}},
+			touchDurations: []int{2, 1},
+			keyADuration:   2,
+		},
+		{
+			cursorX:        11,
+			cursorY:        21,
+			touches:        []guigui.InputRecordTouch{{ID: 2, X: 9, Y: 10}},
+			touchDurations: []int{2},
+		},
+		{},
+	}
+
+	path := filepath.Join(t.TempDir(), "session")
+	if err := guigui.RecordInputSession(path, records); err != nil {
+		t.Fatal(err)
+	}
+	var got []inputTick
+	if err := guigui.ReplayInputSession(path, func() {
+		var tick inputTick
+		tick.cursorX, tick.cursorY = guigui.CursorPosition()
+		for _, id := range guigui.AppendTouchIDs(nil) {
+			x, y := guigui.TouchPosition(id)
+			tick.touches = append(tick.touches, guigui.InputRecordTouch{ID: id, X: x, Y: y})
+			tick.touchDurations = append(tick.touchDurations, guigui.TouchPressDuration(id))
+		}
+		tick.keyADuration = guigui.KeyPressDuration(ebiten.KeyA)
+		tick.mouseDuration = guigui.MouseButtonPressDuration(ebiten.MouseButtonLeft)
+		got = append(got, tick)
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("got %d ticks, want %d", len(got), len(want))
+	}
+	for i := range want {
+		g, w := got[i], want[i]
+		if g.cursorX != w.cursorX || g.cursorY != w.cursorY ||
+			!slices.Equal(g.touches, w.touches) || !slices.Equal(g.touchDurations, w.touchDurations) ||
+			g.keyADuration != w.keyADuration || g.mouseDuration != w.mouseDuration {
+			t.Errorf("tick %d: got %+v, want %+v", i, g, w)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package guigui
+
+import (
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// inputRecordVersion is the version of the input session file format.
+// Increment this when inputRecord is changed incompatibly.
+const inputRecordVersion = 1
+
+type inputRecordHeader struct {
+	Version int
+}
+
+// inputRecord is the input state of one tick in an input session file.
+//
+// An input session file is a gob stream of one inputRecordHeader followed by inputRecords, one per tick.
+// As gob omits zero values, a tick without input activity is encoded in a few bytes.
+type inputRecord struct {
+	CursorX, CursorY int
+	WheelX, WheelY   float64
+
+	PressedKeys      []ebiten.Key
+	JustReleasedKeys []ebiten.Key
+
+	// PressedMouseButtons and JustReleasedMouseButtons are bit sets of mouse buttons.
+	PressedMouseButtons      uint32
+	JustReleasedMouseButtons uint32
+
+	Touches []inputRecordTouch
+
+	OutsideWidth, OutsideHeight float64
+	DeviceScale                 float64
+}
+
+type inputRecordTouch struct {
+	ID   ebiten.TouchID
+	X, Y int
+}
+
+// inputSession records the input states to a file, or replays the input states from a file.
+//
+// An input session is enabled by the environment variable GUIGUI_DEBUG:
+//
+//   - record=path writes the input state of every tick to the file at path.
+//   - replay=path reads the input states from the file at path instead of the actual input devices.
+//     After all the states are consumed, the actual input devices are used again.
+//
+// Dropped files and text typed through an input method, including text typed into basicwidget's text widgets, are not recorded.
+// Both modes should be used with the same application in the same initial state to reproduce a session.
+type inputSession struct {
+	recordFile *os.File
+	encoder    *gob.Encoder
+
+	replayFile *os.File
+	decoder    *gob.Decoder
+}
+
+var theInputSession inputSession
+
+func (s *inputSession) startRecording(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	e := gob.NewEncoder(f)
+	if err := e.Encode(&inputRecordHeader{Version: inputRecordVersion}); err != nil {
+		_ = f.Close()
+		return err
+	}
+	s.recordFile = f
+	s.encoder = e
+	return nil
+}
+
+func (s *inputSession) startReplaying(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	d := gob.NewDecoder(f)
+	var h inputRecordHeader
+	if err := d.Decode(&h); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if h.Version != inputRecordVersion {
+		_ = f.Close()
+		return fmt.Errorf("guigui: unsupported input session version: %d", h.Version)
+	}
+	s.replayFile = f
+	s.decoder = d
+	return nil
+}
+
+func (s *inputSession) isReplaying() bool {
+	return s.decoder != nil
+}
+
+// readRecord reads the next record in the replay mode.
+// readRecord returns false if the session is not being replayed.
+func (s *inputSession) readRecord(record *inputRecord) bool {
+	if s.decoder == nil {
+		return false
+	}
+	// Reset the record, as gob doesn't overwrite fields with zero values.
+	*record = inputRecord{
+		PressedKeys:      record.PressedKeys[:0],
+		JustReleasedKeys: record.JustReleasedKeys[:0],
+		Touches:          record.Touches[:0],
+	}
+	if err := s.decoder.Decode(record); err != nil {
+		if errors.Is(err, io.EOF) {
+			slog.Info("input session replay finished")
+		} else {
+			slog.Error(err.Error())
+		}
+		_ = s.replayFile.Close()
+		s.replayFile = nil
+		s.decoder = nil
+		return false
+	}
+	return true
+}
+
+// writeRecord writes the record in the record mode.
+func (s *inputSession) writeRecord(record *inputRecord) {
+	if s.encoder == nil {
+		return
+	}
+	if err := s.encoder.Encode(record); err != nil {
+		slog.Error(err.Error())
+		_ = s.recordFile.Close()
+		s.recordFile = nil
+		s.encoder = nil
+	}
+}
@@ -0,0 +1,197 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package guigui
+
+import (
+	"image"
+	"log/slog"
+
+	"github.com/hajimehoshi/ebiten/v2/exp/textinput"
+)
+
+// TextInputClient is implemented by a widget that receives text from an input method (IME),
+// such as a terminal emulator or a text tool on a canvas.
+//
+// When the focused widget implements TextInputClient, Guigui starts an input method session for it.
+// While the input method is composing text, button input is not delivered to widgets.
+//
+// basicwidget's text widgets handle an input method by themselves, so they don't implement TextInputClient.
+type TextInputClient interface {
+	Widget
+
+	// TextInputCaretBounds returns the caret bounds in the screen coordinates.
+	// The input method uses them to place its candidate window.
+	//
+	// TextInputCaretBounds is called every tick while the widget is focused,
+	// and the input method follows the caret when the bounds are changed while no text is being composed.
+	TextInputCaretBounds(context *Context) image.Rectangle
+
+	// SetTextComposition is called when the text being composed (preedit) is changed.
+	// composition's Text is empty when the composition ends.
+	SetTextComposition(context *Context, composition TextComposition)
+
+	// CommitText is called when the input method commits text.
+	// The widget should insert text at its caret.
+	CommitText(context *Context, text string)
+}
+
+// TextCompositionSegmentType represents the type of a segment in a text composition.
+type TextCompositionSegmentType int
+
+const (
+	// TextCompositionSegmentTypeNormal indicates a segment that is not being converted.
+	TextCompositionSegmentTypeNormal TextCompositionSegmentType = iota
+
+	// TextCompositionSegmentTypeTarget indicates a segment that the input method is converting.
+	// A widget typically highlights this segment.
+	TextCompositionSegmentTypeTarget
+)
+
+// TextCompositionSegment is a segment in a text composition.
+type TextCompositionSegment struct {
+	StartInBytes int
+	EndInBytes   int
+	Type         TextCompositionSegmentType
+}
+
+// TextComposition represents text being composed (preedit) by an input method.
+type TextComposition struct {
+	// Text is the text being composed.
+	Text string
+
+	// CursorInBytes is the cursor position in Text in bytes.
+	CursorInBytes int
+
+	// Segments are the segments of Text in order.
+	// Segments cover the whole Text without gaps.
+	Segments []TextCompositionSegment
+}
+
+func newTextComposition(c *textinput.Composition) TextComposition {
+	start, end := c.SelectionRangeInBytes()
+	return textCompositionFromSelection(c.Text(), start, end)
+}
+
+// textCompositionFromSelection returns a text composition of text whose segment [start, end) is being converted.
+// The cursor is at end.
+func textCompositionFromSelection(text string, start, end int) TextComposition {
+	if text == "" {
+		return TextComposition{}
+	}
+	start = min(max(start, 0), len(text))
+	end = min(max(end, start), len(text))
+
+	tc := TextComposition{
+		Text:          text,
+		CursorInBytes: end,
+	}
+	if start > 0 {
+		tc.Segments = append(tc.Segments, TextCompositionSegment{
+			StartInBytes: 0,
+			EndInBytes:   start,
+			Type:         TextCompositionSegmentTypeNormal,
+		})
+	}
+	if start < end {
+		tc.Segments = append(tc.Segments, TextCompositionSegment{
+			StartInBytes: start,
+			EndInBytes:   end,
+			Type:         TextCompositionSegmentTypeTarget,
+		})
+	}
+	if end < len(text) {
+		tc.Segments = append(tc.Segments, TextCompositionSegment{
+			StartInBytes: end,
+			EndInBytes:   len(text),
+			Type:         TextCompositionSegmentTypeNormal,
+		})
+	}
+	return tc
+}
+
+type textInputClientState struct {
+	client         TextInputClient
+	composer       textinput.Composer
+	composerInited bool
+	err            error
+
+	// sessionCaretBounds is the caret bounds passed to the current input method session.
+	sessionCaretBounds image.Rectangle
+
+	// composing reports whether the input method is composing text.
+	composing bool
+}
+
+func (s *textInputClientState) ensureComposerInited() {
+	if s.composerInited {
+		return
+	}
+	s.composer.OnNewSession = func() *textinput.SessionOptions {
+		if s.client == nil {
+			return nil
+		}
+		s.sessionCaretBounds = s.client.TextInputCaretBounds(&theApp.context)
+		return &textinput.SessionOptions{
+			CaretBounds: s.sessionCaretBounds,
+		}
+	}
+	s.composer.OnComposition = func(c *textinput.Composition) {
+		composing := s.composing
+		s.composing = c.Text() != ""
+		if s.client == nil {
+			return
+		}
+		// An empty composition is dispatched when a session ends. Ignore it unless a composition ends.
+		if !composing && !s.composing {
+			return
+		}
+		s.client.SetTextComposition(&theApp.context, newTextComposition(c))
+		RequestRebuild(s.client)
+	}
+	s.composer.OnCommit = func(c *textinput.Commit) {
+		if s.client == nil {
+			return
+		}
+		s.client.CommitText(&theApp.context, c.Text())
+		RequestRebuild(s.client)
+	}
+	s.composerInited = true
+}
+
+// update drives the input method for the focused widget.
+// update returns true if the input method consumed input in this tick.
+func (s *textInputClientState) update(focusedWidget Widget) bool {
+	client, _ := focusedWidget.(TextInputClient)
+	if client != nil && !theApp.context.canHaveFocus(client.widgetState()) {
+		client = nil
+	}
+
+	if s.client != nil && (client == nil || !areWidgetsSame(s.client, client)) {
+		// Commit the composition to the previous client, as the user would expect the text not to be lost.
+		s.composer.Confirm()
+		s.client = nil
+	}
+	if client == nil {
+		return false
+	}
+	s.client = client
+
+	if s.err != nil {
+		return false
+	}
+	s.ensureComposerInited()
+	// The caret bounds can be passed to the input method only at the start of a session.
+	// Restart the session when the caret is moved, unless text is being composed and would be lost.
+	if !s.composing && client.TextInputCaretBounds(&theApp.context) != s.sessionCaretBounds {
+		s.composer.Cancel()
+	}
+	handled, err := s.composer.Update()
+	if err != nil {
+		// An input method error is not fatal. Stop using the input method.
+		slog.Error(err.Error())
+		s.err = err
+		return false
+	}
+	return handled
+}
@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package guigui_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/guigui-gui/guigui"
+)
+
+func TestTextCompositionFromSelection(t *testing.T) {
+	type segment = guigui.TextCompositionSegment
+	const (
+		normal = guigui.TextCompositionSegmentTypeNormal
+		target = guigui.TextCompositionSegmentTypeTarget
+	)
+	testCases := []struct {
+		name  string
+		text  string
+		start int
+		end   int
+		out   guigui.TextComposition
+	}{
+		{
+			name: "empty",
+			text: "",
+			out:  guigui.TextComposition{},
+		},
+		{
+			name:  "no target",
+			text:  "abc",
+			start: 3,
+			end:   3,
+			out: guigui.TextComposition{
+				Text:          "abc",
+				CursorInBytes: 3,
+				Segments:      []segment{{StartInBytes: 0, EndInBytes: 3, Type: normal}},
+			},
+		},
+		{
+			name:  "whole target",
+			text:  "abc",
+			start: 0,
+			end:   3,
+			out: guigui.TextComposition{
+				Text:          "abc",
+				CursorInBytes: 3,
+				Segments:      []segment{{StartInBytes: 0, EndInBytes: 3, Type: target}},
+			},
+		},
+		{
+			name:  "middle target",
+			text:  "かんじへ",
+			start: 3,
+			end:   9,
+			out: guigui.TextComposition{
+				Text:          "かんじへ",
+				CursorInBytes: 9,
+				Segments: []segment{
+					{StartInBytes: 0, EndInBytes: 3, Type: normal},
+					{StartInBytes: 3, EndInBytes: 9, Type: target},
+					{StartInBytes: 9, EndInBytes: 12, Type: normal},
+				},
+			},
+		},
+		{
+			name:  "out of range",
+			text:  "abc",
+			start: -1,
+			end:   10,
+			out: guigui.TextComposition{
+				Text:          "abc",
+				CursorInBytes: 3,
+				Segments:      []segment{{StartInBytes: 0, EndInBytes: 3, Type: target}},
+			},
+		},
+		{
+			name:  "reversed",
+			text:  "abc",
+			start: 2,
+			end:   1,
+			out: guigui.TextComposition{
+				Text:          "abc",
+				CursorInBytes: 2,
+				Segments: []segment{
+					{StartInBytes: 0, EndInBytes: 2, Type: normal},
+					{StartInBytes: 2, EndInBytes: 3, Type: normal},
+				},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := guigui.TextCompositionFromSelection(tc.text, tc.start, tc.end); !reflect.DeepEqual(got, tc.out) {
+				t.Errorf("got: %+v, want: %+v", got, tc.out)
+			}
+		})
+	}
+}
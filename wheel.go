@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package guigui
+
+import (
+	"runtime"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// appScaleLevels are the app scales that the zoom shortcuts step through.
+var appScaleLevels = []float64{0.5, 0.67, 0.75, 0.8, 0.9, 1, 1.1, 1.25, 1.5, 1.75, 2, 2.5, 3}
+
+// nextAppScaleLevel returns the smallest level greater than scale.
+// If scale is already at or above the greatest level, nextAppScaleLevel returns scale as it is.
+func nextAppScaleLevel(scale float64) float64 {
+	for _, l := range appScaleLevels {
+		if l > scale+1e-6 {
+			return l
+		}
+	}
+	return scale
+}
+
+// prevAppScaleLevel returns the greatest level less than scale.
+// If scale is already at or below the smallest level, prevAppScaleLevel returns scale as it is.
+func prevAppScaleLevel(scale float64) float64 {
+	for i := len(appScaleLevels) - 1; i >= 0; i-- {
+		if l := appScaleLevels[i]; l < scale-1e-6 {
+			return l
+		}
+	}
+	return scale
+}
+
+// platformWheelScale returns the factor to normalize the wheel values among platforms.
+func platformWheelScale() float64 {
+	switch runtime.GOOS {
+	case "darwin":
+		return 2
+	case "windows":
+		return 4
+	}
+	return 1
+}
+
+func isZoomModifierPressed() bool {
+	if runtime.GOOS == "darwin" {
+		return IsKeyPressed(ebiten.KeyMeta)
+	}
+	return IsKeyPressed(ebiten.KeyControl)
+}
+
+// updateWheel updates the wheel values for widgets, and handles the zoom shortcuts if enabled.
+// updateWheel reports whether a zoom shortcut key is handled.
+// Then the key is not passed to the widgets as button input.
+func (a *app) updateWheel() (keyHandled bool) {
+	x, y := a.inputState.wheelX, a.inputState.wheelY
+
+	if a.context.appScaleShortcutsEnabled && isZoomModifierPressed() {
+		// Accumulate the wheel values, as a touchpad reports many small values.
+		a.zoomWheel += y
+		switch {
+		case a.zoomWheel >= 1:
+			a.context.SetAppScale(nextAppScaleLevel(a.context.AppScale()))
+			a.zoomWheel = 0
+		case a.zoomWheel <= -1:
+			a.context.SetAppScale(prevAppScaleLevel(a.context.AppScale()))
+			a.zoomWheel = 0
+		}
+		switch {
+		case IsKeyJustPressed(ebiten.KeyEqual), IsKeyJustPressed(ebiten.KeyNumpadAdd):
+			a.context.SetAppScale(nextAppScaleLevel(a.context.AppScale()))
+			keyHandled = true
+		case IsKeyJustPressed(ebiten.KeyMinus), IsKeyJustPressed(ebiten.KeyNumpadSubtract):
+			a.context.SetAppScale(prevAppScaleLevel(a.context.AppScale()))
+			keyHandled = true
+		case IsKeyJustPressed(ebiten.Key0), IsKeyJustPressed(ebiten.KeyNumpad0):
+			a.context.SetAppScale(1)
+			keyHandled = true
+		}
+		// The wheel is consumed by zooming.
+		a.wheelX = 0
+		a.wheelY = 0
+		return keyHandled
+	}
+	a.zoomWheel = 0
+
+	// Some platforms like macOS already convert Shift+wheel to a horizontal wheel.
+	if x == 0 && IsKeyPressed(ebiten.KeyShift) {
+		x, y = y, 0
+	}
+
+	s := platformWheelScale() * a.context.WheelSpeed()
+	a.wheelX = x * s
+	a.wheelY = y * s
+	return false
+}
+
+// Wheel returns the wheel values for scrolling in the current tick.
+//
+// Unlike [ebiten.Wheel], the values are normalized among platforms and multiplied by [Context.WheelSpeed].
+// While Shift is pressed, a vertical wheel is reported as a horizontal wheel.
+// Wheel returns zeros when the wheel is used for zooming (see [Context.SetAppScaleShortcutsEnabled]).
+func (c *Context) Wheel() (x, y float64) {
+	return c.app.wheelX, c.app.wheelY
+}
+
+// WheelSpeed returns the wheel speed factor set by [Context.SetWheelSpeed].
+// The default value is 1.
+func (c *Context) WheelSpeed() float64 {
+	return c.wheelSpeedMinus1 + 1
+}
+
+// SetWheelSpeed sets the wheel speed factor applied to [Context.Wheel].
+func (c *Context) SetWheelSpeed(speed float64) {
+	c.wheelSpeedMinus1 = speed - 1
+}
+
+// IsSmoothScrollingEnabled reports whether scrolling by a wheel is animated.
+func (c *Context) IsSmoothScrollingEnabled() bool {
+	return c.smoothScrollingEnabled
+}
+
+// SetSmoothScrollingEnabled sets whether scrolling by a wheel is animated.
+// The default value is false.
+func (c *Context) SetSmoothScrollingEnabled(enabled bool) {
+	c.smoothScrollingEnabled = enabled
+}
+
+// IsAppScaleShortcutsEnabled reports whether the shortcuts to change the app scale are enabled.
+func (c *Context) IsAppScaleShortcutsEnabled() bool {
+	return c.appScaleShortcutsEnabled
+}
+
+// SetAppScaleShortcutsEnabled sets whether the shortcuts to change the app scale are enabled.
+//
+// When enabled, Ctrl+wheel, Ctrl+Plus and Ctrl+Minus zoom the app in and out, and Ctrl+0 resets the app scale to 1.
+// On macOS, Command is used instead of Ctrl.
+// The key presses of these shortcuts are not passed to [Widget.HandleButtonInput].
+// The default value is false.
+func (c *Context) SetAppScaleShortcutsEnabled(enabled bool) {
+	c.appScaleShortcutsEnabled = enabled
+}
@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The Guigui Authors
+
+package guigui_test
+
+import (
+	"testing"
+
+	"github.com/guigui-gui/guigui"
+)
+
+func TestAppScaleLevel(t *testing.T) {
+	testCases := []struct {
+		scale float64
+		next  float64
+		prev  float64
+	}{
+		{scale: 1, next: 1.1, prev: 0.9},
+		{scale: 0.5, next: 0.67, prev: 0.5},
+		{scale: 3, next: 3, prev: 2.5},
+		{scale: 0.25, next: 0.5, prev: 0.25},
+		{scale: 4, next: 4, prev: 3},
+		// A scale between the levels steps to the nearest levels.
+		{scale: 1.2, next: 1.25, prev: 1.1},
+		// A scale with a rounding error is treated as the level.
+		{scale: 1.1 + 1e-9, next: 1.25, prev: 1},
+	}
+	for _, tc := range testCases {
+		if got := guigui.NextAppScaleLevel(tc.scale); got != tc.next {
+			t.Errorf("NextAppScaleLevel(%v): got: %v, want: %v", tc.scale, got, tc.next)
+		}
+		if got := guigui.PrevAppScaleLevel(tc.scale); got != tc.prev {
+			t.Errorf("PrevAppScaleLevel(%v): got: %v, want: %v", tc.scale, got, tc.prev)
+		}
+	}
+}
@@ -378,6 +378,15 @@ func SetEventHandler(widget Widget, eventKey EventKey, handler any) {
 	})
 }
 
+func (w *widgetState) hasEventHandler(eventKey EventKey) bool {
+	for _, h := range w.eventHandlers {
+		if h.key == eventKey {
+			return true
+		}
+	}
+	return false
+}
+
 // DispatchEvent invokes the event handler registered for the given event key on the widget.
 // The handler must have been set via SetEventHandler during the current build phase,
 // as all handlers are reset before each build.